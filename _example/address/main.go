@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsCEP results:")
+	fmt.Println(checker.IsCEP("01310-100")) // Should return true
+	fmt.Println(checker.IsCEP("01310100"))  // Should return true
+	fmt.Println(checker.IsCEP("0131-0100")) // Should return false
+
+	fmt.Println("IsStreetLine results:")
+	fmt.Println(checker.IsStreetLine("Rua 25 de Março")) // Should return true
+	fmt.Println(checker.IsStreetLine("1234"))            // Should return false
+
+	fmt.Println("IsHouseNumber results:")
+	fmt.Println(checker.IsHouseNumber("123A"))  // Should return true
+	fmt.Println(checker.IsHouseNumber("S/N"))   // Should return true
+	fmt.Println(checker.IsHouseNumber("12 34")) // Should return false
+
+	fmt.Println("IsComplement results:")
+	fmt.Println(checker.IsComplement("Apto 12, Bloco B")) // Should return true
+	fmt.Println(checker.IsComplement("--"))               // Should return false
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsAmount results:")
+	fmt.Println(checker.IsAmount("pt-BR", "R$ 1.234,56")) // Should return true
+	fmt.Println(checker.IsAmount("en-US", "$1,234.56"))   // Should return true
+	fmt.Println(checker.IsAmount("en-US", "1.234,56"))    // Should return false
+
+	fmt.Println("AmountWithinRange results:")
+	fmt.Println(checker.AmountWithinRange("pt-BR", "1,234", 0, 1000)) // Should return true
+	fmt.Println(checker.AmountWithinRange("en-US", "1,234", 0, 1000)) // Should return false
+
+	fmt.Println("RegisterAmountFormat results:")
+	checker.RegisterAmountFormat("pt-PT", checker.AmountFormat{ThousandSeparator: " ", DecimalSeparator: ",",
+		Symbols: []string{"€"}})
+	fmt.Println(checker.IsAmount("pt-PT", "1 234,56 €")) // Should return true
+
+	fmt.Println("IsValidAmountForCurrency results:")
+	fmt.Println(checker.IsValidAmountForCurrency(1234.56, "BRL"))  // Should return true
+	fmt.Println(checker.IsValidAmountForCurrency("1500.5", "JPY")) // Should return false
+	fmt.Println(checker.IsValidAmountForCurrency("12.345", "BHD")) // Should return true
+
+	fmt.Println("IsWithinLimit results:")
+	fmt.Println(checker.IsWithinLimit(250.75, 1000))  // Should return true
+	fmt.Println(checker.IsWithinLimit(1000.01, 1000)) // Should return false
+	fmt.Println(checker.IsWithinLimit("abc", 1000))   // Should return false
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsBankCode results:")
+	fmt.Println(checker.IsBankCode("341", true)) // Should return true
+	fmt.Println(checker.IsBankCode("999", true)) // Should return false
+
+	fmt.Println("IsAgencyNumber results:")
+	fmt.Println(checker.IsAgencyNumber("001", "1584-9")) // Should return true
+	fmt.Println(checker.IsAgencyNumber("001", "1584-2")) // Should return false
+
+	fmt.Println("IsAccountNumberWithDigit results:")
+	fmt.Println(checker.IsAccountNumberWithDigit("341", "0057", "12345-7"))   // Should return true
+	fmt.Println(checker.IsAccountNumberWithDigit("237", "1234", "0238069-2")) // Should return true
+	fmt.Println(checker.IsAccountNumberWithDigit("001", "1584", "210169-5"))  // Should return false
+
+	fmt.Println("IsISPB results:")
+	fmt.Println(checker.IsISPB("60701190")) // Should return true
+	fmt.Println(checker.IsISPB("6070119"))  // Should return false
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("CheckConcurrently results:")
+	items := []any{"12101721007", "11111111111", "121.017.210-07"}
+	fmt.Println(checker.CheckConcurrently(context.Background(), items, checker.IsCPF, 2)) // Should return [true false true] <nil>
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsKafkaTopicName results:")
+	fmt.Println(checker.IsKafkaTopicName("orders.created_v1")) // Should return true
+	fmt.Println(checker.IsKafkaTopicName("orders created"))    // Should return false
+
+	fmt.Println("IsRabbitRoutingKey results:")
+	fmt.Println(checker.IsRabbitRoutingKey("tenant.42.order.created", false)) // Should return true
+	fmt.Println(checker.IsRabbitRoutingKey("tenant.*.order.#", true))         // Should return true
+	fmt.Println(checker.IsRabbitRoutingKey("tenant.*.order.#", false))        // Should return false
+
+	fmt.Println("IsSQSQueueName results:")
+	fmt.Println(checker.IsSQSQueueName("order-events.fifo")) // Should return true
+	fmt.Println(checker.IsSQSQueueName("order.events"))      // Should return false
+
+	fmt.Println("IsNATSSubject results:")
+	fmt.Println(checker.IsNATSSubject("orders.us.created", false)) // Should return true
+	fmt.Println(checker.IsNATSSubject("orders.>", true))           // Should return true
+	fmt.Println(checker.IsNATSSubject("orders.>.created", true))   // Should return false
+}
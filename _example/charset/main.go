@@ -0,0 +1,19 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("OnlyContains results:")
+	fmt.Println(checker.OnlyContains("deadBEEF", checker.CharsetHex))            // Should return true
+	fmt.Println(checker.OnlyContains("my-slug_1", checker.CharsetAlphaNum+"-_")) // Should return true
+	fmt.Println(checker.OnlyContains("0OIl", checker.CharsetBase58))             // Should return false
+	fmt.Println(checker.OnlyContains("", checker.CharsetAlphaNum))               // Should return false
+
+	fmt.Println("ContainsNoneOf results:")
+	fmt.Println(checker.ContainsNoneOf("report.pdf", `/\:*?"<>|`)) // Should return true
+	fmt.Println(checker.ContainsNoneOf("../etc/passwd", `/\`))     // Should return false
+	fmt.Println(checker.ContainsNoneOf("", checker.CharsetHex))    // Should return true
+}
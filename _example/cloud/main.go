@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsAWSARN results:")
+	fmt.Println(checker.IsAWSARN("arn:aws:iam::123456789012:user/johndoe")) // Should return true
+	fmt.Println(checker.IsAWSARN("arn:aws:s3:::my-bucket/photos/*"))        // Should return true
+	fmt.Println(checker.IsAWSARN("arn:aws:iam::12345:user/johndoe"))        // Should return false
+
+	fmt.Println("IsS3BucketName results:")
+	fmt.Println(checker.IsS3BucketName("my-app-assets")) // Should return true
+	fmt.Println(checker.IsS3BucketName("My_Bucket"))     // Should return false
+	fmt.Println(checker.IsS3BucketName("192.168.5.4"))   // Should return false
+
+	fmt.Println("IsGCPProjectID results:")
+	fmt.Println(checker.IsGCPProjectID("my-project-123")) // Should return true
+	fmt.Println(checker.IsGCPProjectID("1-project"))      // Should return false
+
+	fmt.Println("IsAzureResourceID results:")
+	fmt.Println(checker.IsAzureResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-app" +
+		"/providers/Microsoft.Storage/storageAccounts/appstorage")) // Should return true
+	fmt.Println(checker.IsAzureResourceID("/subscriptions/not-a-guid")) // Should return false
+}
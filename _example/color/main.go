@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsHexColor results:")
+	fmt.Println(checker.IsHexColor("#1A2B3C")) // Should return true
+	fmt.Println(checker.IsHexColor("1A2B3C"))  // Should return false
+
+	fmt.Println("HasSufficientContrast results:")
+	fmt.Println(checker.HasSufficientContrast("#000", "#fff", 7))                    // Should return true
+	fmt.Println(checker.HasSufficientContrast("#777777", "rgb(255, 255, 255)", 4.5)) // Should return false
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("ContainsMixedScripts results:")
+	fmt.Println(checker.ContainsMixedScripts("pаypal.com")) // Should return true
+	fmt.Println(checker.ContainsMixedScripts("paypal.com")) // Should return false
+
+	fmt.Println("ContainsConfusables results:")
+	fmt.Println(checker.ContainsConfusables("аррӏе.com")) // Should return true
+	fmt.Println(checker.ContainsConfusables("São Paulo")) // Should return false
+}
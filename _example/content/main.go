@@ -0,0 +1,59 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	docx := buildZIP("[Content_Types].xml", "word/document.xml")
+	xlsx := buildZIP("[Content_Types].xml", "xl/workbook.xml")
+
+	fmt.Println("IsZIPBytes results:")
+	fmt.Println(checker.IsZIPBytes(docx))                // Should return true
+	fmt.Println(checker.IsZIPBytes([]byte("not a zip"))) // Should return false
+
+	fmt.Println("IsTarBytes results:")
+	fmt.Println(checker.IsTarBytes(docx)) // Should return false
+
+	fmt.Println("Is7zBytes results:")
+	fmt.Println(checker.Is7zBytes([]byte{'7', 'z', 0xBC, 0xAF, 0x27, 0x1C})) // Should return true
+	fmt.Println(checker.Is7zBytes(docx))                                     // Should return false
+
+	fmt.Println("IsDocxBytes results:")
+	fmt.Println(checker.IsDocxBytes(docx)) // Should return true
+	fmt.Println(checker.IsDocxBytes(xlsx)) // Should return false
+
+	fmt.Println("IsXlsxBytes results:")
+	fmt.Println(checker.IsXlsxBytes(xlsx)) // Should return true
+	fmt.Println(checker.IsXlsxBytes(docx)) // Should return false
+
+	fmt.Println("IsMP3Bytes results:")
+	fmt.Println(checker.IsMP3Bytes([]byte{0xFF, 0xFB, 0x90, 0x00})) // Should return true
+	fmt.Println(checker.IsMP3Bytes([]byte("not an mp3")))           // Should return false
+
+	fmt.Println("IsMP4Bytes results:")
+	fmt.Println(checker.IsMP4Bytes([]byte("\x00\x00\x00\x14ftypisom\x00\x00\x02\x00isom"))) // Should return true
+	fmt.Println(checker.IsMP4Bytes(docx))                                                   // Should return false
+
+	fmt.Println("IsWebMBytes results:")
+	fmt.Println(checker.IsWebMBytes([]byte("\x1A\x45\xDF\xA3\x9F\x42\x82\x84webm"))) // Should return true
+	fmt.Println(checker.IsWebMBytes(docx))                                           // Should return false
+
+	fmt.Println("IsOGGBytes results:")
+	fmt.Println(checker.IsOGGBytes(append([]byte("OggS\x00\x02"), make([]byte, 21)...))) // Should return true
+	fmt.Println(checker.IsOGGBytes(docx))                                                // Should return false
+}
+
+func buildZIP(names ...string) []byte {
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for _, name := range names {
+		file, _ := writer.Create(name)
+		_, _ = file.Write([]byte(name))
+	}
+	_ = writer.Close()
+	return buffer.Bytes()
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("CanConvertToString results:")
+	fmt.Println(checker.CanConvertToString(123))            // Should return true <nil>
+	fmt.Println(checker.CanConvertToString(make(chan int))) // Should return false error getting a string: unsupported type chan
+
+	fmt.Println("CanConvertToInt results:")
+	fmt.Println(checker.CanConvertToInt("123")) // Should return true <nil>
+	fmt.Println(checker.CanConvertToInt("1.5")) // Should return false strconv.Atoi: parsing "1.5": invalid syntax
+
+	fmt.Println("CanConvertToFloat results:")
+	fmt.Println(checker.CanConvertToFloat("1.5")) // Should return true <nil>
+	fmt.Println(checker.CanConvertToFloat(nil))   // Should return false error getting float: value is nil
+
+	fmt.Println("CanConvertToTime results:")
+	fmt.Println(checker.CanConvertToTime("2024-06-01")) // Should return true <nil>
+	fmt.Println(checker.CanConvertToTime("tomorrow"))   // Should return false cannot convert string to time.Time: Unknown format "tomorrow"
+}
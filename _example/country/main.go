@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsNationalID results:")
+	fmt.Println(checker.IsNationalID("BR", "121.017.210-07")) // Should return true
+	fmt.Println(checker.IsNationalID("PT", "123456789"))      // Should return true
+	fmt.Println(checker.IsNationalID("ES", "12345678Z"))      // Should return true
+	fmt.Println(checker.IsNationalID("US", "000-12-3456"))    // Should return false
+
+	fmt.Println("IsPostalCode results:")
+	fmt.Println(checker.IsPostalCode("BR", "01310-100")) // Should return true
+	fmt.Println(checker.IsPostalCode("UK", "SW1A 1AA"))  // Should return true
+	fmt.Println(checker.IsPostalCode("DE", "10115"))     // Should return true
+	fmt.Println(checker.IsPostalCode("PT", "1000001"))   // Should return false
+}
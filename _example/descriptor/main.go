@@ -0,0 +1,27 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("ParseDescriptor results:")
+	descriptor, err := checker.ParseDescriptor("max=10000")
+	fmt.Println(descriptor.Name, descriptor.Params, err) // Should return max [10000] <nil>
+
+	fmt.Println("Descriptor marshaling results:")
+	b, _ := json.Marshal(descriptor)
+	fmt.Println(string(b)) // Should return {"name":"max","params":["10000"]}
+
+	fmt.Println("Descriptor.Rule results:")
+	var shipped checker.Descriptor
+	_ = json.Unmarshal(b, &shipped)
+	rule, err := shipped.Rule()
+	fmt.Println(err)         // Should return <nil>
+	fmt.Println(rule(500))   // Should return true
+	fmt.Println(rule(20000)) // Should return false
+	_, err = checker.Descriptor{Name: "is_unknown"}.Rule()
+	fmt.Println(err) // Should return unknown rule "is_unknown"
+}
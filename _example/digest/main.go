@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("MatchesSHA256 results:")
+	fmt.Println(checker.MatchesSHA256("hello", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824")) // Should return true
+	fmt.Println(checker.MatchesSHA256("hello!", "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ="))                    // Should return false
+
+	fmt.Println("MatchesMD5 results:")
+	fmt.Println(checker.MatchesMD5([]byte("hello"), "XUFAKrxLKna5cZ2REBfFkg==")) // Should return true
+	fmt.Println(checker.MatchesMD5("hello", "5d41402abc4b2a76b9719d911017c593")) // Should return false
+
+	fmt.Println("MatchesETag results:")
+	fmt.Println(checker.MatchesETag(`W/"v1"`, `"v1"`, true))  // Should return true
+	fmt.Println(checker.MatchesETag(`W/"v1"`, `"v1"`, false)) // Should return false
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+	"strings"
+)
+
+func main() {
+	fmt.Println("IsDockerImageReference results:")
+	fmt.Println(checker.IsDockerImageReference("nginx"))                  // Should return true
+	fmt.Println(checker.IsDockerImageReference("ghcr.io/org/app:v1.2.0")) // Should return true
+	fmt.Println(checker.IsDockerImageReference("Nginx:latest"))           // Should return false
+
+	fmt.Println("IsImageTag results:")
+	fmt.Println(checker.IsImageTag("1.25-alpine")) // Should return true
+	fmt.Println(checker.IsImageTag(".hidden"))     // Should return false
+
+	fmt.Println("IsImageDigest results:")
+	fmt.Println(checker.IsImageDigest("sha256:" + strings.Repeat("a", 64))) // Should return true
+	fmt.Println(checker.IsImageDigest("sha256:" + strings.Repeat("a", 63))) // Should return false
+}
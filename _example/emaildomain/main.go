@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsDisposableEmail results:")
+	fmt.Println(checker.IsDisposableEmail("user@mailinator.com")) // Should return true
+	fmt.Println(checker.IsDisposableEmail("user@gmail.com"))      // Should return false
+
+	fmt.Println("IsFreeEmail results:")
+	fmt.Println(checker.IsFreeEmail("user@gmail.com"))   // Should return true
+	fmt.Println(checker.IsFreeEmail("user@example.com")) // Should return false
+
+	fmt.Println("IsCorporateEmail results:")
+	fmt.Println(checker.IsCorporateEmail("jane@acme.com"))    // Should return true
+	fmt.Println(checker.IsCorporateEmail("jane@gmail.com"))   // Should return false
+	fmt.Println(checker.IsCorporateEmail("jane@yopmail.com")) // Should return false
+
+	fmt.Println("IsEmailFromDomain results:")
+	fmt.Println(checker.IsEmailFromDomain("jane@eu.acme.com", "acme.com")) // Should return true
+	fmt.Println(checker.IsEmailFromDomain("jane@notacme.com", "acme.com")) // Should return false
+}
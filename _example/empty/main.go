@@ -1,6 +1,7 @@
 package main
 
 import (
+	"database/sql"
 	"fmt"
 	"github.com/tech4works/checker"
 )
@@ -14,11 +15,12 @@ func main() {
 	var nilPointer *int
 
 	fmt.Println("IsNil results:")
-	fmt.Println(checker.IsNil(nil))            // Should return true.
-	fmt.Println(checker.IsNil(3))              // Should return false.
-	fmt.Println(checker.IsNil(student{}))      // Should return false.
-	fmt.Println(checker.IsNil(&map[int]any{})) // Should return false.
-	fmt.Println(checker.IsNil(nilPointer))     // Should return true.
+	fmt.Println(checker.IsNil(nil))              // Should return true.
+	fmt.Println(checker.IsNil(3))                // Should return false.
+	fmt.Println(checker.IsNil(student{}))        // Should return false.
+	fmt.Println(checker.IsNil(&map[int]any{}))   // Should return false.
+	fmt.Println(checker.IsNil(nilPointer))       // Should return true.
+	fmt.Println(checker.IsNil(sql.NullString{})) // Should return true.
 
 	fmt.Println("NonNil results:")
 	fmt.Println(checker.NonNil(nil))               // Should return false.
@@ -29,14 +31,16 @@ func main() {
 	fmt.Println(checker.NonNil(false))             // Should return true.
 
 	fmt.Println("IsEmpty results:")
-	fmt.Println(checker.IsEmpty(" "))                   // Should return true.
-	fmt.Println(checker.IsEmpty([]int{}))               // Should return true.
-	fmt.Println(checker.IsEmpty(student{}))             // Should return true.
-	fmt.Println(checker.IsEmpty(map[string]any{}))      // Should return true.
-	fmt.Println(checker.IsEmpty(0))                     // Should return true.
-	fmt.Println(checker.IsEmpty(student{name: "John"})) // Should return false.
-	fmt.Println(checker.IsEmpty("Go"))                  // Should return false.
-	fmt.Println(checker.IsEmpty(1))                     // Should return false.
+	fmt.Println(checker.IsEmpty(" "))                                       // Should return true.
+	fmt.Println(checker.IsEmpty([]int{}))                                   // Should return true.
+	fmt.Println(checker.IsEmpty(student{}))                                 // Should return true.
+	fmt.Println(checker.IsEmpty(map[string]any{}))                          // Should return true.
+	fmt.Println(checker.IsEmpty(0))                                         // Should return true.
+	fmt.Println(checker.IsEmpty(student{name: "John"}))                     // Should return false.
+	fmt.Println(checker.IsEmpty("Go"))                                      // Should return false.
+	fmt.Println(checker.IsEmpty(1))                                         // Should return false.
+	fmt.Println(checker.IsEmpty(sql.NullString{Valid: false}))              // Should return true.
+	fmt.Println(checker.IsEmpty(sql.NullString{String: "Go", Valid: true})) // Should return false.
 
 	fmt.Println("IsNotEmpty results:")
 	fmt.Println(checker.IsNotEmpty(" "))                            // Should return false.
@@ -50,6 +54,14 @@ func main() {
 	fmt.Println(checker.IsNotEmpty(student{}))                      // Should return false.
 	fmt.Println(checker.IsNotEmpty(student{name: "John", age: 23})) // Should return true.
 
+	fmt.Println("IsEmptyStrict results:")
+	fmt.Println(checker.IsEmptyStrict(""))  // Should return true.
+	fmt.Println(checker.IsEmptyStrict(" ")) // Should return false.
+
+	fmt.Println("IsNotEmptyStrict results:")
+	fmt.Println(checker.IsNotEmptyStrict(" ")) // Should return true.
+	fmt.Println(checker.IsNotEmptyStrict(""))  // Should return false.
+
 	fmt.Println("AllNil results:")
 	fmt.Println(checker.AllNil(nil, nil, nilPointer))                         // Should return true.
 	fmt.Println(checker.AllNil(nil, 0, "hello", student{}, map[string]any{})) // Should return false.
@@ -65,4 +77,19 @@ func main() {
 	fmt.Println("AllNotEmpty results:")
 	fmt.Println(checker.NoneEmpty("hello", 10, []int{1, 2, 3}, true, map[string]any{"test": 1})) // Should return true.
 	fmt.Println(checker.NoneEmpty(" ", "", 0, []int{}, nil, false, student{}, map[string]any{})) // Should return false.
+
+	fmt.Println("IsRequiredIf results:")
+	fmt.Println(checker.IsRequiredIf("", false))     // Should return true.
+	fmt.Println(checker.IsRequiredIf("   ", true))   // Should return false.
+	fmt.Println(checker.IsRequiredIf("value", true)) // Should return true.
+
+	fmt.Println("IsRequiredUnless results:")
+	fmt.Println(checker.IsRequiredUnless("", true))       // Should return true.
+	fmt.Println(checker.IsRequiredUnless("", false))      // Should return false.
+	fmt.Println(checker.IsRequiredUnless("value", false)) // Should return true.
+
+	fmt.Println("IsRequiredWith results:")
+	fmt.Println(checker.IsRequiredWith("", "Main Street"))   // Should return false.
+	fmt.Println(checker.IsRequiredWith("", ""))              // Should return true.
+	fmt.Println(checker.IsRequiredWith("10", "Main Street")) // Should return true.
 }
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte("Hello World"))
+	zw.Close()
+
+	fmt.Println("IsGzipDecompressibleWithin results:")
+	fmt.Println(checker.IsGzipDecompressibleWithin(buf.Bytes(), 1024))   // Should return true
+	fmt.Println(checker.IsGzipDecompressibleWithin(buf.Bytes(), 5))      // Should return false
+	fmt.Println(checker.IsGzipDecompressibleWithin("Hello World", 1024)) // Should return false
+
+	fmt.Println("DecodesToJSON results:")
+	fmt.Println(checker.DecodesToJSON("eyJrZXkiOiJ2YWx1ZSJ9")) // Should return true
+	fmt.Println(checker.DecodesToJSON("WzEsMiwzXQ=="))         // Should return true
+	fmt.Println(checker.DecodesToJSON("SGVsbG8gV29ybGQ="))     // Should return false
+	fmt.Println(checker.DecodesToJSON(`{"key":"value"}`))      // Should return false
+
+	fmt.Println("DecodesToUTF8 results:")
+	fmt.Println(checker.DecodesToUTF8("SGVsbG8gV29ybGQ=")) // Should return true
+	fmt.Println(checker.DecodesToUTF8("w6fDo28="))         // Should return true
+	fmt.Println(checker.DecodesToUTF8("/w=="))             // Should return false
+	fmt.Println(checker.DecodesToUTF8("not base64!"))      // Should return false
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	var errs checker.Errors
+	errs.Add("name", checker.IsFullName("Maria Silva"), "must be a full name")
+	errs.Add("email", checker.IsEmail("invalid"), "must be a valid email")
+	errs.AddIf("document", checker.IsCPF, nil, "must be a valid CPF")
+
+	fmt.Println("HasAny results:")
+	fmt.Println(errs.HasAny()) // Should return true
+
+	fmt.Println("Error results:")
+	fmt.Println(errs.Error()) // Should return email: must be a valid email; document: must be a valid CPF
+
+	fmt.Println("MarshalJSON results:")
+	b, _ := json.Marshal(errs)
+	fmt.Println(string(b)) // Should return {"document":["must be a valid CPF"],"email":["must be a valid email"]}
+
+	fmt.Println("ErrNilValue results:")
+	func() {
+		defer func() {
+			err, _ := recover().(error)
+			fmt.Println(errors.Is(err, checker.ErrNilValue)) // Should return true
+		}()
+		checker.IsCPF(nil)
+	}()
+
+	fmt.Println("ErrUnsupportedType results:")
+	func() {
+		defer func() {
+			var unsupported checker.ErrUnsupportedType
+			err, _ := recover().(error)
+			fmt.Println(errors.As(err, &unsupported), unsupported.Kind) // Should return true chan
+		}()
+		checker.IsCPF(make(chan int))
+	}()
+}
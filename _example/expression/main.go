@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsArithmeticExpression results:")
+	fmt.Println(checker.IsArithmeticExpression("(10 * 1.1) - 5")) // Should return true
+	fmt.Println(checker.IsArithmeticExpression("(1 + 2"))         // Should return false
+
+	fmt.Println("EvaluatesWithinRange results:")
+	fmt.Println(checker.EvaluatesWithinRange("2 + 3 * 4", 0, 20)) // Should return true
+	fmt.Println(checker.EvaluatesWithinRange("1 / 0", 0, 100))    // Should return false
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+	"time"
+)
+
+type booking struct {
+	StartDate string
+	EndDate   time.Time
+}
+
+type priceFilter struct {
+	MinPrice int
+	MaxPrice string
+}
+
+func main() {
+	b := booking{StartDate: "2024-01-10", EndDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)}
+	f := priceFilter{MinPrice: 10, MaxPrice: "20.5"}
+
+	fmt.Println("DateFieldAfter results:")
+	fmt.Println(checker.DateFieldAfter(b, "EndDate", "StartDate")) // Should return true
+	fmt.Println(checker.DateFieldAfter(b, "StartDate", "EndDate")) // Should return false
+
+	fmt.Println("DateFieldBefore results:")
+	fmt.Println(checker.DateFieldBefore(b, "StartDate", "EndDate")) // Should return true
+
+	fmt.Println("NumericFieldLessThan results:")
+	fmt.Println(checker.NumericFieldLessThan(f, "MinPrice", "MaxPrice")) // Should return true
+
+	fmt.Println("NumericFieldGreaterThan results:")
+	fmt.Println(checker.NumericFieldGreaterThan(f, "MaxPrice", "MinPrice")) // Should return true
+}
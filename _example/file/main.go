@@ -0,0 +1,19 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsValidFileName results:")
+	fmt.Println(checker.IsValidFileName("report-2024.pdf")) // Should return true
+	fmt.Println(checker.IsValidFileName("../etc/passwd"))   // Should return false
+	fmt.Println(checker.IsValidFileName("CON.txt"))         // Should return false
+	fmt.Println(checker.IsValidFileName("notes."))          // Should return false
+
+	fmt.Println("IsHiddenFileName results:")
+	fmt.Println(checker.IsHiddenFileName(".env"))     // Should return true
+	fmt.Println(checker.IsHiddenFileName("file.txt")) // Should return false
+	fmt.Println(checker.IsHiddenFileName(".."))       // Should return false
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsRSQLExpression results:")
+	fmt.Println(checker.IsRSQLExpression(`name=="Kill Bill";year=gt=2003`)) // Should return true
+	fmt.Println(checker.IsRSQLExpression("name==;year=gt=2003"))            // Should return false
+
+	fmt.Println("IsODataFilterExpression results:")
+	fmt.Println(checker.IsODataFilterExpression("Price le 200 and contains(Name, 'milk')")) // Should return true
+	fmt.Println(checker.IsODataFilterExpression("Price le"))                                // Should return false
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsNFeAccessKey results:")
+	fmt.Println(checker.IsNFeAccessKey("35240612345678000195550010000012341123456783"))           // Should return true
+	fmt.Println(checker.IsNFeAccessKey("3524 0612 3456 7800 0195 5500 1000 0012 3411 2345 6783")) // Should return true
+	fmt.Println(checker.IsNFeAccessKey("35240612345678000195550010000012341123456784"))           // Should return false
+
+	fmt.Println("IsNFCeKey results:")
+	fmt.Println(checker.IsNFCeKey("35240612345678000195650010000012341123456786")) // Should return true
+	fmt.Println(checker.IsNFCeKey("35240612345678000195550010000012341123456783")) // Should return false
+
+	fmt.Println("IsCTeKey results:")
+	fmt.Println(checker.IsCTeKey("35240612345678000195570010000012341123456780")) // Should return true
+	fmt.Println(checker.IsCTeKey("35240612345678000195550010000012341123456783")) // Should return false
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsGraphQLDocument results:")
+	fmt.Println(checker.IsGraphQLDocument("query GetUser($id: ID!) { user(id: $id) { name } }")) // Should return true
+	fmt.Println(checker.IsGraphQLDocument("type User { name: String }"))                         // Should return false
+
+	fmt.Println("IsGraphQLOperationName results:")
+	fmt.Println(checker.IsGraphQLOperationName("GetUser"))  // Should return true
+	fmt.Println(checker.IsGraphQLOperationName("get-user")) // Should return false
+
+	fmt.Println("HasMaxQueryDepth results:")
+	fmt.Println(checker.HasMaxQueryDepth("{ user { name } }", 2))                         // Should return true
+	fmt.Println(checker.HasMaxQueryDepth("{ user { friends { friends { name } } } }", 3)) // Should return false
+}
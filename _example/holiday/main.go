@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+	"time"
+)
+
+func main() {
+	checker.RegisterHolidays("SP", []time.Time{time.Date(2024, time.July, 9, 0, 0, 0, 0, time.UTC)})
+
+	fmt.Println("IsBrazilianHoliday results:")
+	fmt.Println(checker.IsBrazilianHoliday("2024-12-25")) // Should return true
+	fmt.Println(checker.IsBrazilianHoliday("2024-03-29")) // Should return true (Good Friday)
+	fmt.Println(checker.IsBrazilianHoliday("2024-03-28")) // Should return false
+
+	fmt.Println("IsRegionalHoliday results:")
+	fmt.Println(checker.IsRegionalHoliday("SP", "2024-07-09")) // Should return true
+	fmt.Println(checker.IsRegionalHoliday("RJ", "2024-07-09")) // Should return false
+
+	fmt.Println("IsBusinessDay results:")
+	fmt.Println(checker.IsBusinessDay("2024-07-08"))       // Should return true
+	fmt.Println(checker.IsBusinessDay("2024-07-09", "SP")) // Should return false
+	fmt.Println(checker.IsBusinessDay("2024-07-13"))       // Should return false (Saturday)
+	fmt.Println(checker.IsBusinessDay("2024-12-25"))       // Should return false
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsICUMessageFormat results:")
+	fmt.Println(checker.IsICUMessageFormat("{count, plural, one {# item} other {# items}}")) // Should return true
+	fmt.Println(checker.IsICUMessageFormat("{count, plural, one {# item}}"))                 // Should return false
+
+	fmt.Println("IsTranslationKey results:")
+	fmt.Println(checker.IsTranslationKey("checkout.payment.card_declined")) // Should return true
+	fmt.Println(checker.IsTranslationKey("checkout..title"))                // Should return false
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/tech4works/checker"
+	"image"
+	"image/png"
+)
+
+func main() {
+	var buffer bytes.Buffer
+	_ = png.Encode(&buffer, image.NewGray(image.Rect(0, 0, 200, 200)))
+	avatar := buffer.Bytes()
+
+	opts := checker.ImageConstraints{MaxWidth: 512, MaxHeight: 512, MaxBytes: 1 << 20, Formats: []string{"png", "jpeg"}}
+
+	fmt.Println("ImageSatisfies results:")
+	fmt.Println(checker.ImageSatisfies(avatar, opts))                                    // Should return true <nil>
+	fmt.Println(checker.ImageSatisfies(avatar, checker.ImageConstraints{MaxWidth: 100})) // Should return false <nil>
+	fmt.Println(checker.ImageSatisfies([]byte("not an image"), opts))                    // Should return false image: unknown format
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+type User struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+func main() {
+	fmt.Println("IsMapWithOptions results:")
+	fmt.Println(checker.IsMapWithOptions(`{"key":"value"}`, checker.JSONOptions{RejectNull: true})) // Should return true
+	fmt.Println(checker.IsMapWithOptions("null", checker.JSONOptions{}))                            // Should return true
+	fmt.Println(checker.IsMapWithOptions("null", checker.JSONOptions{RejectNull: true}))            // Should return false
+
+	fmt.Println("IsSliceWithOptions results:")
+	fmt.Println(checker.IsSliceWithOptions(`[1, 2, 3]`, checker.JSONOptions{RejectNull: true})) // Should return true
+	fmt.Println(checker.IsSliceWithOptions("null", checker.JSONOptions{}))                      // Should return true
+	fmt.Println(checker.IsSliceWithOptions("null", checker.JSONOptions{RejectNull: true}))      // Should return false
+
+	fmt.Println("IsJSONNull results:")
+	fmt.Println(checker.IsJSONNull("null"))    // Should return true
+	fmt.Println(checker.IsJSONNull(" null\n")) // Should return true
+	fmt.Println(checker.IsJSONNull(`"null"`))  // Should return false
+	fmt.Println(checker.IsJSONNull("{}"))      // Should return false
+
+	fmt.Println("IsEmptyJSONObject results:")
+	fmt.Println(checker.IsEmptyJSONObject("{}"))              // Should return true
+	fmt.Println(checker.IsEmptyJSONObject("{ }"))             // Should return true
+	fmt.Println(checker.IsEmptyJSONObject(`{"key":"value"}`)) // Should return false
+	fmt.Println(checker.IsEmptyJSONObject("null"))            // Should return false
+
+	fmt.Println("IsEmptyJSONArray results:")
+	fmt.Println(checker.IsEmptyJSONArray("[]"))   // Should return true
+	fmt.Println(checker.IsEmptyJSONArray("[ ]"))  // Should return true
+	fmt.Println(checker.IsEmptyJSONArray("[1]"))  // Should return false
+	fmt.Println(checker.IsEmptyJSONArray("null")) // Should return false
+
+	fmt.Println("IsNonEmptyJSON results:")
+	fmt.Println(checker.IsNonEmptyJSON(`{"key":"value"}`)) // Should return true
+	fmt.Println(checker.IsNonEmptyJSON("[1]"))             // Should return true
+	fmt.Println(checker.IsNonEmptyJSON("{}"))              // Should return false
+	fmt.Println(checker.IsNonEmptyJSON("null"))            // Should return false
+
+	fmt.Println("IsJSONValue results:")
+	fmt.Println(checker.IsJSONValue(`{"key":"value"}`)) // Should return true
+	fmt.Println(checker.IsJSONValue(`"text"`))          // Should return true
+	fmt.Println(checker.IsJSONValue("1e400"))           // Should return true
+	fmt.Println(checker.IsJSONValue("text"))            // Should return false
+
+	fmt.Println("IsJSONString results:")
+	fmt.Println(checker.IsJSONString(`"text"`))        // Should return true
+	fmt.Println(checker.IsJSONString(`"line\nbreak"`)) // Should return true
+	fmt.Println(checker.IsJSONString("text"))          // Should return false
+	fmt.Println(checker.IsJSONString("null"))          // Should return false
+
+	fmt.Println("IsJSONNumber results:")
+	fmt.Println(checker.IsJSONNumber("42"))   // Should return true
+	fmt.Println(checker.IsJSONNumber(-1.5))   // Should return true
+	fmt.Println(checker.IsJSONNumber("01"))   // Should return false
+	fmt.Println(checker.IsJSONNumber(`"42"`)) // Should return false
+
+	fmt.Println("IsJSONBoolean results:")
+	fmt.Println(checker.IsJSONBoolean("true"))   // Should return true
+	fmt.Println(checker.IsJSONBoolean(false))    // Should return true
+	fmt.Println(checker.IsJSONBoolean("True"))   // Should return false
+	fmt.Println(checker.IsJSONBoolean(`"true"`)) // Should return false
+
+	fmt.Println("UnmarshalsInto results:")
+	fmt.Println(checker.UnmarshalsInto[User](`{"name":"Maria"}`))              // Should return true
+	fmt.Println(checker.UnmarshalsInto[User](`{"name":"Maria","admin":true}`)) // Should return false
+	fmt.Println(checker.UnmarshalsInto[User](`{"email":"maria@example.com"}`)) // Should return true
+	fmt.Println(checker.UnmarshalsInto[User](`{"name":42}`))                   // Should return false
+
+	fmt.Println("CanUnmarshalInto results:")
+	fmt.Println(checker.CanUnmarshalInto[User](`{"name":"Maria"}`)) // Should return true <nil>
+	fmt.Println(checker.CanUnmarshalInto[User](`{"admin":true}`))   // Should return false admin: is unknown; name: is missing
+	fmt.Println(checker.CanUnmarshalInto[User](nil))                // Should return false error getting a string: value is nil
+
+	fmt.Println("HasMaxDepth results:")
+	fmt.Println(checker.HasMaxDepth(`{"a": [1]}`, 2))             // Should return true
+	fmt.Println(checker.HasMaxDepth(`{"a": {"b": {"c": 1}}}`, 2)) // Should return false
+	fmt.Println(checker.HasMaxDepth(`"text"`, 0))                 // Should return true
+	fmt.Println(checker.HasMaxDepth(`{"a": [1}`, 5))              // Should return false
+
+	fmt.Println("HasMaxTotalKeys results:")
+	fmt.Println(checker.HasMaxTotalKeys(`{"a": 1, "b": {"c": 2}}`, 3)) // Should return true
+	fmt.Println(checker.HasMaxTotalKeys(`[{"a": 1}, {"a": 2}]`, 1))    // Should return false
+	fmt.Println(checker.HasMaxTotalKeys(`[1, 2, 3]`, 0))               // Should return true
+
+	fmt.Println("HasMaxStringValueLength results:")
+	fmt.Println(checker.HasMaxStringValueLength(`{"name": "Maria"}`, 5))        // Should return true
+	fmt.Println(checker.HasMaxStringValueLength(`["short", "much longer"]`, 5)) // Should return false
+	fmt.Println(checker.HasMaxStringValueLength(`{"a_long_key": 1}`, 1))        // Should return true
+
+	fmt.Println("KeysMatchConvention results:")
+	fmt.Println(checker.KeysMatchConvention(`{"user_id": [{"unit_price": 2}]}`, checker.NamingConventionSnakeCase)) // Should return true
+	fmt.Println(checker.KeysMatchConvention(`{"userId": [{"unit_price": 2}]}`, checker.NamingConventionCamelCase))  // Should return false
+	fmt.Println(checker.KeysMatchConvention(map[string]int{"max-age": 60}, checker.NamingConventionKebabCase))      // Should return true
+
+	fmt.Println("EqualsCanonicalJSON results:")
+	fmt.Println(checker.EqualsCanonicalJSON(`{"a": 1, "b": [true]}`, `{"b":[true],"a":1.0}`)) // Should return true
+	fmt.Println(checker.EqualsCanonicalJSON(`[1, 2]`, `[2, 1]`))                              // Should return false
+	fmt.Println(checker.EqualsCanonicalJSON(`{"price": 1e2}`, map[string]int{"price": 100}))  // Should return true
+	fmt.Println(checker.EqualsCanonicalJSON(`{"a": 1}`, `{"a": 1`))                           // Should return false
+}
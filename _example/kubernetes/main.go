@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsDNS1123Label results:")
+	fmt.Println(checker.IsDNS1123Label("my-app")) // Should return true
+	fmt.Println(checker.IsDNS1123Label("My-App")) // Should return false
+
+	fmt.Println("IsDNS1123Subdomain results:")
+	fmt.Println(checker.IsDNS1123Subdomain("api.example.com")) // Should return true
+	fmt.Println(checker.IsDNS1123Subdomain("api..example"))    // Should return false
+
+	fmt.Println("IsK8sResourceName results:")
+	fmt.Println(checker.IsK8sResourceName("web-frontend-v2")) // Should return true
+	fmt.Println(checker.IsK8sResourceName("Web_Frontend"))    // Should return false
+
+	fmt.Println("IsK8sNamespaceName results:")
+	fmt.Println(checker.IsK8sNamespaceName("payments-staging")) // Should return true
+	fmt.Println(checker.IsK8sNamespaceName("payments.staging")) // Should return false
+
+	fmt.Println("IsSemverTag results:")
+	fmt.Println(checker.IsSemverTag("v1.4.2"))       // Should return true
+	fmt.Println(checker.IsSemverTag("1.0.0-rc.1"))   // Should return true
+	fmt.Println(checker.IsSemverTag("1.0.0+build1")) // Should return false
+	fmt.Println(checker.IsSemverTag("latest"))       // Should return false
+}
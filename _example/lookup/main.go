@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+	"strings"
+)
+
+func main() {
+	fmt.Println("RuleByName results:")
+	rule, ok := checker.RuleByName("is_cpf")
+	fmt.Println(ok, rule("12101721007")) // Should return true true
+	_, ok = checker.RuleByName("IsCPF")
+	fmt.Println(ok) // Should return false
+
+	fmt.Println("RegisterRule results:")
+	checker.RegisterRule("is_short_text", func(a any) bool { return len(fmt.Sprint(a)) <= 10 })
+	rule, _ = checker.RuleByName("is_short_text")
+	fmt.Println(rule("hello"))                 // Should return true
+	fmt.Println(rule(strings.Repeat("a", 11))) // Should return false
+
+	fmt.Println("RuleNames results:")
+	fmt.Println(checker.Contains(checker.RuleNames(), "is_email")) // Should return true
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsMarkdown results:")
+	fmt.Println(checker.IsMarkdown("# Title\n\nSee [the docs](https://example.com).")) // Should return true
+	fmt.Println(checker.IsMarkdown("```go\nfmt.Println(1)"))                           // Should return false
+
+	fmt.Println("HasMaxHeadingLevel results:")
+	fmt.Println(checker.HasMaxHeadingLevel("# Title\n## Section", 2))  // Should return true
+	fmt.Println(checker.HasMaxHeadingLevel("# Title\n### Details", 2)) // Should return false
+
+	fmt.Println("ContainsRawHTMLInMarkdown results:")
+	fmt.Println(checker.ContainsRawHTMLInMarkdown("Hello <script>alert(1)</script>")) // Should return true
+	fmt.Println(checker.ContainsRawHTMLInMarkdown("Use `<div>` for blocks"))          // Should return false
+
+	fmt.Println("ListMarkdownLinks results:")
+	fmt.Println(checker.ListMarkdownLinks("[docs](https://example.com) ![logo](/logo.png)")) // Should return [https://example.com /logo.png]
+
+	fmt.Println("HasSafeMarkdownLinks results:")
+	fmt.Println(checker.HasSafeMarkdownLinks("[docs](https://example.com) [home](/)")) // Should return true
+	fmt.Println(checker.HasSafeMarkdownLinks("[click](javascript:alert(1))"))          // Should return false
+}
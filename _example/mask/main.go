@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("MaskKeep results:")
+	fmt.Println(checker.MaskKeep("secret-token", 2, 2)) // Should return se********en
+	fmt.Println(checker.MaskKeep("abc", 2, 2))          // Should return ***
+
+	fmt.Println("MaskCPF results:")
+	fmt.Println(checker.MaskCPF("12101721007"))    // Should return 121.***.***-07 true
+	fmt.Println(checker.MaskCPF("111.111.111-11")) // Should return "" false
+
+	fmt.Println("MaskEmail results:")
+	fmt.Println(checker.MaskEmail("john.doe@example.com")) // Should return j******e@example.com true
+	fmt.Println(checker.MaskEmail("not an email"))         // Should return "" false
+
+	fmt.Println("MaskCreditCard results:")
+	fmt.Println(checker.MaskCreditCard("4111 1111 1111 1111")) // Should return ************1111 true
+	fmt.Println(checker.MaskCreditCard("1234 5678"))           // Should return "" false
+}
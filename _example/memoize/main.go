@@ -0,0 +1,14 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("Memoize results:")
+	isCNPJ := checker.Memoize(checker.IsCNPJ, 10_000)
+	fmt.Println(isCNPJ("11222333000181")) // Should return true
+	fmt.Println(isCNPJ("11222333000181")) // Should return true, served from the cache
+	fmt.Println(isCNPJ("11222333000182")) // Should return false
+}
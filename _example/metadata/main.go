@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsMetadataKey results:")
+	fmt.Println(checker.IsMetadataKey("x-request-id", 63)) // Should return true
+	fmt.Println(checker.IsMetadataKey("X-Request-ID", 63)) // Should return false
+	fmt.Println(checker.IsMetadataKey("x-request-id", 5))  // Should return false
+
+	fmt.Println("IsMetadataValue results:")
+	fmt.Println(checker.IsMetadataValue("Bearer abc.def", 256)) // Should return true
+	fmt.Println(checker.IsMetadataValue("line\nbreak", 256))    // Should return false
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsSnakeCase results:")
+	fmt.Println(checker.IsSnakeCase("created_at")) // Should return true
+	fmt.Println(checker.IsSnakeCase("createdAt"))  // Should return false
+
+	fmt.Println("IsCamelCase results:")
+	fmt.Println(checker.IsCamelCase("createdAt")) // Should return true
+	fmt.Println(checker.IsCamelCase("CreatedAt")) // Should return false
+
+	fmt.Println("IsPascalCase results:")
+	fmt.Println(checker.IsPascalCase("CreatedAt"))  // Should return true
+	fmt.Println(checker.IsPascalCase("created_at")) // Should return false
+
+	fmt.Println("IsKebabCase results:")
+	fmt.Println(checker.IsKebabCase("created-at")) // Should return true
+	fmt.Println(checker.IsKebabCase("created_at")) // Should return false
+
+	fmt.Println("MatchesConvention results:")
+	fmt.Println(checker.MatchesConvention("created_at", checker.NamingConventionSnakeCase)) // Should return true
+	fmt.Println(checker.MatchesConvention("created_at", checker.NamingConventionKebabCase)) // Should return false
+
+	fmt.Println("MatchesConventionWithOptions results:")
+	strict := checker.NamingOptions{NoDigits: true}
+	fmt.Println(checker.MatchesConventionWithOptions("user_name", checker.NamingConventionSnakeCase, strict)) // Should return true
+	fmt.Println(checker.MatchesConventionWithOptions("utf8_name", checker.NamingConventionSnakeCase, strict)) // Should return false
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/tech4works/checker/netcheck"
+	"time"
+)
+
+func main() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	fmt.Println("IsResolvableHost results:")
+	fmt.Println(netcheck.IsResolvableHost(ctx, "google.com"))      // Should return true
+	fmt.Println(netcheck.IsResolvableHost(ctx, "127.0.0.1"))       // Should return true
+	fmt.Println(netcheck.IsResolvableHost(ctx, "unknown.invalid")) // Should return false
+
+	fmt.Println("HasMXRecord results:")
+	fmt.Println(netcheck.HasMXRecord(ctx, "gmail.com"))         // Should return true
+	fmt.Println(netcheck.HasMXRecord(ctx, "someone@gmail.com")) // Should return true
+	fmt.Println(netcheck.HasMXRecord(ctx, "unknown.invalid"))   // Should return false
+
+	fmt.Println("IsReachableURL results:")
+	fmt.Println(netcheck.IsReachableURL(ctx, "https://google.com", 3*time.Second))    // Should return true
+	fmt.Println(netcheck.IsReachableURL(ctx, "ftp://google.com", 3*time.Second))      // Should return false
+	fmt.Println(netcheck.IsReachableURL(ctx, "https://unknown.invalid", time.Second)) // Should return false
+
+	fmt.Println("IsSafeWebhookURL results:")
+	fmt.Println(netcheck.IsSafeWebhookURL(ctx, "https://google.com/hook"))                       // Should return true
+	fmt.Println(netcheck.IsSafeWebhookURL(ctx, "http://169.254.169.254/latest/meta-data"))       // Should return false
+	fmt.Println(netcheck.IsSafeWebhookURL(ctx, "http://localhost:8080/hook"))                    // Should return false
+	fmt.Println(netcheck.IsSafeWebhookURL(ctx, "https://93.184.216.34/hook", "93.184.216.0/24")) // Should return false
+
+	fmt.Println("HasPublicMarkdownLinks results:")
+	fmt.Println(netcheck.HasPublicMarkdownLinks(ctx, "[docs](https://google.com) [home](/)")) // Should return true
+	fmt.Println(netcheck.HasPublicMarkdownLinks(ctx, "[admin](http://127.0.0.1:8080/admin)")) // Should return false
+}
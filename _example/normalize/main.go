@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("NormalizeCPF results:")
+	fmt.Println(checker.NormalizeCPF("121.017.210-07")) // Should return 12101721007 true
+	fmt.Println(checker.NormalizeCPF("111.111.111-11")) // Should return "" false
+
+	fmt.Println("NormalizeCNPJ results:")
+	fmt.Println(checker.NormalizeCNPJ("47.263.759/0001-20")) // Should return 47263759000120 true
+	fmt.Println(checker.NormalizeCNPJ("00.000.000/0001-00")) // Should return "" false
+
+	fmt.Println("NormalizeEmail results:")
+	fmt.Println(checker.NormalizeEmail("  John.Doe@Example.COM ")) // Should return john.doe@example.com true
+	fmt.Println(checker.NormalizeEmail("not an email"))            // Should return "" false
+
+	fmt.Println("NormalizePhone results:")
+	fmt.Println(checker.NormalizePhone("(11) 98765-4321", "55"))   // Should return +5511987654321 true
+	fmt.Println(checker.NormalizePhone("+1 (415) 555-2671", "55")) // Should return +14155552671 true
+	fmt.Println(checker.NormalizePhone("phone", "55"))             // Should return "" false
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsRomanNumeral results:")
+	fmt.Println(checker.IsRomanNumeral("XIV"))  // Should return true
+	fmt.Println(checker.IsRomanNumeral("IIII")) // Should return false
+
+	fmt.Println("IsOrdinalString results:")
+	fmt.Println(checker.IsOrdinalString("21st")) // Should return true
+	fmt.Println(checker.IsOrdinalString("2ª"))   // Should return true
+	fmt.Println(checker.IsOrdinalString("11st")) // Should return false
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+	"time"
+)
+
+func main() {
+	fmt.Println("SetObserver results:")
+	checker.SetObserver(func(name string, ok bool, d time.Duration) {
+		fmt.Println(name, ok)
+	})
+	checker.IsCPF("12101721007") // Should print IsCPF true
+	checker.IsEmail("invalid")   // Should print IsEmail false
+	checker.SetObserver(nil)
+	checker.IsCPF("12101721007") // Should print nothing
+}
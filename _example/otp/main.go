@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsOTPCode results:")
+	fmt.Println(checker.IsOTPCode("012345", 6)) // Should return true
+	fmt.Println(checker.IsOTPCode("12345", 6))  // Should return false
+
+	fmt.Println("IsTOTPSecret results:")
+	fmt.Println(checker.IsTOTPSecret("JBSWY3DPEHPK3PXP")) // Should return true
+	fmt.Println(checker.IsTOTPSecret("JBSWY3DP"))         // Should return false
+
+	fmt.Println("IsRecoveryCode results:")
+	fmt.Println(checker.IsRecoveryCode("****-****", "a1b2-c3d4"))    // Should return true
+	fmt.Println(checker.IsRecoveryCode("#####-#####", "1234567890")) // Should return false
+}
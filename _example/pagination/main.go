@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsValidPage results:")
+	fmt.Println(checker.IsValidPage("1")) // Should return true
+	fmt.Println(checker.IsValidPage("0")) // Should return false
+
+	fmt.Println("IsPageSizeWithin results:")
+	fmt.Println(checker.IsPageSizeWithin("50", 100))  // Should return true
+	fmt.Println(checker.IsPageSizeWithin("500", 100)) // Should return false
+
+	fmt.Println("IsOpaqueCursor results:")
+	fmt.Println(checker.IsOpaqueCursor("eyJpZCI6NDJ9")) // Should return true
+	fmt.Println(checker.IsOpaqueCursor("eyJpZCI6+DJ9")) // Should return false
+
+	fmt.Println("IsSortExpression results:")
+	fmt.Println(checker.IsSortExpression("name,-created_at"))             // Should return true
+	fmt.Println(checker.IsSortExpression("-price", "name", "created_at")) // Should return false
+}
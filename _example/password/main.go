@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsCommonPassword results:")
+	fmt.Println(checker.IsCommonPassword("123456"))                       // Should return true
+	fmt.Println(checker.IsCommonPassword("P@ssw0rd"))                     // Should return true
+	fmt.Println(checker.IsCommonPassword("correct-horse-battery-staple")) // Should return false
+
+	fmt.Println("ContainsUserInfo results:")
+	fmt.Println(checker.ContainsUserInfo("M4ria2024!", "Maria Silva", "maria@example.com")) // Should return true
+	fmt.Println(checker.ContainsUserInfo("T7#kq9!vLm", "Maria Silva", "maria@example.com")) // Should return false
+}
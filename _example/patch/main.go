@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsJSONMergePatch results:")
+	fmt.Println(checker.IsJSONMergePatch(`{"name": "Maria", "phone": null}`))     // Should return true
+	fmt.Println(checker.IsJSONMergePatch(`{}`))                                   // Should return true
+	fmt.Println(checker.IsJSONMergePatch(`[{"op": "remove", "path": "/phone"}]`)) // Should return false
+	fmt.Println(checker.IsJSONMergePatch("null"))                                 // Should return false
+
+	fmt.Println("IsJSONPatch results:")
+	fmt.Println(checker.IsJSONPatch(`[{"op": "replace", "path": "/name", "value": "Maria"}]`)) // Should return true
+	fmt.Println(checker.IsJSONPatch(`[{"op": "move", "from": "/a", "path": "/a/b"}]`))         // Should return false
+	fmt.Println(checker.IsJSONPatch(`[{"op": "add", "path": "name", "value": 1}]`))            // Should return false
+	fmt.Println(checker.IsJSONPatch(`{"name": "Maria"}`))                                      // Should return false
+
+	fmt.Println("PatchTargetsAllowedPaths results:")
+	ops := `[{"op": "replace", "path": "/address/city", "value": "Recife"}]`
+	fmt.Println(checker.PatchTargetsAllowedPaths(ops, "/name", "/address"))                           // Should return true
+	fmt.Println(checker.PatchTargetsAllowedPaths(`{"name": "Maria", "role": "admin"}`, "/name"))      // Should return false
+	fmt.Println(checker.PatchTargetsAllowedPaths(`{"address": {"city": "Recife"}}`, "/address/city")) // Should return true
+	copied := `[{"op": "copy", "from": "/password", "path": "/name"}]`
+	fmt.Println(checker.PatchTargetsAllowedPaths(copied, "/name")) // Should return false
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsISOWeek results:")
+	fmt.Println(checker.IsISOWeek("2024-W07")) // Should return true
+	fmt.Println(checker.IsISOWeek("2024-W53")) // Should return false
+
+	fmt.Println("IsYearMonth results:")
+	fmt.Println(checker.IsYearMonth("2024-02")) // Should return true
+	fmt.Println(checker.IsYearMonth("2024-13")) // Should return false
+
+	fmt.Println("IsQuarter results:")
+	fmt.Println(checker.IsQuarter("2024-Q1")) // Should return true
+	fmt.Println(checker.IsQuarter("2024-Q5")) // Should return false
+
+	fmt.Println("PeriodContains results:")
+	fmt.Println(checker.PeriodContains("2024-Q1", "2024-03-31"))  // Should return true
+	fmt.Println(checker.PeriodContains("2024-Q1", "2024-04-01"))  // Should return false
+	fmt.Println(checker.PeriodContains("2025-W01", "2024-12-30")) // Should return true
+}
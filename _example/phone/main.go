@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsValidDDD results:")
+	fmt.Println(checker.IsValidDDD("11")) // Should return true
+	fmt.Println(checker.IsValidDDD(20))   // Should return false
+
+	fmt.Println("IsBrazilMobile results:")
+	fmt.Println(checker.IsBrazilMobile("(11) 98765-4321")) // Should return true
+	fmt.Println(checker.IsBrazilMobile("(11) 3456-7890"))  // Should return false
+
+	fmt.Println("IsBrazilLandline results:")
+	fmt.Println(checker.IsBrazilLandline("(11) 3456-7890"))  // Should return true
+	fmt.Println(checker.IsBrazilLandline("(11) 98765-4321")) // Should return false
+
+	fmt.Println("IsWhatsAppNumber results:")
+	fmt.Println(checker.IsWhatsAppNumber("5511987654321"))   // Should return true
+	fmt.Println(checker.IsWhatsAppNumber("(11) 98765-4321")) // Should return false
+}
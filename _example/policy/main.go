@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+	"strings"
+)
+
+func main() {
+	fmt.Println("LoadPolicy results:")
+	policy, err := checker.LoadPolicy(strings.NewReader(`
+# checkout rules
+customer.email: [is_email]
+customer.document: [optional, is_cpf]
+amount: [is_positive, max=10000]
+`))
+	fmt.Println(err) // Should return <nil>
+	_, err = checker.LoadPolicy(strings.NewReader("amount: [is_unknown]"))
+	fmt.Println(err != nil) // Should return true
+
+	fmt.Println("Evaluate results:")
+	report := policy.Evaluate(map[string]any{"customer": map[string]any{"email": "test@example.com"}, "amount": 100})
+	fmt.Println(report.Passed()) // Should return true
+	report = policy.Evaluate(`{"customer": {"email": "invalid"}, "amount": 20000}`)
+	fmt.Println(report.Passed())       // Should return false
+	fmt.Println(report.Errors.Error()) // Should return customer.email: failed is_email; amount: failed max=10000
+}
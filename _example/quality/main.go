@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	rows := []string{"529.982.247-25", "111.111.111-11", "121.017.210-07", "invalid"}
+	emails := []string{"ana@example.com", "bob@example.com", "not an email"}
+
+	fmt.Println("PercentSatisfying results:")
+	fmt.Println(checker.PercentSatisfying(rows, checker.IsCPF)) // Should return 50
+
+	fmt.Println("AtLeastNSatisfy results:")
+	fmt.Println(checker.AtLeastNSatisfy(emails, 2, checker.IsEmail)) // Should return true
+	fmt.Println(checker.AtLeastNSatisfy(emails, 3, checker.IsEmail)) // Should return false
+
+	fmt.Println("AtMostNSatisfy results:")
+	fmt.Println(checker.AtMostNSatisfy(emails, 1, checker.IsNotEmail)) // Should return true
+	fmt.Println(checker.AtMostNSatisfy(emails, 1, checker.IsEmail))    // Should return false
+}
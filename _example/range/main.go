@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsValidRange results:")
+	fmt.Println(checker.IsValidRange(1, 10))                      // Should return true
+	fmt.Println(checker.IsValidRange("2024-01-10", "2024-01-01")) // Should return false
+	fmt.Println(checker.IsValidRange("20240101", "2024-02-01"))   // Should return false
+
+	fmt.Println("IsNonEmptyRange results:")
+	fmt.Println(checker.IsNonEmptyRange("2024-01-01", "2024-01-10")) // Should return true
+	fmt.Println(checker.IsNonEmptyRange(10, 10))                     // Should return false
+
+	fmt.Println("RangeWithin results:")
+	fmt.Println(checker.RangeWithin("2024-01-01", "2024-01-31", "2024-01-10", "2024-01-15")) // Should return true
+	fmt.Println(checker.RangeWithin(0, 100, 90, 110))                                        // Should return false
+}
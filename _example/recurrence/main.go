@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+	"time"
+)
+
+func main() {
+	fmt.Println("IsAnniversaryOf results:")
+	fmt.Println(checker.IsAnniversaryOf("2025-03-15", "2020-03-15")) // Should return true
+	fmt.Println(checker.IsAnniversaryOf("2025-02-28", "2020-02-29")) // Should return false
+
+	fmt.Println("IsAnniversaryOfWithOptions results:")
+	feb28 := checker.RecurrenceOptions{MissingDay: checker.MissingDayLastOfMonth}
+	fmt.Println(checker.IsAnniversaryOfWithOptions("2025-02-28", "2020-02-29", feb28)) // Should return true
+	fmt.Println(checker.IsAnniversaryOfWithOptions("2024-02-28", "2020-02-29", feb28)) // Should return false
+
+	fmt.Println("OccursOnWeekday results:")
+	fmt.Println(checker.OccursOnWeekday("2024-01-01", time.Monday)) // Should return true
+	fmt.Println(checker.OccursOnWeekday("2024-01-01", time.Sunday)) // Should return false
+
+	fmt.Println("OccursOnDayOfMonth results:")
+	fmt.Println(checker.OccursOnDayOfMonth("2024-03-10", 10)) // Should return true
+	fmt.Println(checker.OccursOnDayOfMonth("2024-04-30", 31)) // Should return false
+
+	fmt.Println("OccursOnDayOfMonthWithOptions results:")
+	monthEnd := checker.RecurrenceOptions{MissingDay: checker.MissingDayLastOfMonth}
+	fmt.Println(checker.OccursOnDayOfMonthWithOptions("2024-04-30", 31, monthEnd)) // Should return true
+	fmt.Println(checker.OccursOnDayOfMonthWithOptions("2024-02-28", 30, monthEnd)) // Should return false
+}
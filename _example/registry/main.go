@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsGenderCode results:")
+	fmt.Println(checker.IsGenderCode("F"))                                           // Should return true
+	fmt.Println(checker.IsGenderCode("m"))                                           // Should return true
+	fmt.Println(checker.IsGenderCode("O", checker.GenderMale, checker.GenderFemale)) // Should return false
+
+	fmt.Println("IsMaritalStatus results:")
+	fmt.Println(checker.IsMaritalStatus("MARRIED"))     // Should return true
+	fmt.Println(checker.IsMaritalStatus("complicated")) // Should return false
+
+	fmt.Println("IsBrazilUF results:")
+	fmt.Println(checker.IsBrazilUF("SP")) // Should return true
+	fmt.Println(checker.IsBrazilUF("XX")) // Should return false
+
+	fmt.Println("IsBrazilMunicipalityIBGECode results:")
+	fmt.Println(checker.IsBrazilMunicipalityIBGECode("3550308")) // Should return true
+	fmt.Println(checker.IsBrazilMunicipalityIBGECode("3550309")) // Should return false
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsVersionedAPIPath results:")
+	fmt.Println(checker.IsVersionedAPIPath("/v1/users")) // Should return true
+	fmt.Println(checker.IsVersionedAPIPath("/users"))    // Should return false
+
+	fmt.Println("PathMatchesTemplate results:")
+	template := "/users/{id}/orders/{orderId}"
+	fmt.Println(checker.PathMatchesTemplate("/users/42/orders/7", template))     // Should return true
+	fmt.Println(checker.PathMatchesTemplate("/users/../orders/7", template))     // Should return false
+	fmt.Println(checker.PathMatchesTemplate("/users/%2e%2e/orders/7", template)) // Should return false
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("Quietly results:")
+	fmt.Println(checker.Quietly(func() bool { return checker.IsCPF("12101721007") })) // Should return true false
+	fmt.Println(checker.Quietly(func() bool { return checker.IsCPF(nil) }))           // Should return false true
+
+	fmt.Println("Guard results:")
+	isEmail := checker.Guard(checker.IsEmail)
+	fmt.Println(isEmail("test@example.com")) // Should return true
+	fmt.Println(isEmail(nil))                // Should return false
+
+	fmt.Println("AllTrue results:")
+	fmt.Println(checker.AllTrue(checker.IsCPF("12101721007"), checker.IsEmail("test@example.com"))) // Should return true
+	fmt.Println(checker.AllTrue(checker.IsCPF("12101721007"), checker.IsEmail("invalid")))          // Should return false
+
+	fmt.Println("AnyTrue results:")
+	fmt.Println(checker.AnyTrue(checker.IsCPF("invalid"), checker.IsCNPJ("11222333000181"))) // Should return true
+	fmt.Println(checker.AnyTrue(checker.IsCPF("invalid"), checker.IsCNPJ("invalid")))        // Should return false
+
+	fmt.Println("FirstFalse results:")
+	fmt.Println(checker.FirstFalse(map[string]bool{
+		"document": checker.IsCPF("12101721007"),
+		"email":    checker.IsEmail("invalid"),
+	})) // Should return email true
+}
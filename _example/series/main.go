@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+	"time"
+)
+
+func main() {
+	candles := []string{"2024-01-01 10:00:00", "2024-01-01 10:01:00", "2024-01-01 10:05:00"}
+
+	fmt.Println("IsMonotonicIncreasing results:")
+	fmt.Println(checker.IsMonotonicIncreasing([]int{1, 2, 2, 3}))  // Should return true
+	fmt.Println(checker.IsMonotonicIncreasing([]float64{1, 3, 2})) // Should return false
+
+	fmt.Println("IsStrictlyIncreasing results:")
+	fmt.Println(checker.IsStrictlyIncreasing(candles))           // Should return true
+	fmt.Println(checker.IsStrictlyIncreasing([]int{1, 2, 2, 3})) // Should return false
+
+	fmt.Println("HasGapLargerThan results:")
+	fmt.Println(checker.HasGapLargerThan(candles, time.Minute))   // Should return true
+	fmt.Println(checker.HasGapLargerThan(candles, 5*time.Minute)) // Should return false
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	allowlist := checker.NewSet([]string{"admin", "operator"})
+
+	fmt.Println("Set.Contains results:")
+	fmt.Println(allowlist.Contains("admin"))  // Should return true
+	fmt.Println(allowlist.Contains("viewer")) // Should return false
+
+	fmt.Println("Set.NotContains results:")
+	fmt.Println(allowlist.NotContains("viewer")) // Should return true
+	fmt.Println(allowlist.NotContains("admin"))  // Should return false
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+	"time"
+)
+
+func main() {
+	fmt.Println("IsValidHMACSignature results:")
+	signature := "88aab3ede8d3adf94d26ab90d3bafd4a2083070c3bcce9c014ee04a443847c0b"
+	fmt.Println(checker.IsValidHMACSignature("hello", signature, []byte("secret"), checker.HashAlgoSHA256))  // Should return true
+	fmt.Println(checker.IsValidHMACSignature("hello!", signature, []byte("secret"), checker.HashAlgoSHA256)) // Should return false
+
+	fmt.Println("IsValidGitHubWebhookSignature results:")
+	header := "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"
+	fmt.Println(checker.IsValidGitHubWebhookSignature("Hello, World!", header, []byte("It's a Secret to Everybody"))) // Should return true
+	fmt.Println(checker.IsValidGitHubWebhookSignature("Hello, World?", header, []byte("It's a Secret to Everybody"))) // Should return false
+
+	fmt.Println("IsValidStripeSignature results:")
+	header = "t=1492774577,v1=7656fc2882a7ca0a651666b36bf2f2f22ee204f54f608f227498a2419f2890b2"
+	fmt.Println(checker.IsValidStripeSignature(`{"id":"evt_1"}`, header, []byte("whsec_test"), 0))             // Should return true
+	fmt.Println(checker.IsValidStripeSignature(`{"id":"evt_1"}`, header, []byte("whsec_test"), 5*time.Minute)) // Should return false
+}
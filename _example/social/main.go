@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsEmojiShortcode results:")
+	fmt.Println(checker.IsEmojiShortcode(":thumbsup:")) // Should return true
+	fmt.Println(checker.IsEmojiShortcode("thumbsup"))   // Should return false
+
+	fmt.Println("ContainsMention results:")
+	fmt.Println(checker.ContainsMention("Thanks @john.doe!"))         // Should return true
+	fmt.Println(checker.ContainsMention("Write to john@example.com")) // Should return false
+
+	fmt.Println("IsHashtag results:")
+	fmt.Println(checker.IsHashtag("#topic")) // Should return true
+	fmt.Println(checker.IsHashtag("#2024"))  // Should return false
+
+	fmt.Println("ExtractAndCheckMentions results:")
+	members := checker.Rule(func(a any) bool { return checker.Contains([]string{"ana", "bob"}, a) })
+	fmt.Println(checker.ExtractAndCheckMentions("@ana and @bob, please review", members)) // Should return true
+	fmt.Println(checker.ExtractAndCheckMentions("@ana and @eve, please review", members)) // Should return false
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsSingleSQLStatement results:")
+	fmt.Println(checker.IsSingleSQLStatement("SELECT * FROM users WHERE name = 'a;b';")) // Should return true
+	fmt.Println(checker.IsSingleSQLStatement("SELECT 1; DROP TABLE users"))              // Should return false
+
+	fmt.Println("IsSelectOnlyStatement results:")
+	fmt.Println(checker.IsSelectOnlyStatement("WITH t AS (SELECT 1) SELECT * FROM t")) // Should return true
+	fmt.Println(checker.IsSelectOnlyStatement("DELETE FROM users"))                    // Should return false
+
+	fmt.Println("ContainsDDL results:")
+	fmt.Println(checker.ContainsDDL("SELECT 1; DROP TABLE users"))           // Should return true
+	fmt.Println(checker.ContainsDDL("SELECT 'DROP TABLE users' AS message")) // Should return false
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	series := []float64{10, 12, 11, 13, 12, 11, 10, 12}
+
+	fmt.Println("IsWithinStdDevs results:")
+	fmt.Println(checker.IsWithinStdDevs(12.5, series, 2))  // Should return true
+	fmt.Println(checker.IsWithinStdDevs("30", series, 3))  // Should return false
+	fmt.Println(checker.IsWithinStdDevs("abc", series, 3)) // Should return false
+
+	fmt.Println("IsOutlierIQR results:")
+	fmt.Println(checker.IsOutlierIQR(30, series))    // Should return true
+	fmt.Println(checker.IsOutlierIQR("12", series))  // Should return false
+	fmt.Println(checker.IsOutlierIQR("abc", series)) // Should return true
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+	"strings"
+)
+
+func main() {
+	fmt.Println("IsJSONStream results:")
+	fmt.Println(checker.IsJSONStream(strings.NewReader(`{"key": [1, 2]}`), 1024)) // Should return true
+	fmt.Println(checker.IsJSONStream(strings.NewReader(`{"key": [1, 2]}`), 8))    // Should return false
+	fmt.Println(checker.IsJSONStream(strings.NewReader(`"text"`), 1024))          // Should return false
+
+	fmt.Println("IsBase64Stream results:")
+	fmt.Println(checker.IsBase64Stream(strings.NewReader("SGVsbG8gV29ybGQ="), 1024)) // Should return true
+	fmt.Println(checker.IsBase64Stream(strings.NewReader("SGVsbG8gV29ybGQ="), 4))    // Should return false
+	fmt.Println(checker.IsBase64Stream(strings.NewReader("not base64!"), 1024))      // Should return false
+
+	fmt.Println("SniffContentType results:")
+	fmt.Println(checker.SniffContentType(strings.NewReader("<html><body></body></html>"))) // Should return text/html; charset=utf-8 <nil>
+	fmt.Println(checker.SniffContentType(strings.NewReader("%PDF-1.7")))                   // Should return application/pdf <nil>
+}
@@ -17,6 +17,14 @@ func main() {
 	fmt.Println(checker.IsURLPath("not/a/path"))                // Should return false.
 	fmt.Println(checker.IsURLPath("not/a/path?document=12345")) // Should return false.
 
+	fmt.Println("IsURLSafe results:")
+	fmt.Println(checker.IsURLSafe("order-42_v1.2~beta")) // Should return true
+	fmt.Println(checker.IsURLSafe("a b"))                // Should return false
+
+	fmt.Println("NeedsURLEncoding results:")
+	fmt.Println(checker.NeedsURLEncoding("São Paulo")) // Should return true
+	fmt.Println(checker.NeedsURLEncoding("order-42"))  // Should return false
+
 	fmt.Println("IsHTTPMethod results:")
 	fmt.Println(checker.IsHTTPMethod("POST"))          // Should return true
 	fmt.Println(checker.IsHTTPMethod("GET"))           // Should return true
@@ -45,10 +53,35 @@ func main() {
 	fmt.Println(checker.IsNumericSpace("123 456")) // Should return true
 	fmt.Println(checker.IsNumericSpace("123abc"))  // Should return false
 
+	fmt.Println("IsStrictNumeric results:")
+	fmt.Println(checker.IsStrictNumeric("0123")) // Should return true
+	fmt.Println(checker.IsStrictNumeric("-123")) // Should return false
+
+	fmt.Println("IsSignedNumeric results:")
+	fmt.Println(checker.IsSignedNumeric("-123")) // Should return true
+	fmt.Println(checker.IsSignedNumeric("+-1"))  // Should return false
+
+	fmt.Println("IsDecimalString results:")
+	fmt.Println(checker.IsDecimalString("-1.5"))  // Should return true
+	fmt.Println(checker.IsDecimalString("1.2.3")) // Should return false
+
+	fmt.Println("IsScientificNotation results:")
+	fmt.Println(checker.IsScientificNotation("6.022e23")) // Should return true
+	fmt.Println(checker.IsScientificNotation("1.5"))      // Should return false
+
 	fmt.Println("IsEmail results:")
 	fmt.Println(checker.IsEmail("email@example.com")) // Should return true
 	fmt.Println(checker.IsEmail("bad email"))         // Should return false
 
+	fmt.Println("IsSameEmail results:")
+	fmt.Println(checker.IsSameEmail("user@Example.COM", "user@example.com")) // Should return true
+	fmt.Println(checker.IsSameEmail("User@example.com", "user@example.com")) // Should return false
+
+	fmt.Println("IsSameEmailWithOptions results:")
+	opts := checker.EmailOptions{FoldPlusAlias: true, FoldGmailDots: true}
+	fmt.Println(checker.IsSameEmailWithOptions("User+promo@gmail.com", "user@gmail.com", opts)) // Should return true
+	fmt.Println(checker.IsSameEmailWithOptions("j.doe@example.com", "jdoe@example.com", opts))  // Should return false
+
 	fmt.Println("IsCPF results:")
 	fmt.Println(checker.IsCPF("12101721007"))    // Should return true
 	fmt.Println(checker.IsCPF("121.017.210-07")) // Should return true
@@ -78,11 +111,51 @@ func main() {
 	fmt.Println(checker.IsBase64("SGVsbG8gd29ybGQh")) // Should return true
 	fmt.Println(checker.IsBase64("Hello world!"))     // Should return false
 
+	fmt.Println("IsBase64WithOptions results:")
+	fmt.Println(checker.IsBase64WithOptions("SGk", checker.Base64Options{NoPadding: true}))  // Should return true
+	fmt.Println(checker.IsBase64WithOptions("SGl=", checker.Base64Options{Canonical: true})) // Should return false
+
+	fmt.Println("IsBase64URL results:")
+	fmt.Println(checker.IsBase64URL("PDw_Pz4-")) // Should return true
+	fmt.Println(checker.IsBase64URL("PDw/Pz4+")) // Should return false
+
+	fmt.Println("IsBase64RawStd results:")
+	fmt.Println(checker.IsBase64RawStd("SGk"))  // Should return true
+	fmt.Println(checker.IsBase64RawStd("SGk=")) // Should return false
+
+	fmt.Println("IsBase64RawURL results:")
+	fmt.Println(checker.IsBase64RawURL("eyJhbGciOiJIUzI1NiJ9")) // Should return true
+	fmt.Println(checker.IsBase64RawURL("SGk="))                 // Should return false
+
+	fmt.Println("IsBase64OfLength results:")
+	fmt.Println(checker.IsBase64OfLength("AAECAwQFBgcICQoLDA0ODw==", 16)) // Should return true
+	fmt.Println(checker.IsBase64OfLength("AAECAwQFBgcICQoLDA0ODw==", 32)) // Should return false
+
 	fmt.Println("IsBearer results:")
 	fmt.Println(checker.IsBearer("Bearer token")) // Should return true
 	fmt.Println(checker.IsBearer("token"))        // Should return false
 	fmt.Println(checker.IsBearer(12345))          // Should return false
 
+	fmt.Println("IsTraceParent results:")
+	fmt.Println(checker.IsTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")) // Should return true
+	fmt.Println(checker.IsTraceParent("ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")) // Should return false
+
+	fmt.Println("IsTraceID results:")
+	fmt.Println(checker.IsTraceID("4bf92f3577b34da6a3ce929d0e0e4736")) // Should return true
+	fmt.Println(checker.IsTraceID("00000000000000000000000000000000")) // Should return false
+
+	fmt.Println("IsSpanID results:")
+	fmt.Println(checker.IsSpanID("00f067aa0ba902b7")) // Should return true
+	fmt.Println(checker.IsSpanID("0000000000000000")) // Should return false
+
+	fmt.Println("IsRequestIDHeaderSafe results:")
+	fmt.Println(checker.IsRequestIDHeaderSafe("f47ac10b-58cc-4372-a567-0e02b2c3d479")) // Should return true
+	fmt.Println(checker.IsRequestIDHeaderSafe("abc\r\nSet-Cookie: x=1"))               // Should return false
+
+	fmt.Println("IsHeaderValueSafe results:")
+	fmt.Println(checker.IsHeaderValueSafe("text/html; charset=utf-8")) // Should return true
+	fmt.Println(checker.IsHeaderValueSafe("abc\r\nSet-Cookie: x=1"))   // Should return false
+
 	fmt.Println("IsPrivateIP results:")
 	fmt.Println(checker.IsPrivateIP("192.0.2.1"))   // Should return false
 	fmt.Println(checker.IsPrivateIP("192.168.0.1")) // Should return true
@@ -115,4 +188,29 @@ func main() {
 	fmt.Println(checker.IsMobilePlatform("iphone os"))        // Should return true
 	fmt.Println(checker.IsMobilePlatform("iphone"))           // Should return false
 	fmt.Println(checker.IsMobileDeviceID("incorrect-format")) // Should return false
+
+	fmt.Println("IsFullNameWithOptions results:")
+	fmt.Println(checker.IsFullNameWithOptions("Suharto", checker.NameOptions{AllowSingle: true}))      // Should return true
+	fmt.Println(checker.IsFullNameWithOptions("Ana Maria de Souza", checker.NameOptions{MaxWords: 3})) // Should return false
+
+	fmt.Println("IsPersonName results:")
+	fmt.Println(checker.IsPersonName("Suharto")) // Should return true
+	fmt.Println(checker.IsPersonName("John123")) // Should return false
+
+	fmt.Println("HasSurname results:")
+	fmt.Println(checker.HasSurname("João da Silva")) // Should return true
+	fmt.Println(checker.HasSurname("João S."))       // Should return false
+
+	fmt.Println("ContainsInitial results:")
+	fmt.Println(checker.ContainsInitial("J. Silva"))   // Should return true
+	fmt.Println(checker.ContainsInitial("João Silva")) // Should return false
+
+	fmt.Println("ContainsHonorific results:")
+	fmt.Println(checker.ContainsHonorific("Dr. João Silva")) // Should return true
+	fmt.Println(checker.ContainsHonorific("Drummond Lima"))  // Should return false
+
+	fmt.Println("IsRandomLooking results:")
+	fmt.Println(checker.IsRandomLooking("xkqzvbnm"))    // Should return true
+	fmt.Println(checker.IsRandomLooking("asdfgh"))      // Should return true
+	fmt.Println(checker.IsRandomLooking("Maria Silva")) // Should return false
 }
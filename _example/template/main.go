@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("HasBalancedBraces results:")
+	fmt.Println(checker.HasBalancedBraces("Hello {{name}}")) // Should return true
+	fmt.Println(checker.HasBalancedBraces("Hello {{name}"))  // Should return false
+
+	fmt.Println("ListPlaceholders results:")
+	fmt.Println(checker.ListPlaceholders("Hello {{name}}, you owe {{amount}}", checker.PlaceholderStyleMustache)) // Should return [name amount]
+	fmt.Println(checker.ListPlaceholders("Hello ${name}", checker.PlaceholderStyleDollar))                        // Should return [name]
+
+	fmt.Println("PlaceholdersSubsetOf results:")
+	fmt.Println(checker.PlaceholdersSubsetOf("Hello {{name}}", "name", "email"))  // Should return true
+	fmt.Println(checker.PlaceholdersSubsetOf("Hello {{token}}", "name", "email")) // Should return false
+	fmt.Println(checker.PlaceholdersSubsetOf("Hello {{name | upper}}", "name"))   // Should return false
+}
@@ -53,4 +53,61 @@ func main() {
 	fmt.Println(checker.IsToday(t1)) // Will return false if the date is not today
 	fmt.Println(checker.IsToday(t3)) // Will return true if the current date is today
 	fmt.Println(checker.IsToday(t2)) // Will return true if the provided date is today's date
+
+	fmt.Println("IsZeroTime results:")
+	fmt.Println(checker.IsZeroTime(time.Time{}))            // Should return true
+	fmt.Println(checker.IsZeroTime("0001-01-01T00:00:00Z")) // Should return true
+	fmt.Println(checker.IsZeroTime(time.Now()))             // Should return false
+	fmt.Println(checker.IsZeroTime(0))                      // Should return false
+
+	fmt.Println("IsBeforeNowWithOptions results:")
+	fmt.Println(checker.IsBeforeNowWithOptions(time.Time{}, checker.TimeOptions{}))                        // Should return true
+	fmt.Println(checker.IsBeforeNowWithOptions(time.Time{}, checker.TimeOptions{ZeroIsUnset: true}))       // Should return false
+	fmt.Println(checker.IsBeforeNowWithOptions((*time.Time)(nil), checker.TimeOptions{ZeroIsUnset: true})) // Should return false
+
+	fmt.Println("IsTodayWithOptions results:")
+	fmt.Println(checker.IsTodayWithOptions(time.Now(), checker.TimeOptions{ZeroIsUnset: true}))  // Should return true
+	fmt.Println(checker.IsTodayWithOptions(time.Time{}, checker.TimeOptions{ZeroIsUnset: true})) // Should return false
+
+	fmt.Println("IsPlausibleBirthDate results:")
+	fmt.Println(checker.IsPlausibleBirthDate("1990-05-17"))                // Should return true
+	fmt.Println(checker.IsPlausibleBirthDate(time.Now().AddDate(0, 0, 1))) // Should return false
+	fmt.Println(checker.IsPlausibleBirthDate("1850-01-01"))                // Should return false
+
+	fmt.Println("IsPlausibleBirthDateWithOptions results:")
+	fmt.Println(checker.IsPlausibleBirthDateWithOptions("1990-05-17", checker.BirthDateOptions{MinAge: 18}))                  // Should return true
+	fmt.Println(checker.IsPlausibleBirthDateWithOptions(time.Now().AddDate(-17, 0, 0), checker.BirthDateOptions{MinAge: 18})) // Should return false
+
+	fmt.Println("IsMultipleOfDuration results:")
+	fmt.Println(checker.IsMultipleOfDuration("45m", 15*time.Minute))     // Should return true
+	fmt.Println(checker.IsMultipleOfDuration(90*time.Minute, time.Hour)) // Should return false
+
+	fmt.Println("AlignsToInterval results:")
+	fmt.Println(checker.AlignsToInterval("2024-01-01T10:15:00Z", 5*time.Minute)) // Should return true
+	fmt.Println(checker.AlignsToInterval("2024-01-01T10:17:00Z", 5*time.Minute)) // Should return false
+
+	fmt.Println("WithFrozenTime results:")
+	checker.WithFrozenTime(time.Date(2024, 1, 1, 22, 0, 0, 0, time.FixedZone("BRT", -3*60*60)), func() {
+		fmt.Println(checker.IsToday("2024-01-01"))      // Should return true
+		fmt.Println(checker.IsAfterToday("2024-01-02")) // Should return true
+	})
+
+	fmt.Println("IsTodayIn results:")
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+	fmt.Println(checker.IsTodayIn(time.Now(), saoPaulo))                   // Should return true
+	fmt.Println(checker.IsTodayIn(time.Now().AddDate(0, 0, -1), saoPaulo)) // Should return false
+
+	fmt.Println("IsBeforeTodayIn results:")
+	fmt.Println(checker.IsBeforeTodayIn(time.Now().AddDate(0, 0, -1), saoPaulo)) // Should return true
+
+	fmt.Println("IsAfterTodayIn results:")
+	fmt.Println(checker.IsAfterTodayIn(time.Now().AddDate(0, 0, 1), saoPaulo)) // Should return true
+
+	fmt.Println("IsSameInstant results:")
+	fmt.Println(checker.IsSameInstant("2024-01-01T10:00:00Z", "2024-01-01T07:00:00-03:00")) // Should return true
+	fmt.Println(checker.IsSameInstant("2024-01-01T10:00:00Z", "2024-01-01T10:00:01Z"))      // Should return false
+
+	fmt.Println("IsWithinDurationOf results:")
+	fmt.Println(checker.IsWithinDurationOf("2024-01-01T10:04:00Z", "2024-01-01T10:00:00Z", 5*time.Minute)) // Should return true
+	fmt.Println(checker.IsWithinDurationOf("2024-01-01T10:06:00Z", "2024-01-01T10:00:00Z", 5*time.Minute)) // Should return false
 }
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsTLSVersion results:")
+	fmt.Println(checker.IsTLSVersion("1.2"))     // Should return true
+	fmt.Println(checker.IsTLSVersion("TLSv1.3")) // Should return true
+	fmt.Println(checker.IsTLSVersion("SSLv3"))   // Should return false
+
+	fmt.Println("IsCipherSuiteName results:")
+	fmt.Println(checker.IsCipherSuiteName("TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256")) // Should return true
+	fmt.Println(checker.IsCipherSuiteName("TLS_FAKE_WITH_NOTHING"))                 // Should return false
+
+	fmt.Println("IsCurveName results:")
+	fmt.Println(checker.IsCurveName("X25519")) // Should return true
+	fmt.Println(checker.IsCurveName("P-256"))  // Should return true
+	fmt.Println(checker.IsCurveName("P-192"))  // Should return false
+}
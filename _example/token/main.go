@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+	"github.com/tech4works/checker"
+)
+
+func main() {
+	fmt.Println("IsIdempotencyKey results:")
+	fmt.Println(checker.IsIdempotencyKey("f47ac10b-58cc-4372-a567-0e02b2c3d479")) // Should return true
+	fmt.Println(checker.IsIdempotencyKey("order-2024-000123"))                    // Should return true
+	fmt.Println(checker.IsIdempotencyKey("abc"))                                  // Should return false
+
+	fmt.Println("IsNonce results:")
+	fmt.Println(checker.IsNonce("9f86d081884c7d659a2feaa0c55ad015", 128)) // Should return true
+	fmt.Println(checker.IsNonce("4f9a2b7c", 128))                         // Should return false
+}
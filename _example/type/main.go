@@ -73,6 +73,22 @@ func main() {
 	fmt.Println(checker.IsInt(intStr)) // Should return true.
 	fmt.Println(checker.IsInt(text))   // Should return false.
 
+	fmt.Println("IsInt64String results:")
+	fmt.Println(checker.IsInt64String("9223372036854775807")) // Should return true.
+	fmt.Println(checker.IsInt64String("9223372036854775808")) // Should return false.
+
+	fmt.Println("IsUint64String results:")
+	fmt.Println(checker.IsUint64String("18446744073709551615")) // Should return true.
+	fmt.Println(checker.IsUint64String("-1"))                   // Should return false.
+
+	fmt.Println("IsIntInBase results:")
+	fmt.Println(checker.IsIntInBase("ff", 16))  // Should return true.
+	fmt.Println(checker.IsIntInBase("1012", 2)) // Should return false.
+
+	fmt.Println("IsBigInt results:")
+	fmt.Println(checker.IsBigInt("123456789012345678901234567890")) // Should return true.
+	fmt.Println(checker.IsBigInt("1e10"))                           // Should return false.
+
 	fmt.Println("IsBool results:")
 	fmt.Println(checker.IsBool(boolStr))          // Should return true.
 	fmt.Println(checker.IsBool(boolInt))          // Should return true.
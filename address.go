@@ -0,0 +1,173 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// complementMaxLength is the maximum number of characters accepted in an address complement, the limit used by
+// the main carriers' APIs.
+const complementMaxLength = 60
+
+// IsCEP checks if a given value is a Brazilian postal code (CEP), either masked as "#####-###" or as 8 bare
+// digits. The all-zero CEP is not assigned to any address and is rejected.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a CEP.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a CEP.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsCEP("01310-100")) // true
+//	fmt.Println(IsCEP("01310100"))  // true
+//	fmt.Println(IsCEP("0131-0100")) // false
+//	fmt.Println(IsCEP("00000-000")) // false
+func IsCEP(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsCEP", time.Now(), &passed)
+	}
+	return isCEP(a)
+}
+
+// isCEP is the unobserved implementation of IsCEP.
+func isCEP(a any) bool {
+	s := toString(a)
+	regex := regexp.MustCompile(`^\d{5}-?\d{3}$`)
+	return regex.MatchString(s) && strings.Trim(s, "0-") != ""
+}
+
+// IsStreetLine checks if a given value is a street line of an address, such as "Av. Paulista" or "Rua 25 de
+// Março". The value must contain at least one letter, may mix letters, digits and spaces, and accepts only the
+// punctuation commonly found in addresses: . , ' - / º ª ° ( ) & and #. Control characters are never accepted.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a street line.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a street line.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsStreetLine("Av. Paulista"))    // true
+//	fmt.Println(IsStreetLine("Rua 25 de Março")) // true
+//	fmt.Println(IsStreetLine("1234"))            // false
+//	fmt.Println(IsStreetLine("Rua\tA"))          // false
+func IsStreetLine(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsStreetLine", time.Now(), &passed)
+	}
+	s := toString(a)
+	return isAddressText(s) && strings.ContainsFunc(s, unicode.IsLetter)
+}
+
+// IsHouseNumber checks if a given value is the number of an address: up to 6 digits optionally followed by a
+// letter, such as "123" or "123A", or "S/N" (sem número) for addresses without a number. The letter suffix may be
+// separated by a hyphen and the comparison is case-insensitive.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a house number.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a house number.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsHouseNumber("123"))   // true
+//	fmt.Println(IsHouseNumber("123-a")) // true
+//	fmt.Println(IsHouseNumber("S/N"))   // true
+//	fmt.Println(IsHouseNumber("12 34")) // false
+func IsHouseNumber(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsHouseNumber", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^(?i)(\d{1,6}(-?[a-z])?|s/?n)$`)
+	return regex.MatchString(toString(a))
+}
+
+// IsComplement checks if a given value is the complement of an address, such as "Apto 12, Bloco B". The value
+// must contain at least one letter or digit, must have at most 60 characters and accepts the same punctuation as
+// IsStreetLine. Control characters are never accepted.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a complement.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an address complement.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsComplement("Apto 12, Bloco B")) // true
+//	fmt.Println(IsComplement("Sala 1001"))        // true
+//	fmt.Println(IsComplement("--"))               // false
+func IsComplement(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsComplement", time.Now(), &passed)
+	}
+	s := toString(a)
+	return utf8.RuneCountInString(s) <= complementMaxLength && isAddressText(s) &&
+		strings.ContainsFunc(s, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) })
+}
+
+// isAddressText checks if s is a non-blank address text made only of letters, digits, spaces and the punctuation
+// accepted in address lines, without leading or trailing spaces.
+func isAddressText(s string) bool {
+	if strings.TrimSpace(s) != s || s == "" {
+		return false
+	}
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == ' ':
+		case strings.ContainsRune(".,'-/ºª°()&#", r):
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsCEP(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Masked", arg: "01310-100", want: true},
+		{name: "Bare", arg: "01310100", want: true},
+		{name: "Int", arg: 88015100, want: true},
+		{name: "WrongMask", arg: "0131-0100", want: false},
+		{name: "Short", arg: "01310-10", want: false},
+		{name: "Letters", arg: "0131A-100", want: false},
+		{name: "AllZeros", arg: "00000-000", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsCEP(tc.arg); got != tc.want {
+				t.Errorf("IsCEP(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsStreetLine(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Avenue", arg: "Av. Paulista", want: true},
+		{name: "DigitsAndAccents", arg: "Rua 25 de Março", want: true},
+		{name: "Ordinal", arg: "Travessa 1º de Maio", want: true},
+		{name: "Apostrophe", arg: "Rua D'Ávila", want: true},
+		{name: "OnlyDigits", arg: "1234", want: false},
+		{name: "ControlChar", arg: "Rua\tA", want: false},
+		{name: "NewLine", arg: "Rua A\n", want: false},
+		{name: "Symbols", arg: "Rua <script>", want: false},
+		{name: "LeadingSpace", arg: " Rua A", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsStreetLine(tc.arg); got != tc.want {
+				t.Errorf("IsStreetLine(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsHouseNumber(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Digits", arg: "123", want: true},
+		{name: "Int", arg: 42, want: true},
+		{name: "Letter", arg: "123A", want: true},
+		{name: "HyphenLowerLetter", arg: "123-a", want: true},
+		{name: "WithoutNumber", arg: "S/N", want: true},
+		{name: "WithoutNumberLower", arg: "sn", want: true},
+		{name: "Space", arg: "12 34", want: false},
+		{name: "TooLong", arg: "1234567", want: false},
+		{name: "TwoLetters", arg: "12AB", want: false},
+		{name: "OnlyLetter", arg: "A", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsHouseNumber(tc.arg); got != tc.want {
+				t.Errorf("IsHouseNumber(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsComplement(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Apartment", arg: "Apto 12, Bloco B", want: true},
+		{name: "Room", arg: "Sala 1001", want: true},
+		{name: "Hash", arg: "Casa #3", want: true},
+		{name: "OnlyDigits", arg: "12", want: true},
+		{name: "OnlyPunctuation", arg: "--", want: false},
+		{name: "TooLong", arg: strings.Repeat("a", 61), want: false},
+		{name: "ControlChar", arg: "Apto\x0012", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsComplement(tc.arg); got != tc.want {
+				t.Errorf("IsComplement(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
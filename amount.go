@@ -0,0 +1,292 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AmountFormat describes how monetary amounts are written in a locale.
+type AmountFormat struct {
+	// ThousandSeparator separates the groups of three integer digits, such as "." in "1.234,56".
+	ThousandSeparator string
+	// DecimalSeparator separates the integer part from the fraction, such as "," in "1.234,56".
+	DecimalSeparator string
+	// Symbols lists the currency symbols or codes accepted before or after the number, such as "R$" or "BRL".
+	Symbols []string
+}
+
+// amountRegistry stores the amount formats known by IsAmount and AmountWithinRange, keyed by locale.
+var amountRegistry = struct {
+	sync.RWMutex
+	locales map[string]AmountFormat
+}{locales: map[string]AmountFormat{
+	"pt-BR": {ThousandSeparator: ".", DecimalSeparator: ",", Symbols: []string{"R$", "BRL"}},
+	"en-US": {ThousandSeparator: ",", DecimalSeparator: ".", Symbols: []string{"$", "US$", "USD"}},
+	"en-GB": {ThousandSeparator: ",", DecimalSeparator: ".", Symbols: []string{"£", "GBP"}},
+	"de-DE": {ThousandSeparator: ".", DecimalSeparator: ",", Symbols: []string{"€", "EUR"}},
+	"es-ES": {ThousandSeparator: ".", DecimalSeparator: ",", Symbols: []string{"€", "EUR"}},
+	"fr-FR": {ThousandSeparator: " ", DecimalSeparator: ",", Symbols: []string{"€", "EUR"}},
+}}
+
+// RegisterAmountFormat registers the amount format of a locale, replacing the built-in one if it exists. The
+// locales "pt-BR", "en-US", "en-GB", "de-DE", "es-ES" and "fr-FR" are available by default. It is safe to call
+// RegisterAmountFormat concurrently with the amount checkers.
+//
+// Parameters:
+//   - locale: The identifier of the locale, for example "pt-PT".
+//   - format: The separators and currency symbols used by the locale.
+//
+// Panic:
+//   - The function will panic if the decimal separator is empty or equal to the thousand separator.
+//
+// Example:
+//
+//	RegisterAmountFormat("pt-PT", AmountFormat{ThousandSeparator: " ", DecimalSeparator: ",", Symbols: []string{"€"}})
+//	fmt.Println(IsAmount("pt-PT", "1 234,56 €")) // true
+func RegisterAmountFormat(locale string, format AmountFormat) {
+	if format.DecimalSeparator == "" || format.DecimalSeparator == format.ThousandSeparator {
+		panic(fmt.Sprintf("Invalid amount format for locale: %s", locale))
+	}
+
+	amountRegistry.Lock()
+	defer amountRegistry.Unlock()
+
+	amountRegistry.locales[locale] = format
+}
+
+// IsAmount checks whether the given value is a monetary amount written in the format of the locale. It uses the
+// toString function to convert the value and accepts an optional leading '-', an optional currency symbol of the
+// locale before or after the number (separated by an optional space), an integer part either ungrouped or grouped
+// in thousands with the locale separator, and an optional fraction after the locale decimal separator. The same
+// "1,234" is then one thousand two hundred thirty-four in "en-US" and one point two three four in "pt-BR".
+// Non-breaking spaces, common in formatted output, are treated as regular spaces.
+//
+// Parameters:
+//   - locale: The locale whose format is used, such as "pt-BR" or "en-US".
+//   - a: Any value to be converted into a string and checked as an amount.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an amount in the locale format.
+//
+// Panic:
+//   - The function will panic if the locale is not registered, or if the value cannot be converted to a string
+//     through the toString function.
+//
+// Example:
+//
+//	fmt.Println(IsAmount("pt-BR", "R$ 1.234,56")) // true
+//	fmt.Println(IsAmount("en-US", "$1,234.56"))   // true
+//	fmt.Println(IsAmount("en-US", "1.234,56"))    // false
+//	fmt.Println(IsAmount("pt-BR", "1,234.56"))    // false
+func IsAmount(locale string, a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAmount", time.Now(), &passed)
+	}
+	_, ok := parseAmount(locale, toString(a))
+	return ok
+}
+
+// AmountWithinRange checks whether the given value is a monetary amount in the format of the locale, as checked by
+// IsAmount, whose numeric value lies between min and max, both inclusive.
+//
+// Parameters:
+//   - locale: The locale whose format is used, such as "pt-BR" or "en-US".
+//   - a: Any value to be converted into a string and checked as an amount.
+//   - min: The minimum accepted value.
+//   - max: The maximum accepted value.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an amount in the locale format within the range.
+//
+// Panic:
+//   - The function will panic if the locale is not registered, or if the value cannot be converted to a string
+//     through the toString function.
+//
+// Example:
+//
+//	fmt.Println(AmountWithinRange("pt-BR", "R$ 1.234,56", 0, 5000)) // true
+//	fmt.Println(AmountWithinRange("en-US", "1,234", 0, 1000))       // false
+//	fmt.Println(AmountWithinRange("pt-BR", "1,234", 0, 1000))       // true
+func AmountWithinRange(locale string, a any, min, max float64) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("AmountWithinRange", time.Now(), &passed)
+	}
+	value, ok := parseAmount(locale, toString(a))
+	return ok && value >= min && value <= max
+}
+
+// currencyMinorUnits holds the ISO 4217 minor units, the number of decimal places, of the active currencies,
+// built on first use.
+var currencyMinorUnits = lazy(func() map[string]int {
+	codes := map[int]string{
+		0: "BIF CLP DJF GNF ISK JPY KMF KRW PYG RWF UGX UYI VND VUV XAF XOF XPF",
+		2: "AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BMD BND BOB BOV BRL BSD BTN BWP BYN BZD CAD " +
+			"CDF CHE CHF CHW CNY COP COU CRC CUC CUP CVE CZK DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP " +
+			"GMD GTQ GYD HKD HNL HTG HUF IDR ILS INR IRR JMD KES KGS KHR KPW KYD KZT LAK LBP LKR LRD LSL MAD MDL " +
+			"MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV MYR MZN NAD NGN NIO NOK NPR NZD PAB PEN PGK PHP PKR PLN " +
+			"QAR RON RSD RUB SAR SBD SCR SDG SEK SGD SHP SLE SLL SOS SRD SSP STN SVC SYP SZL THB TJS TMT TOP TRY " +
+			"TTD TWD TZS UAH USD USN UZS VED VES WST XCD YER ZAR ZMW ZWL",
+		3: "BHD IQD JOD KWD LYD OMR TND",
+		4: "CLF UYW",
+	}
+	units := map[string]int{}
+	for minor, list := range codes {
+		for _, code := range strings.Fields(list) {
+			units[code] = minor
+		}
+	}
+	return units
+})
+
+// IsValidAmountForCurrency checks whether the given value is a plain decimal amount, such as 1234.56 or "1234.56",
+// with no more decimal places than the ISO 4217 minor unit of the currency allows: none for JPY, 2 for BRL and 3
+// for BHD. Trailing zeros of the fraction are ignored, so "100.00" is a valid JPY amount, and the amount may be
+// negative, as in refunds. Floats are checked through their shortest representation, so a float carrying a
+// rounding error, such as 0.30000000000000004, has too many decimal places for any currency. The currency code is
+// case-insensitive.
+//
+// Parameters:
+//   - amount: Any value to be converted into a string and checked as an amount.
+//   - currency: The ISO 4217 code of the currency, such as "BRL".
+//
+// Returns:
+//   - bool: A boolean value indicating whether the amount fits the minor unit of the currency.
+//
+// Panic:
+//   - The function will panic if the currency is not an active ISO 4217 currency, or if the value cannot be
+//     converted to a string through the toString function.
+//
+// Example:
+//
+//	fmt.Println(IsValidAmountForCurrency(1234.56, "BRL"))  // true
+//	fmt.Println(IsValidAmountForCurrency("1500", "JPY"))   // true
+//	fmt.Println(IsValidAmountForCurrency("1500.5", "JPY")) // false
+//	fmt.Println(IsValidAmountForCurrency("12.345", "BHD")) // true
+//	fmt.Println(IsValidAmountForCurrency("12.345", "BRL")) // false
+func IsValidAmountForCurrency(amount any, currency string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsValidAmountForCurrency", time.Now(), &passed)
+	}
+	minor, ok := currencyMinorUnits()[strings.ToUpper(currency)]
+	if !ok {
+		panic(fmt.Sprintf("Unsupported currency: %s", currency))
+	}
+
+	var s string
+	switch v := amount.(type) {
+	case float32:
+		s = strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		s = strings.TrimSpace(toString(amount))
+	}
+	matches := regexp.MustCompile(`^[+-]?\d+(?:\.(\d+))?$`).FindStringSubmatch(s)
+	return matches != nil && len(strings.TrimRight(matches[1], "0")) <= minor
+}
+
+// IsWithinLimit checks whether the given amount is not negative and not greater than the limit, such as a
+// transfer against the daily limit of an account. Both values are converted with toFloat, and an amount or a limit
+// that cannot be converted, or that is NaN, such as "abc" in a request body, fails the check instead of panicking.
+//
+// Parameters:
+//   - amount: Any numeric value, or numeric string, to be checked.
+//   - limit: The largest amount allowed, inclusive.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the amount is between zero and the limit.
+//
+// Example:
+//
+//	fmt.Println(IsWithinLimit(250.75, 1000))  // true
+//	fmt.Println(IsWithinLimit("1000", 1000))  // true
+//	fmt.Println(IsWithinLimit(1000.01, 1000)) // false
+//	fmt.Println(IsWithinLimit(-10, 1000))     // false
+//	fmt.Println(IsWithinLimit("abc", 1000))   // false
+func IsWithinLimit(amount, limit any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsWithinLimit", time.Now(), &passed)
+	}
+	value, err := toFloatWithErr(amount)
+	if err != nil {
+		return false
+	}
+	ceiling, err := toFloatWithErr(limit)
+	return err == nil && value >= 0 && value <= ceiling
+}
+
+// parseAmount parses the amount written in the format of the locale, returning its numeric value.
+func parseAmount(locale, s string) (float64, bool) {
+	amountRegistry.RLock()
+	format, ok := amountRegistry.locales[locale]
+	amountRegistry.RUnlock()
+	if !ok {
+		panic(fmt.Sprintf("Unsupported locale: %s", locale))
+	}
+
+	spaces := strings.NewReplacer("\u00A0", " ", "\u202F", " ")
+	var symbols []string
+	for _, symbol := range format.Symbols {
+		symbols = append(symbols, regexp.QuoteMeta(symbol))
+	}
+	prefix, suffix := `(?:)`, `(?:)`
+	if len(symbols) > 0 {
+		prefix = `(?:(?:` + strings.Join(symbols, "|") + `) ?)?`
+		suffix = `(?: ?(?:` + strings.Join(symbols, "|") + `))?`
+	}
+
+	format.ThousandSeparator = spaces.Replace(format.ThousandSeparator)
+	integer := `\d+`
+	if format.ThousandSeparator != "" {
+		integer = `\d{1,3}(?:` + regexp.QuoteMeta(format.ThousandSeparator) + `\d{3})+|\d+`
+	}
+	regex := regexp.MustCompile(`^(-?)` + prefix + `(-?)(` + integer + `)(?:` +
+		regexp.QuoteMeta(format.DecimalSeparator) + `(\d+))?` + suffix + `$`)
+
+	s = spaces.Replace(strings.TrimSpace(s))
+	matches := regex.FindStringSubmatch(s)
+	if matches == nil || (matches[1] != "" && matches[2] != "") {
+		return 0, false
+	}
+
+	number := matches[3]
+	if format.ThousandSeparator != "" {
+		number = strings.ReplaceAll(number, format.ThousandSeparator, "")
+	}
+	if matches[4] != "" {
+		number += "." + matches[4]
+	}
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, false
+	} else if matches[1] != "" || matches[2] != "" {
+		value = -value
+	}
+	return value, true
+}
@@ -0,0 +1,192 @@
+package checker
+
+import "testing"
+
+type amountCase struct {
+	name   string
+	locale string
+	arg    any
+	want   bool
+	panic  bool
+}
+
+func TestIsAmount(t *testing.T) {
+	testCases := []amountCase{
+		{name: "BRGrouped", locale: "pt-BR", arg: "1.234,56", want: true},
+		{name: "BRSymbol", locale: "pt-BR", arg: "R$ 1.234,56", want: true},
+		{name: "BRSymbolNoSpace", locale: "pt-BR", arg: "R$1.234.567,89", want: true},
+		{name: "BRNonBreakingSpace", locale: "pt-BR", arg: "R$\u00a010,00", want: true},
+		{name: "BRCodeSuffix", locale: "pt-BR", arg: "1234,56 BRL", want: true},
+		{name: "BRNegative", locale: "pt-BR", arg: "-R$ 10,00", want: true},
+		{name: "BRNegativeAfterSymbol", locale: "pt-BR", arg: "R$ -10,00", want: true},
+		{name: "BRDecimalComma", locale: "pt-BR", arg: "1,234", want: true},
+		{name: "BRUSFormat", locale: "pt-BR", arg: "1,234.56", want: false},
+		{name: "BRBadGrouping", locale: "pt-BR", arg: "1.23,45", want: false},
+		{name: "BRForeignSymbol", locale: "pt-BR", arg: "$ 10,00", want: false},
+		{name: "USGrouped", locale: "en-US", arg: "$1,234.56", want: true},
+		{name: "USThousands", locale: "en-US", arg: "1,234", want: true},
+		{name: "USInteger", locale: "en-US", arg: 1234, want: true},
+		{name: "USFloat", locale: "en-US", arg: 12.5, want: true},
+		{name: "USBRFormat", locale: "en-US", arg: "1.234,56", want: false},
+		{name: "USDoubleSign", locale: "en-US", arg: "-$-10.00", want: false},
+		{name: "USBothSymbols", locale: "en-US", arg: "$10.00 USD", want: true},
+		{name: "FRSpaceGrouped", locale: "fr-FR", arg: "1 234,56 €", want: true},
+		{name: "FRNarrowSpace", locale: "fr-FR", arg: "1\u202f234,56\u00a0€", want: true},
+		{name: "Text", locale: "en-US", arg: "ten dollars", want: false},
+		{name: "MissingFraction", locale: "en-US", arg: "10.", want: false},
+		{name: "Empty", locale: "en-US", arg: "", want: false},
+		{name: "UnknownLocale", locale: "xx-XX", arg: "10", panic: true},
+		{name: "Nil", locale: "en-US", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsAmount(tc.locale, tc.arg); got != tc.want {
+				t.Errorf("IsAmount(%v, %v) = %v, want %v", tc.locale, tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAmountWithinRange(t *testing.T) {
+	testCases := []struct {
+		name   string
+		locale string
+		arg    any
+		min    float64
+		max    float64
+		want   bool
+	}{
+		{name: "BRWithin", locale: "pt-BR", arg: "R$ 1.234,56", min: 0, max: 5000, want: true},
+		{name: "BRDecimalComma", locale: "pt-BR", arg: "1,234", min: 0, max: 1000, want: true},
+		{name: "USThousands", locale: "en-US", arg: "1,234", min: 0, max: 1000, want: false},
+		{name: "InclusiveMin", locale: "en-US", arg: "$10.00", min: 10, max: 20, want: true},
+		{name: "InclusiveMax", locale: "en-US", arg: "$20", min: 10, max: 20, want: true},
+		{name: "Negative", locale: "en-US", arg: "-$5.00", min: 0, max: 20, want: false},
+		{name: "NegativeWithin", locale: "de-DE", arg: "-5,50 €", min: -10, max: 0, want: true},
+		{name: "Invalid", locale: "en-US", arg: "abc", min: 0, max: 20, want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := AmountWithinRange(tc.locale, tc.arg, tc.min, tc.max); got != tc.want {
+				t.Errorf("AmountWithinRange(%v, %v, %v, %v) = %v, want %v", tc.locale, tc.arg, tc.min, tc.max, got,
+					tc.want)
+			}
+		})
+	}
+}
+
+func TestRegisterAmountFormat(t *testing.T) {
+	RegisterAmountFormat("pt-PT", AmountFormat{ThousandSeparator: " ", DecimalSeparator: ",", Symbols: []string{"€"}})
+	if !IsAmount("pt-PT", "1 234,56 €") {
+		t.Errorf("IsAmount() = false, want true for a registered locale")
+	}
+
+	RegisterAmountFormat("ja-JP", AmountFormat{ThousandSeparator: ",", DecimalSeparator: "."})
+	if !AmountWithinRange("ja-JP", "1,000", 1000, 1000) || IsAmount("ja-JP", "¥1,000") {
+		t.Errorf("AmountWithinRange() failed for a registered locale without symbols")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	RegisterAmountFormat("xx-XX", AmountFormat{ThousandSeparator: ".", DecimalSeparator: "."})
+}
+
+func TestIsValidAmountForCurrency(t *testing.T) {
+	tenth, fifth := 0.1, 0.2
+	testCases := []struct {
+		name     string
+		arg      any
+		currency string
+		want     bool
+		panic    bool
+	}{
+		{name: "BRLTwoDecimals", arg: 1234.56, currency: "BRL", want: true},
+		{name: "BRLString", arg: "1234.56", currency: "BRL", want: true},
+		{name: "BRLInteger", arg: 10, currency: "BRL", want: true},
+		{name: "BRLThreeDecimals", arg: "12.345", currency: "BRL", want: false},
+		{name: "BRLTrailingZeros", arg: "12.3400", currency: "BRL", want: true},
+		{name: "BRLFloatError", arg: tenth + fifth, currency: "BRL", want: false},
+		{name: "BRLFloat32", arg: float32(19.99), currency: "BRL", want: true},
+		{name: "BRLNegative", arg: "-10.50", currency: "BRL", want: true},
+		{name: "LowerCaseCurrency", arg: "10.50", currency: "brl", want: true},
+		{name: "JPYInteger", arg: "1500", currency: "JPY", want: true},
+		{name: "JPYZeroFraction", arg: "1500.00", currency: "JPY", want: true},
+		{name: "JPYFraction", arg: "1500.5", currency: "JPY", want: false},
+		{name: "BHDThreeDecimals", arg: "12.345", currency: "BHD", want: true},
+		{name: "BHDFourDecimals", arg: "12.3456", currency: "BHD", want: false},
+		{name: "CLFFourDecimals", arg: "1.2345", currency: "CLF", want: true},
+		{name: "LargeFloat", arg: 1e21, currency: "JPY", want: true},
+		{name: "Grouped", arg: "1,234.56", currency: "USD", want: false},
+		{name: "MissingFraction", arg: "10.", currency: "USD", want: false},
+		{name: "Text", arg: "ten", currency: "USD", want: false},
+		{name: "UnknownCurrency", arg: "10", currency: "XYZ", panic: true},
+		{name: "Nil", arg: nil, currency: "USD", panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsValidAmountForCurrency(tc.arg, tc.currency); got != tc.want {
+				t.Errorf("IsValidAmountForCurrency(%v, %v) = %v, want %v", tc.arg, tc.currency, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsWithinLimit(t *testing.T) {
+	testCases := []struct {
+		name   string
+		amount any
+		limit  any
+		want   bool
+		panic  bool
+	}{
+		{name: "Below", amount: 250.75, limit: 1000, want: true},
+		{name: "Equal", amount: "1000", limit: 1000, want: true},
+		{name: "Zero", amount: 0, limit: 1000, want: true},
+		{name: "Above", amount: 1000.01, limit: 1000, want: false},
+		{name: "Negative", amount: -10, limit: 1000, want: false},
+		{name: "NegativeLimit", amount: 0, limit: -1, want: false},
+		{name: "NotNumber", amount: "ten", limit: 1000, want: false},
+		{name: "NaN", amount: "NaN", limit: 1000, want: false},
+		{name: "NilAmount", amount: nil, limit: 1000, want: false},
+		{name: "LimitNotNumber", amount: 10, limit: "ten", want: false},
+		{name: "NilLimit", amount: 10, limit: nil, want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsWithinLimit(tc.amount, tc.limit); got != tc.want {
+				t.Errorf("IsWithinLimit(%v, %v) = %v, want %v", tc.amount, tc.limit, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+// Package assert provides test assertions backed by the checker functions, so tests can verify values with
+// the same rules applied in production code. Every assertion marks itself as a test helper, reports a
+// descriptive failure through t.Errorf and returns whether it succeeded. Panics raised by the underlying
+// checker, for example on unsupported types, are reported as failures instead of aborting the test.
+package assert
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/tech4works/checker"
+)
+
+// AssertEmpty asserts that the given value is empty according to checker.IsEmpty.
+//
+// Parameters:
+//   - t: The test or benchmark being run.
+//   - a: The value expected to be empty.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the assertion succeeded.
+//
+// Example:
+//
+//	func TestSomething(t *testing.T) {
+//		assert.AssertEmpty(t, "   ") // passes
+//		assert.AssertEmpty(t, "go")  // fails: expected value to be empty, got "go" (string)
+//	}
+func AssertEmpty(t testing.TB, a any) bool {
+	t.Helper()
+	return check(t, func() bool {
+		return checker.IsEmpty(a)
+	}, "expected value to be empty, got %s", describe(a))
+}
+
+// AssertContains asserts that the value 'b' is contained within the value 'a' according to checker.Contains.
+//
+// Parameters:
+//   - t: The test or benchmark being run.
+//   - a: The slice, array, map, struct or string expected to contain 'b'.
+//   - b: The value expected to be found in 'a'.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the assertion succeeded.
+//
+// Example:
+//
+//	func TestSomething(t *testing.T) {
+//		assert.AssertContains(t, []string{"a", "b"}, "b") // passes
+//		assert.AssertContains(t, "Hello World", "Moon")   // fails: expected "Hello World" (string) to contain "Moon" (string)
+//	}
+func AssertContains(t testing.TB, a, b any) bool {
+	t.Helper()
+	return check(t, func() bool {
+		return checker.Contains(a, b)
+	}, "expected %s to contain %s", describe(a), describe(b))
+}
+
+// AssertEqualsIgnoreCase asserts that the values 'a' and 'b' are equal ignoring case according to
+// checker.EqualsIgnoreCase.
+//
+// Parameters:
+//   - t: The test or benchmark being run.
+//   - a: The first string to be compared.
+//   - b: The second string to be compared.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the assertion succeeded.
+//
+// Example:
+//
+//	func TestSomething(t *testing.T) {
+//		assert.AssertEqualsIgnoreCase(t, "GoLang", "golang") // passes
+//		assert.AssertEqualsIgnoreCase(t, "GoLang", "Java")   // fails: expected "GoLang" (string) to equal "Java" (string) ignoring case
+//	}
+func AssertEqualsIgnoreCase(t testing.TB, a, b any) bool {
+	t.Helper()
+	return check(t, func() bool {
+		return checker.EqualsIgnoreCase(a, b)
+	}, "expected %s to equal %s ignoring case", describe(a), describe(b))
+}
+
+// AssertIsCPF asserts that the given value is a valid CPF according to checker.IsCPF.
+//
+// Parameters:
+//   - t: The test or benchmark being run.
+//   - a: The value expected to be a valid CPF, masked or not.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the assertion succeeded.
+//
+// Example:
+//
+//	func TestSomething(t *testing.T) {
+//		assert.AssertIsCPF(t, "121.017.210-07") // passes
+//		assert.AssertIsCPF(t, "111.111.111-11") // fails: expected a valid CPF, got "111.111.111-11" (string)
+//	}
+func AssertIsCPF(t testing.TB, a any) bool {
+	t.Helper()
+	return check(t, func() bool {
+		return checker.IsCPF(a)
+	}, "expected a valid CPF, got %s", describe(a))
+}
+
+// check runs the given checker, reporting the formatted message when it returns false and reporting the panic
+// value when it panics.
+func check(t testing.TB, fn func() bool, format string, args ...any) (ok bool) {
+	t.Helper()
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf(format+": checker panicked: %v", append(args, r)...)
+			ok = false
+		}
+	}()
+
+	if !fn() {
+		t.Errorf(format, args...)
+		return false
+	}
+	return true
+}
+
+// describe returns a representation of the value with its type, used in failure messages.
+func describe(a any) string {
+	if a == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%#v (%T)", a, a)
+}
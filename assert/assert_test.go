@@ -0,0 +1,94 @@
+package assert
+
+import (
+	"fmt"
+	"testing"
+)
+
+type recorderTB struct {
+	testing.TB
+	messages []string
+}
+
+func (r *recorderTB) Helper() {}
+
+func (r *recorderTB) Errorf(format string, args ...any) {
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+}
+
+type assertCase struct {
+	name        string
+	assert      func(t testing.TB) bool
+	want        bool
+	wantMessage string
+}
+
+func TestAssertions(t *testing.T) {
+	tests := []assertCase{
+		{
+			name:   "AssertEmptySuccess",
+			assert: func(t testing.TB) bool { return AssertEmpty(t, "   ") },
+			want:   true,
+		},
+		{
+			name:        "AssertEmptyFailure",
+			assert:      func(t testing.TB) bool { return AssertEmpty(t, "go") },
+			wantMessage: `expected value to be empty, got "go" (string)`,
+		},
+		{
+			name:   "AssertContainsSuccess",
+			assert: func(t testing.TB) bool { return AssertContains(t, []string{"a", "b"}, "b") },
+			want:   true,
+		},
+		{
+			name:        "AssertContainsFailure",
+			assert:      func(t testing.TB) bool { return AssertContains(t, "Hello World", "Moon") },
+			wantMessage: `expected "Hello World" (string) to contain "Moon" (string)`,
+		},
+		{
+			name:        "AssertContainsPanic",
+			assert:      func(t testing.TB) bool { return AssertContains(t, 10, 1) },
+			wantMessage: `expected 10 (int) to contain 1 (int): checker panicked: unsupported type int`,
+		},
+		{
+			name:   "AssertEqualsIgnoreCaseSuccess",
+			assert: func(t testing.TB) bool { return AssertEqualsIgnoreCase(t, "GoLang", "golang") },
+			want:   true,
+		},
+		{
+			name:        "AssertEqualsIgnoreCaseFailure",
+			assert:      func(t testing.TB) bool { return AssertEqualsIgnoreCase(t, "GoLang", "Java") },
+			wantMessage: `expected "GoLang" (string) to equal "Java" (string) ignoring case`,
+		},
+		{
+			name:   "AssertIsCPFSuccess",
+			assert: func(t testing.TB) bool { return AssertIsCPF(t, "121.017.210-07") },
+			want:   true,
+		},
+		{
+			name:        "AssertIsCPFFailure",
+			assert:      func(t testing.TB) bool { return AssertIsCPF(t, "111.111.111-11") },
+			wantMessage: `expected a valid CPF, got "111.111.111-11" (string)`,
+		},
+		{
+			name:        "AssertIsCPFNil",
+			assert:      func(t testing.TB) bool { return AssertIsCPF(t, nil) },
+			wantMessage: `expected a valid CPF, got nil: checker panicked: error getting a string: value is nil`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &recorderTB{TB: t}
+			if got := tt.assert(recorder); got != tt.want {
+				t.Errorf("assertion returned %v, want %v", got, tt.want)
+			}
+			if tt.want && len(recorder.messages) != 0 {
+				t.Errorf("unexpected failure messages: %v", recorder.messages)
+			}
+			if !tt.want && (len(recorder.messages) != 1 || recorder.messages[0] != tt.wantMessage) {
+				t.Errorf("failure messages = %q, want [%q]", recorder.messages, tt.wantMessage)
+			}
+		})
+	}
+}
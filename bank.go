@@ -0,0 +1,205 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// knownBankCodes lists the COMPE codes of the main banks and payment institutions operating in Brazil, used by
+// IsBankCode when only known codes are accepted.
+var knownBankCodes = []string{
+	"001", "003", "004", "021", "033", "037", "041", "047", "070", "077", "104", "197", "208", "212", "237", "260",
+	"290", "318", "323", "336", "341", "380", "389", "422", "623", "633", "655", "745", "748", "756",
+}
+
+// IsBankCode checks whether the given value is a Brazilian COMPE bank code, made of exactly 3 digits. When
+// knownOnly is true the code must also belong to one of the main banks and payment institutions, such as "001"
+// (Banco do Brasil), "237" (Bradesco), "260" (Nu Pagamentos) or "341" (Itaú).
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a bank code.
+//   - knownOnly: Whether only the codes of known institutions are accepted.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid bank code.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsBankCode("341", true))  // true
+//	fmt.Println(IsBankCode("999", false)) // true
+//	fmt.Println(IsBankCode("999", true))  // false
+//	fmt.Println(IsBankCode("34", false))  // false
+func IsBankCode(a any, knownOnly bool) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBankCode", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^\d{3}$`)
+	return regex.MatchString(s) && (!knownOnly || contains(knownBankCodes, s))
+}
+
+// IsAgencyNumber checks whether the given value is a branch (agência) number of the given bank, made of 4 digits
+// optionally followed by '-' and a verifier digit. The verifier digit is checked for Banco do Brasil ("001"),
+// where a remainder of 10 is written as 'X', and Bradesco ("237"), where it is written as 'P', both using modulo
+// 11 with weights 5 to 2. For other banks, whose branches usually have no verifier digit, only the format is
+// checked.
+//
+// Parameters:
+//   - bankCode: The COMPE code of the bank the branch belongs to, such as "001".
+//   - a: Any value to be converted into a string and checked as a branch number.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid branch number for the bank.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsAgencyNumber("001", "1584-9")) // true
+//	fmt.Println(IsAgencyNumber("001", "1584"))   // true
+//	fmt.Println(IsAgencyNumber("001", "1584-2")) // false
+//	fmt.Println(IsAgencyNumber("341", "0057"))   // true
+//	fmt.Println(IsAgencyNumber("341", "57"))     // false
+func IsAgencyNumber(bankCode string, a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAgencyNumber", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^(\d{4})(?:-([0-9A-Za-z]))?$`)
+	matches := regex.FindStringSubmatch(toString(a))
+	if matches == nil {
+		return false
+	} else if matches[2] == "" {
+		return true
+	}
+
+	switch bankCode {
+	case "001":
+		return strings.EqualFold(matches[2], modulo11Digit(matches[1], []int{5, 4, 3, 2}, "X"))
+	case "237":
+		return strings.EqualFold(matches[2], modulo11Digit(matches[1], []int{5, 4, 3, 2}, "P"))
+	default:
+		return isNumeric(matches[2])
+	}
+}
+
+// IsAccountNumberWithDigit checks whether the given value is an account number of the given bank followed by '-'
+// and its verifier digit, such as "12345-7". The verifier digit is checked for the major banks:
+//   - Banco do Brasil ("001"): up to 8 digits, modulo 11 with weights 9 to 2, a remainder of 10 written as 'X'.
+//   - Bradesco ("237"): up to 7 digits, modulo 11 with weights 2, 7, 6, 5, 4, 3, 2, a remainder of 10 written as 'P'.
+//   - Itaú ("341"): 5 digits, modulo 10 over the 4-digit branch and the account with alternating weights 2 and 1.
+//
+// For other banks only the format, up to 12 digits and a verifier digit, is checked.
+//
+// Parameters:
+//   - bankCode: The COMPE code of the bank the account belongs to, such as "341".
+//   - agency: The branch number of the account, used by the banks whose verifier digit depends on it.
+//   - a: Any value to be converted into a string and checked as an account number with verifier digit.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid account number for the bank.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsAccountNumberWithDigit("341", "0057", "12345-7"))   // true
+//	fmt.Println(IsAccountNumberWithDigit("341", "0057", "12345-8"))   // false
+//	fmt.Println(IsAccountNumberWithDigit("237", "1234", "0238069-2")) // true
+//	fmt.Println(IsAccountNumberWithDigit("001", "1584", "210169-6"))  // true
+//	fmt.Println(IsAccountNumberWithDigit("001", "1584", "210169"))    // false
+func IsAccountNumberWithDigit(bankCode, agency string, a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAccountNumberWithDigit", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^(\d{1,12})-([0-9A-Za-z])$`)
+	matches := regex.FindStringSubmatch(toString(a))
+	if matches == nil {
+		return false
+	}
+	account, digit := matches[1], matches[2]
+
+	switch bankCode {
+	case "001":
+		return len(account) <= 8 &&
+			strings.EqualFold(digit, modulo11Digit(leftPadZeros(account, 8), []int{9, 8, 7, 6, 5, 4, 3, 2}, "X"))
+	case "237":
+		return len(account) <= 7 &&
+			strings.EqualFold(digit, modulo11Digit(leftPadZeros(account, 7), []int{2, 7, 6, 5, 4, 3, 2}, "P"))
+	case "341":
+		agencyRegex := regexp.MustCompile(`^\d{4}$`)
+		if len(account) != 5 || !agencyRegex.MatchString(agency) {
+			return false
+		}
+
+		sum := 0
+		for i, char := range agency + account {
+			product := int(char-'0') * (2 - i%2)
+			sum += product/10 + product%10
+		}
+		return digit == strconv.Itoa((10-sum%10)%10)
+	default:
+		return isNumeric(digit)
+	}
+}
+
+// IsISPB checks whether the given value is an ISPB (Identificador do Sistema de Pagamentos Brasileiro), the
+// 8-digit code identifying institutions in the Brazilian payment system and in Pix, such as "00000000"
+// (Banco do Brasil) or "60701190" (Itaú).
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an ISPB.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid ISPB.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsISPB("60701190")) // true
+//	fmt.Println(IsISPB("6070119"))  // false
+func IsISPB(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsISPB", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^\d{8}$`)
+	return regex.MatchString(toString(a))
+}
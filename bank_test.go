@@ -0,0 +1,153 @@
+package checker
+
+import "testing"
+
+func TestIsBankCode(t *testing.T) {
+	testCases := []struct {
+		name      string
+		arg       any
+		knownOnly bool
+		want      bool
+		panic     bool
+	}{
+		{name: "Known", arg: "341", knownOnly: true, want: true},
+		{name: "KnownLeadingZeros", arg: "001", knownOnly: true, want: true},
+		{name: "UnknownAllowed", arg: "999", want: true},
+		{name: "UnknownRejected", arg: "999", knownOnly: true, want: false},
+		{name: "Integer", arg: 237, knownOnly: true, want: true},
+		{name: "TwoDigits", arg: "34", want: false},
+		{name: "FourDigits", arg: "0341", want: false},
+		{name: "Letters", arg: "abc", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsBankCode(tc.arg, tc.knownOnly); got != tc.want {
+				t.Errorf("IsBankCode(%v, %v) = %v, want %v", tc.arg, tc.knownOnly, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsAgencyNumber(t *testing.T) {
+	testCases := []struct {
+		name     string
+		bankCode string
+		arg      any
+		want     bool
+		panic    bool
+	}{
+		{name: "BBWithDigit", bankCode: "001", arg: "1584-9", want: true},
+		{name: "BBWithoutDigit", bankCode: "001", arg: "1584", want: true},
+		{name: "BBDigitX", bankCode: "001", arg: "1009-X", want: true},
+		{name: "BBDigitLowerX", bankCode: "001", arg: "1009-x", want: true},
+		{name: "BBDigitZero", bankCode: "001", arg: "1003-0", want: true},
+		{name: "BBWrongDigit", bankCode: "001", arg: "1584-2", want: false},
+		{name: "BradescoWithDigit", bankCode: "237", arg: "1234-3", want: true},
+		{name: "BradescoDigitP", bankCode: "237", arg: "1009-P", want: true},
+		{name: "BradescoWrongDigit", bankCode: "237", arg: "1234-4", want: false},
+		{name: "OtherBank", bankCode: "341", arg: "0057", want: true},
+		{name: "OtherBankWithDigit", bankCode: "104", arg: "0057-1", want: true},
+		{name: "OtherBankLetterDigit", bankCode: "104", arg: "0057-X", want: false},
+		{name: "ShortAgency", bankCode: "341", arg: "57", want: false},
+		{name: "Nil", bankCode: "001", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsAgencyNumber(tc.bankCode, tc.arg); got != tc.want {
+				t.Errorf("IsAgencyNumber(%v, %v) = %v, want %v", tc.bankCode, tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsAccountNumberWithDigit(t *testing.T) {
+	testCases := []struct {
+		name     string
+		bankCode string
+		agency   string
+		arg      any
+		want     bool
+		panic    bool
+	}{
+		{name: "Itau", bankCode: "341", agency: "0057", arg: "12345-7", want: true},
+		{name: "ItauWrongDigit", bankCode: "341", agency: "0057", arg: "12345-8", want: false},
+		{name: "ItauOtherAgency", bankCode: "341", agency: "0058", arg: "12345-7", want: false},
+		{name: "ItauInvalidAgency", bankCode: "341", agency: "57", arg: "12345-7", want: false},
+		{name: "ItauShortAccount", bankCode: "341", agency: "0057", arg: "2345-7", want: false},
+		{name: "Bradesco", bankCode: "237", agency: "1234", arg: "0238069-2", want: true},
+		{name: "BradescoUnpadded", bankCode: "237", agency: "1234", arg: "238069-2", want: true},
+		{name: "BradescoDigitP", bankCode: "237", agency: "1234", arg: "100008-P", want: true},
+		{name: "BradescoWrongDigit", bankCode: "237", agency: "1234", arg: "0238069-3", want: false},
+		{name: "BradescoTooLong", bankCode: "237", agency: "1234", arg: "10238069-2", want: false},
+		{name: "BB", bankCode: "001", agency: "1584", arg: "210169-6", want: true},
+		{name: "BBPadded", bankCode: "001", agency: "1584", arg: "00210169-6", want: true},
+		{name: "BBDigitX", bankCode: "001", agency: "1584", arg: "100008-X", want: true},
+		{name: "BBWrongDigit", bankCode: "001", agency: "1584", arg: "210169-5", want: false},
+		{name: "MissingDigit", bankCode: "001", agency: "1584", arg: "210169", want: false},
+		{name: "OtherBank", bankCode: "260", agency: "0001", arg: "12345678-9", want: true},
+		{name: "OtherBankLetterDigit", bankCode: "260", agency: "0001", arg: "12345678-X", want: false},
+		{name: "Nil", bankCode: "001", agency: "1584", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsAccountNumberWithDigit(tc.bankCode, tc.agency, tc.arg); got != tc.want {
+				t.Errorf("IsAccountNumberWithDigit(%v, %v, %v) = %v, want %v", tc.bankCode, tc.agency, tc.arg, got,
+					tc.want)
+			}
+		})
+	}
+}
+
+func TestIsISPB(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Valid", arg: "60701190", want: true},
+		{name: "Zeros", arg: "00000000", want: true},
+		{name: "Short", arg: "6070119", want: false},
+		{name: "Letters", arg: "6070119A", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsISPB(tc.arg); got != tc.want {
+				t.Errorf("IsISPB(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
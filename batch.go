@@ -0,0 +1,113 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// CheckConcurrently runs the given Rule against every item, spreading the checks over a pool of goroutines, which
+// speeds up batches of expensive checks such as DNS-backed or regex-heavy ones. The result of each item is stored
+// at the same index of the returned slice.
+//
+// The batch stops as soon as the context is done or the Rule panics on an item. In both cases the items that were
+// not checked are left false and an error is returned: the context error, or an error that identifies the item
+// index and the panic value.
+//
+// Parameters:
+//   - ctx: The context that cancels the batch.
+//   - items: The values to be checked.
+//   - rule: The Rule run against each item.
+//   - workers: The number of goroutines running checks, runtime.GOMAXPROCS(0) when not positive.
+//
+// Returns:
+//   - []bool: The result of the Rule for each item, in the order of the items.
+//   - error: An error if the context was done or the Rule panicked before all the items were checked.
+//
+// Example:
+//
+//	pass, err := CheckConcurrently(ctx, []any{"12101721007", "11111111111"}, IsCPF, 4)
+//	fmt.Println(pass, err) // [true false] <nil>
+func CheckConcurrently(ctx context.Context, items []any, rule Rule, workers int) ([]bool, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(items))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pass := make([]bool, len(items))
+	indexes := make(chan int)
+	var once sync.Once
+	var panicErr error
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				result, err := checkItem(rule, items[i], i)
+				if err != nil {
+					once.Do(func() { panicErr = err })
+					cancel()
+					return
+				}
+				pass[i] = result
+			}
+		}()
+	}
+
+	var err error
+	for i := 0; i < len(items) && err == nil; i++ {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case indexes <- i:
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if panicErr != nil {
+		return pass, panicErr
+	}
+	return pass, err
+}
+
+// checkItem runs the Rule against the item at the given index, converting a panic into an error.
+func checkItem(rule Rule, item any, index int) (result bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("rule panicked on item %d: %v", index, r)
+		}
+	}()
+	return rule(item), nil
+}
@@ -0,0 +1,82 @@
+package checker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCheckConcurrently(t *testing.T) {
+	items := []any{"12101721007", "11111111111", 12101721007, "invalid", "121.017.210-07"}
+	want := []bool{true, false, true, false, true}
+
+	for _, workers := range []int{0, 1, 3, 10} {
+		pass, err := CheckConcurrently(context.Background(), items, IsCPF, workers)
+		if err != nil {
+			t.Fatalf("CheckConcurrently(workers=%d) returned error %v", workers, err)
+		}
+		for i := range want {
+			if pass[i] != want[i] {
+				t.Errorf("CheckConcurrently(workers=%d)[%d] = %v, want %v", workers, i, pass[i], want[i])
+			}
+		}
+	}
+}
+
+func TestCheckConcurrentlyEmpty(t *testing.T) {
+	pass, err := CheckConcurrently(context.Background(), nil, IsCPF, 4)
+	if err != nil || len(pass) != 0 {
+		t.Errorf("CheckConcurrently(nil) = %v, %v, want [], <nil>", pass, err)
+	}
+}
+
+func TestCheckConcurrentlyCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls atomic.Int32
+	rule := func(a any) bool {
+		calls.Add(1)
+		return true
+	}
+	pass, err := CheckConcurrently(ctx, []any{1, 2, 3}, rule, 2)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("CheckConcurrently() error = %v, want %v", err, context.Canceled)
+	}
+	if calls.Load() != 0 || len(pass) != 3 || pass[0] || pass[1] || pass[2] {
+		t.Errorf("CheckConcurrently() = %v after %d checks, want no item checked", pass, calls.Load())
+	}
+}
+
+func TestCheckConcurrentlyStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	items := make([]any, 100)
+	var calls atomic.Int32
+	rule := func(a any) bool {
+		if calls.Add(1) == 5 {
+			cancel()
+		}
+		return true
+	}
+	_, err := CheckConcurrently(ctx, items, rule, 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("CheckConcurrently() error = %v, want %v", err, context.Canceled)
+	}
+	if got := calls.Load(); got >= int32(len(items)) {
+		t.Errorf("CheckConcurrently() checked %d items after the cancellation, want fewer than %d", got, len(items))
+	}
+}
+
+func TestCheckConcurrentlyPanic(t *testing.T) {
+	pass, err := CheckConcurrently(context.Background(), []any{"12101721007", nil}, IsCPF, 1)
+	if err == nil || !strings.Contains(err.Error(), "item 1") {
+		t.Fatalf("CheckConcurrently() error = %v, want the panic of item 1", err)
+	}
+	if !pass[0] || pass[1] {
+		t.Errorf("CheckConcurrently() = %v, want [true false]", pass)
+	}
+}
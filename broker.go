@@ -0,0 +1,174 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// IsKafkaTopicName checks if a given value is a valid Apache Kafka topic name. The name must have between 1 and 249
+// characters, contain only ASCII alphanumerics, '.', '_' and '-', and cannot be "." or "..".
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid Kafka topic name.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsKafkaTopicName("orders.created_v1")) // true
+//	fmt.Println(IsKafkaTopicName("orders created"))    // false
+//	fmt.Println(IsKafkaTopicName(".."))                // false
+func IsKafkaTopicName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsKafkaTopicName", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^[a-zA-Z0-9._-]{1,249}$`)
+	return s != "." && s != ".." && regex.MatchString(s)
+}
+
+// IsRabbitRoutingKey checks if a given value is a valid RabbitMQ topic routing key. The key must have at most 255
+// bytes and be made of non-empty words separated by '.', without whitespace. When allowWildcards is true the value
+// is treated as a binding key, in which a word may be '*' (exactly one word) or '#' (zero or more words); otherwise
+// those wildcard words are rejected, since a published key containing them would match unintended bindings.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//   - allowWildcards: Whether the '*' and '#' wildcard words are accepted.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid routing key.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsRabbitRoutingKey("tenant.42.order.created", false)) // true
+//	fmt.Println(IsRabbitRoutingKey("tenant.*.order.#", true))         // true
+//	fmt.Println(IsRabbitRoutingKey("tenant.*.order.#", false))        // false
+//	fmt.Println(IsRabbitRoutingKey("tenant..order", false))           // false
+func IsRabbitRoutingKey(a any, allowWildcards bool) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsRabbitRoutingKey", time.Now(), &passed)
+	}
+	s := toString(a)
+	if isEmpty(s) || len(s) > 255 {
+		return false
+	}
+
+	regex := regexp.MustCompile(`^[^\s.]+$`)
+	for _, word := range strings.Split(s, ".") {
+		if word == "*" || word == "#" {
+			if !allowWildcards {
+				return false
+			}
+		} else if !regex.MatchString(word) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSQSQueueName checks if a given value is a valid Amazon SQS queue name. The name must have between 1 and 80
+// characters and contain only ASCII alphanumerics, '-' and '_', except for FIFO queues, whose name must end with
+// the ".fifo" suffix, which counts toward the limit.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid SQS queue name.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsSQSQueueName("order-events"))      // true
+//	fmt.Println(IsSQSQueueName("order-events.fifo")) // true
+//	fmt.Println(IsSQSQueueName("order.events"))      // false
+func IsSQSQueueName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSQSQueueName", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^[a-zA-Z0-9_-]+(\.fifo)?$`)
+	return len(s) <= 80 && regex.MatchString(s)
+}
+
+// IsNATSSubject checks if a given value is a valid NATS subject. The subject must be made of non-empty tokens
+// separated by '.', without whitespace. When allowWildcards is true the value is treated as a subscription
+// subject, in which a token may be '*' (exactly one token) and the last token may be '>' (one or more tokens);
+// otherwise, as required for publishing, any wildcard token is rejected.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//   - allowWildcards: Whether the '*' and '>' wildcard tokens are accepted.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid NATS subject.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsNATSSubject("orders.us.created", false)) // true
+//	fmt.Println(IsNATSSubject("orders.*.created", true))   // true
+//	fmt.Println(IsNATSSubject("orders.>", true))           // true
+//	fmt.Println(IsNATSSubject("orders.>.created", true))   // false
+//	fmt.Println(IsNATSSubject("orders.*", false))          // false
+func IsNATSSubject(a any, allowWildcards bool) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNATSSubject", time.Now(), &passed)
+	}
+	s := toString(a)
+	if isEmpty(s) {
+		return false
+	}
+
+	regex := regexp.MustCompile(`^[^\s.*>]+$`)
+	tokens := strings.Split(s, ".")
+	for i, token := range tokens {
+		if token == "*" || (token == ">" && i == len(tokens)-1) {
+			if !allowWildcards {
+				return false
+			}
+		} else if !regex.MatchString(token) {
+			return false
+		}
+	}
+	return true
+}
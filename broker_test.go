@@ -0,0 +1,143 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsKafkaTopicName(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: "orders", want: true},
+		{name: "WithSeparators", arg: "orders.created_v1-eu", want: true},
+		{name: "MaxLength", arg: strings.Repeat("a", 249), want: true},
+		{name: "TooLong", arg: strings.Repeat("a", 250), want: false},
+		{name: "Space", arg: "orders created", want: false},
+		{name: "Slash", arg: "orders/created", want: false},
+		{name: "Dot", arg: ".", want: false},
+		{name: "DoubleDot", arg: "..", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsKafkaTopicName(tc.arg); got != tc.want {
+				t.Errorf("IsKafkaTopicName(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsSQSQueueName(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Standard", arg: "order-events_v2", want: true},
+		{name: "FIFO", arg: "order-events.fifo", want: true},
+		{name: "MaxLength", arg: strings.Repeat("a", 75) + ".fifo", want: true},
+		{name: "TooLong", arg: strings.Repeat("a", 76) + ".fifo", want: false},
+		{name: "OnlySuffix", arg: ".fifo", want: false},
+		{name: "Dot", arg: "order.events", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsSQSQueueName(tc.arg); got != tc.want {
+				t.Errorf("IsSQSQueueName(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+type wildcardCase struct {
+	name      string
+	arg       any
+	wildcards bool
+	want      bool
+	panic     bool
+}
+
+func TestIsRabbitRoutingKey(t *testing.T) {
+	testCases := []wildcardCase{
+		{name: "RoutingKey", arg: "tenant.42.order.created", want: true},
+		{name: "SingleWord", arg: "orders", want: true},
+		{name: "BindingKey", arg: "tenant.*.order.#", wildcards: true, want: true},
+		{name: "HashOnly", arg: "#", wildcards: true, want: true},
+		{name: "LiteralAsterisk", arg: "tenant.a*b", want: true},
+		{name: "WildcardNotAllowed", arg: "tenant.*.order", want: false},
+		{name: "HashNotAllowed", arg: "tenant.#", want: false},
+		{name: "EmptyWord", arg: "tenant..order", wildcards: true, want: false},
+		{name: "TrailingDot", arg: "tenant.", want: false},
+		{name: "Whitespace", arg: "tenant.order created", want: false},
+		{name: "TooLong", arg: strings.Repeat("a", 256), want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsRabbitRoutingKey(tc.arg, tc.wildcards); got != tc.want {
+				t.Errorf("IsRabbitRoutingKey(%v, %v) = %v, want %v", tc.arg, tc.wildcards, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsNATSSubject(t *testing.T) {
+	testCases := []wildcardCase{
+		{name: "Subject", arg: "orders.us.created", want: true},
+		{name: "SingleToken", arg: "orders", want: true},
+		{name: "SingleWildcard", arg: "orders.*.created", wildcards: true, want: true},
+		{name: "FullWildcard", arg: "orders.>", wildcards: true, want: true},
+		{name: "OnlyFullWildcard", arg: ">", wildcards: true, want: true},
+		{name: "FullWildcardNotLast", arg: "orders.>.created", wildcards: true, want: false},
+		{name: "WildcardNotAllowed", arg: "orders.*", want: false},
+		{name: "FullWildcardNotAllowed", arg: "orders.>", want: false},
+		{name: "PartialWildcard", arg: "orders.us*", wildcards: true, want: false},
+		{name: "EmptyToken", arg: "orders..created", want: false},
+		{name: "LeadingDot", arg: ".orders", want: false},
+		{name: "Whitespace", arg: "orders.us created", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsNATSSubject(tc.arg, tc.wildcards); got != tc.want {
+				t.Errorf("IsNATSSubject(%v, %v) = %v, want %v", tc.arg, tc.wildcards, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// CharsetAlphaNum represents the ASCII letters and digits, for use with OnlyContains and ContainsNoneOf.
+	CharsetAlphaNum = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	// CharsetHex represents the hexadecimal digits in both cases, for use with OnlyContains and ContainsNoneOf.
+	CharsetHex = "0123456789abcdefABCDEF"
+	// CharsetBase58 represents the Bitcoin Base58 alphabet, which leaves out "0", "O", "I" and "l" to avoid
+	// visually ambiguous characters, for use with OnlyContains and ContainsNoneOf.
+	CharsetBase58 = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+// OnlyContains checks if a given value is a non-empty string made only of characters found in allowed. The
+// allowed set is compared rune by rune, so it may hold any Unicode characters, and the presets CharsetAlphaNum,
+// CharsetHex and CharsetBase58 can be combined by concatenation, such as CharsetAlphaNum+"-_".
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to a string.
+//   - allowed: The set of characters the value may contain.
+//
+// Returns:
+//   - bool: A boolean value indicating whether every character of the value is in allowed.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(OnlyContains("deadBEEF", CharsetHex))            // true
+//	fmt.Println(OnlyContains("my-slug_1", CharsetAlphaNum+"-_")) // true
+//	fmt.Println(OnlyContains("0OIl", CharsetBase58))             // false
+//	fmt.Println(OnlyContains("", CharsetAlphaNum))               // false
+func OnlyContains(a any, allowed string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("OnlyContains", time.Now(), &passed)
+	}
+	s := toString(a)
+	if isEmpty(s) {
+		return false
+	}
+
+	return strings.IndexFunc(s, func(r rune) bool {
+		return !strings.ContainsRune(allowed, r)
+	}) == -1
+}
+
+// ContainsNoneOf checks if a given value has none of the characters found in forbidden. The forbidden set is
+// compared rune by rune, so it may hold any Unicode characters. An empty value contains none of them and passes.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to a string.
+//   - forbidden: The set of characters the value must not contain.
+//
+// Returns:
+//   - bool: A boolean value indicating whether no character of the value is in forbidden.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(ContainsNoneOf("report.pdf", `/\:*?"<>|`)) // true
+//	fmt.Println(ContainsNoneOf("../etc/passwd", `/\`))     // false
+//	fmt.Println(ContainsNoneOf("", CharsetHex))            // true
+func ContainsNoneOf(a any, forbidden string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ContainsNoneOf", time.Now(), &passed)
+	}
+	return !strings.ContainsAny(toString(a), forbidden)
+}
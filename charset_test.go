@@ -0,0 +1,57 @@
+package checker
+
+import "testing"
+
+type charsetCase struct {
+	name  string
+	arg   any
+	set   string
+	want  bool
+	panic bool
+}
+
+func runCharsetCases(t *testing.T, name string, fn func(any, string) bool, tests []charsetCase) {
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := fn(tc.arg, tc.set); got != tc.want {
+				t.Errorf("%s(%v, %q) = %v, want %v", name, tc.arg, tc.set, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOnlyContains(t *testing.T) {
+	s := "abc123"
+	runCharsetCases(t, "OnlyContains", OnlyContains, []charsetCase{
+		{name: "AlphaNum", arg: "abcXYZ019", set: CharsetAlphaNum, want: true},
+		{name: "Hex", arg: "deadBEEF", set: CharsetHex, want: true},
+		{name: "NotHex", arg: "deadbeeg", set: CharsetHex, want: false},
+		{name: "Base58", arg: "3yQ9mZ", set: CharsetBase58, want: true},
+		{name: "Base58Ambiguous", arg: "0OIl", set: CharsetBase58, want: false},
+		{name: "Combined", arg: "my-slug_1", set: CharsetAlphaNum + "-_", want: true},
+		{name: "Unicode", arg: "ãé", set: "ãéí", want: true},
+		{name: "Number", arg: 12345, set: "0123456789", want: true},
+		{name: "Pointer", arg: &s, set: CharsetAlphaNum, want: true},
+		{name: "EmptySet", arg: "a", set: "", want: false},
+		{name: "Empty", arg: "", set: CharsetAlphaNum, want: false},
+		{name: "Nil", arg: nil, set: CharsetAlphaNum, panic: true},
+	})
+}
+
+func TestContainsNoneOf(t *testing.T) {
+	runCharsetCases(t, "ContainsNoneOf", ContainsNoneOf, []charsetCase{
+		{name: "Clean", arg: "report.pdf", set: `/\:*?"<>|`, want: true},
+		{name: "Forbidden", arg: "../etc/passwd", set: `/\`, want: false},
+		{name: "Unicode", arg: "olá", set: "á", want: false},
+		{name: "EmptySet", arg: "anything", set: "", want: true},
+		{name: "Empty", arg: "", set: CharsetHex, want: true},
+		{name: "Nil", arg: nil, set: CharsetHex, panic: true},
+	})
+}
@@ -0,0 +1,91 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// clock is the source of the current time of the time-dependent checkers, such as IsToday, IsBeforeNow or
+// IsAfterToday, which read it through timeNow and dateNow.
+type clock interface {
+	Now() time.Time
+}
+
+// systemClock is the clock used by default, which reads the system time.
+type systemClock struct{}
+
+// Now returns the current local time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// frozenClock is a clock stopped at a fixed instant, set by WithFrozenTime.
+type frozenClock struct {
+	now time.Time
+}
+
+// Now returns the instant the clock is stopped at.
+func (c frozenClock) Now() time.Time {
+	return c.now
+}
+
+// currentClock holds the clock set by WithFrozenTime, nil when the system clock is used.
+var currentClock atomic.Pointer[clock]
+
+// WithFrozenTime runs fn with the clock of the time-dependent checkers, such as IsToday, IsBeforeNow or
+// IsAfterToday, stopped at t, so that tests can check their behavior at a given instant, such as midnight or a
+// DST transition, instead of depending on when they run. The current date is the calendar date of t in its own
+// location, so t also sets the time zone of "today". The previous clock is restored when fn returns or panics,
+// so calls can be nested.
+//
+// The clock is shared by the whole package, so WithFrozenTime must not be used by tests running in parallel, with
+// t.Parallel, or while other goroutines run time-dependent checks.
+//
+// Parameters:
+//   - t: The instant the clock is stopped at while fn runs.
+//   - fn: The function run with the frozen clock.
+//
+// Example:
+//
+//	midnight := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+//	WithFrozenTime(midnight, func() {
+//		fmt.Println(IsToday("2024-01-01"))       // true
+//		fmt.Println(IsBeforeToday("2023-12-31")) // true
+//	})
+func WithFrozenTime(t time.Time, fn func()) {
+	var frozen clock = frozenClock{now: t}
+	previous := currentClock.Swap(&frozen)
+	defer currentClock.Store(previous)
+
+	fn()
+}
+
+// loadClock returns the clock set by WithFrozenTime, or the system clock if there is none.
+func loadClock() clock {
+	if c := currentClock.Load(); c != nil {
+		return *c
+	}
+	return systemClock{}
+}
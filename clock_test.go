@@ -0,0 +1,44 @@
+package checker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithFrozenTime(t *testing.T) {
+	first := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	second := time.Date(2030, 6, 15, 8, 30, 0, 0, time.FixedZone("BRT", -3*60*60))
+
+	WithFrozenTime(first, func() {
+		if got := timeNow(); !got.Equal(first) {
+			t.Errorf("timeNow() = %v, want %v", got, first)
+		}
+		WithFrozenTime(second, func() {
+			if got := timeNow(); !got.Equal(second) || got.Location() != second.Location() {
+				t.Errorf("timeNow() = %v, want %v", got, second)
+			}
+		})
+		if got := timeNow(); !got.Equal(first) {
+			t.Errorf("timeNow() = %v after a nested call, want %v", got, first)
+		}
+	})
+
+	if got := timeNow(); got.Sub(time.Now()).Abs() > time.Minute {
+		t.Errorf("timeNow() = %v after WithFrozenTime, want the system time", got)
+	}
+}
+
+func TestWithFrozenTimePanic(t *testing.T) {
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("The code did not panic")
+			}
+		}()
+		WithFrozenTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), func() { panic("check failed") })
+	}()
+
+	if got := timeNow(); got.Year() == 2000 {
+		t.Errorf("timeNow() = %v after a panic, want the system time", got)
+	}
+}
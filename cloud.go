@@ -0,0 +1,223 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"net"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// IsAWSARN checks if a given value is an Amazon Resource Name in the form
+// "arn:partition:service:region:account-id:resource". The partition must be a known AWS partition, such as "aws",
+// "aws-cn" or "aws-us-gov", the service is required, the region and account ID may be empty for global services,
+// the account ID must have 12 digits (or be "aws" for AWS managed resources) and the resource, which may contain
+// ':' and '/', is required.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a well-formed ARN.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsAWSARN("arn:aws:iam::123456789012:user/johndoe"))               // true
+//	fmt.Println(IsAWSARN("arn:aws:s3:::my-bucket/photos/*"))                      // true
+//	fmt.Println(IsAWSARN("arn:aws:lambda:us-east-1:123456789012:function:hello")) // true
+//	fmt.Println(IsAWSARN("arn:aws:iam::12345:user/johndoe"))                      // false
+//	fmt.Println(IsAWSARN("arn:gcp:s3:::my-bucket"))                               // false
+func IsAWSARN(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAWSARN", time.Now(), &passed)
+	}
+	parts := strings.SplitN(toString(a), ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		return false
+	}
+
+	partition, service, region, account, resource := parts[1], parts[2], parts[3], parts[4], parts[5]
+	switch partition {
+	case "aws", "aws-cn", "aws-us-gov", "aws-iso", "aws-iso-b", "aws-iso-e", "aws-iso-f", "aws-eusc":
+	default:
+		return false
+	}
+
+	serviceRegex := regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	regionRegex := regexp.MustCompile(`^([a-z]{2}(-[a-z]+)+-\d+)?$`)
+	accountRegex := regexp.MustCompile(`^(\d{12}|aws)?$`)
+	return serviceRegex.MatchString(service) && regionRegex.MatchString(region) && accountRegex.MatchString(account) &&
+		isNotEmpty(resource)
+}
+
+// IsS3BucketName checks if a given value follows the naming rules of general purpose Amazon S3 buckets, which keep
+// the name compatible with virtual-hosted DNS addresses. The name must have between 3 and 63 characters, contain
+// only lowercase letters, digits, '.' and '-', start and end with a letter or digit, have no adjacent periods or
+// period next to a hyphen, not be formatted as an IP address and not use the prefixes and suffixes reserved by AWS.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid S3 bucket name.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsS3BucketName("my-app-assets"))    // true
+//	fmt.Println(IsS3BucketName("logs.example.com")) // true
+//	fmt.Println(IsS3BucketName("My_Bucket"))        // false
+//	fmt.Println(IsS3BucketName("192.168.5.4"))      // false
+//	fmt.Println(IsS3BucketName("xn--bucket"))       // false
+func IsS3BucketName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsS3BucketName", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+	if !regex.MatchString(s) || strings.Contains(s, "..") || strings.Contains(s, ".-") || strings.Contains(s, "-.") ||
+		net.ParseIP(s) != nil {
+		return false
+	}
+
+	for _, prefix := range []string{"xn--", "sthree-", "amzn-s3-demo-"} {
+		if strings.HasPrefix(s, prefix) {
+			return false
+		}
+	}
+	for _, suffix := range []string{"-s3alias", "--ol-s3", ".mrap", "--x-s3", "--table-s3"} {
+		if strings.HasSuffix(s, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsGCPProjectID checks if a given value is a valid Google Cloud project ID. The ID must have between 6 and 30
+// characters, contain only lowercase letters, digits and hyphens, start with a letter, not end with a hyphen and
+// not contain the restricted strings "google", "null", "undefined" and "ssl".
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid GCP project ID.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsGCPProjectID("my-project-123")) // true
+//	fmt.Println(IsGCPProjectID("1-project"))      // false
+//	fmt.Println(IsGCPProjectID("short"))          // false
+//	fmt.Println(IsGCPProjectID("google-tools"))   // false
+func IsGCPProjectID(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsGCPProjectID", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+	if !regex.MatchString(s) {
+		return false
+	}
+
+	for _, restricted := range []string{"google", "null", "undefined", "ssl"} {
+		if strings.Contains(s, restricted) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsAzureResourceID checks if a given value is an Azure Resource Manager ID. Subscription, resource group and
+// resource IDs are accepted, in the forms "/subscriptions/{id}", "/subscriptions/{id}/resourceGroups/{group}" and
+// "/subscriptions/{id}[/resourceGroups/{group}]/providers/{namespace}/{type}/{name}[/{type}/{name}...]". The
+// subscription ID must be a GUID, the resource provider namespace must be dotted, such as "Microsoft.Storage", and
+// the segment keywords are compared case-insensitively as Azure does.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a well-formed Azure resource ID.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-app" +
+//		"/providers/Microsoft.Storage/storageAccounts/appstorage"
+//	fmt.Println(IsAzureResourceID(id))                          // true
+//	fmt.Println(IsAzureResourceID("/subscriptions/not-a-guid")) // false
+//	fmt.Println(IsAzureResourceID("subscriptions/1234"))        // false
+func IsAzureResourceID(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAzureResourceID", time.Now(), &passed)
+	}
+	s := toString(a)
+	if !strings.HasPrefix(s, "/") {
+		return false
+	}
+
+	segments := strings.Split(s[1:], "/")
+	guidRegex := regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") || !guidRegex.MatchString(segments[1]) {
+		return false
+	}
+	segments = segments[2:]
+
+	groupRegex := regexp.MustCompile(`^[-\w.()]{0,89}[-\w()]$`)
+	if len(segments) >= 2 && strings.EqualFold(segments[0], "resourceGroups") {
+		if !groupRegex.MatchString(segments[1]) {
+			return false
+		}
+		segments = segments[2:]
+	}
+	if len(segments) == 0 {
+		return true
+	}
+
+	namespaceRegex := regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(\.[A-Za-z][A-Za-z0-9]*)+$`)
+	if len(segments) < 4 || len(segments)%2 != 0 || !strings.EqualFold(segments[0], "providers") ||
+		!namespaceRegex.MatchString(segments[1]) {
+		return false
+	}
+	for _, segment := range segments[2:] {
+		if isEmpty(segment) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsAWSARN(t *testing.T) {
+	testCases := []baseCase{
+		{name: "IAMUser", arg: "arn:aws:iam::123456789012:user/johndoe", want: true},
+		{name: "S3Object", arg: "arn:aws:s3:::my-bucket/photos/*", want: true},
+		{name: "Lambda", arg: "arn:aws:lambda:us-east-1:123456789012:function:hello", want: true},
+		{name: "GovCloud", arg: "arn:aws-us-gov:ec2:us-gov-west-1:123456789012:instance/i-0abc", want: true},
+		{name: "ManagedPolicy", arg: "arn:aws:iam::aws:policy/ReadOnlyAccess", want: true},
+		{name: "ShortAccount", arg: "arn:aws:iam::12345:user/johndoe", want: false},
+		{name: "UnknownPartition", arg: "arn:gcp:s3:::my-bucket", want: false},
+		{name: "InvalidRegion", arg: "arn:aws:lambda:east:123456789012:function:hello", want: false},
+		{name: "MissingService", arg: "arn:aws:::123456789012:thing", want: false},
+		{name: "MissingResource", arg: "arn:aws:s3:::", want: false},
+		{name: "MissingParts", arg: "arn:aws:s3", want: false},
+		{name: "WrongPrefix", arg: "urn:aws:s3:::my-bucket", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsAWSARN(tc.arg); got != tc.want {
+				t.Errorf("IsAWSARN(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsS3BucketName(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: "my-app-assets", want: true},
+		{name: "Dotted", arg: "logs.example.com", want: true},
+		{name: "MinLength", arg: "abc", want: true},
+		{name: "MaxLength", arg: strings.Repeat("a", 63), want: true},
+		{name: "TooShort", arg: "ab", want: false},
+		{name: "TooLong", arg: strings.Repeat("a", 64), want: false},
+		{name: "UpperCase", arg: "My_Bucket", want: false},
+		{name: "AdjacentPeriods", arg: "my..bucket", want: false},
+		{name: "PeriodNextToHyphen", arg: "my-.bucket", want: false},
+		{name: "TrailingHyphen", arg: "my-bucket-", want: false},
+		{name: "IPAddress", arg: "192.168.5.4", want: false},
+		{name: "ReservedPrefix", arg: "xn--bucket", want: false},
+		{name: "ReservedSuffix", arg: "my-bucket-s3alias", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsS3BucketName(tc.arg); got != tc.want {
+				t.Errorf("IsS3BucketName(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsGCPProjectID(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Valid", arg: "my-project-123", want: true},
+		{name: "MinLength", arg: "abcdef", want: true},
+		{name: "MaxLength", arg: "a" + strings.Repeat("b", 29), want: true},
+		{name: "TooShort", arg: "short", want: false},
+		{name: "TooLong", arg: "a" + strings.Repeat("b", 30), want: false},
+		{name: "LeadingDigit", arg: "1-project", want: false},
+		{name: "TrailingHyphen", arg: "my-project-", want: false},
+		{name: "UpperCase", arg: "My-Project", want: false},
+		{name: "Restricted", arg: "google-tools", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsGCPProjectID(tc.arg); got != tc.want {
+				t.Errorf("IsGCPProjectID(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsAzureResourceID(t *testing.T) {
+	subscription := "/subscriptions/00000000-0000-0000-0000-000000000000"
+	testCases := []baseCase{
+		{name: "Subscription", arg: subscription, want: true},
+		{name: "ResourceGroup", arg: subscription + "/resourceGroups/rg-app", want: true},
+		{name: "Resource", arg: subscription + "/resourceGroups/rg-app/providers/Microsoft.Storage/storageAccounts/appstorage", want: true},
+		{name: "ChildResource", arg: subscription + "/resourceGroups/rg-app/providers/Microsoft.Sql/servers/sql1/databases/db1", want: true},
+		{name: "SubscriptionProvider", arg: subscription + "/providers/Microsoft.Authorization/roleDefinitions/reader", want: true},
+		{name: "CaseInsensitiveKeywords", arg: "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/resourcegroups/rg", want: true},
+		{name: "InvalidSubscription", arg: "/subscriptions/not-a-guid", want: false},
+		{name: "MissingLeadingSlash", arg: "subscriptions/00000000-0000-0000-0000-000000000000", want: false},
+		{name: "GroupEndingWithPeriod", arg: subscription + "/resourceGroups/rg.", want: false},
+		{name: "UndottedNamespace", arg: subscription + "/resourceGroups/rg/providers/Storage/accounts/app", want: false},
+		{name: "MissingResourceName", arg: subscription + "/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts", want: false},
+		{name: "EmptySegment", arg: subscription + "/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/", want: false},
+		{name: "UnknownSegment", arg: subscription + "/vaults/v1", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsAzureResourceID(tc.arg); got != tc.want {
+				t.Errorf("IsAzureResourceID(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
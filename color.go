@@ -0,0 +1,151 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// hexColorPattern matches a color in the short "#RGB" or in the long "#RRGGBB" hexadecimal notation.
+const hexColorPattern = `^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+
+// IsHexColor checks if a given value is a color in hexadecimal notation, in the short "#RGB" or in the long
+// "#RRGGBB" form, such as "#fff" or "#1A2B3C". The leading '#' is required and the digits are case-insensitive.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a hexadecimal color.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a hexadecimal color.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsHexColor("#1A2B3C")) // true
+//	fmt.Println(IsHexColor("#fff"))    // true
+//	fmt.Println(IsHexColor("1A2B3C"))  // false
+//	fmt.Println(IsHexColor("#12345"))  // false
+func IsHexColor(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsHexColor", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(hexColorPattern)
+	return regex.MatchString(toString(a))
+}
+
+// HasSufficientContrast checks if the contrast ratio between a foreground and a background color, as defined by
+// the Web Content Accessibility Guidelines (WCAG), is at least the given ratio. WCAG level AA requires 4.5 for
+// normal text and 3 for large text, and level AAA requires 7 and 4.5 respectively. The colors may be given in
+// hexadecimal notation, as accepted by IsHexColor, or in the "rgb(r, g, b)" functional notation with channels
+// between 0 and 255. Any color in another format results in false.
+//
+// Parameters:
+//   - fg: The foreground color, converted into a string.
+//   - bg: The background color, converted into a string.
+//   - ratio: The minimum contrast ratio, between 1 and 21.
+//
+// Returns:
+//   - bool: A boolean value indicating whether both colors are valid and their contrast reaches the ratio.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(HasSufficientContrast("#000", "#fff", 7))                    // true
+//	fmt.Println(HasSufficientContrast("#777777", "rgb(255, 255, 255)", 4.5)) // false
+//	fmt.Println(HasSufficientContrast("#777777", "rgb(255, 255, 255)", 3))   // true
+//	fmt.Println(HasSufficientContrast("black", "#fff", 4.5))                 // false
+func HasSufficientContrast(fg, bg any, ratio float64) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("HasSufficientContrast", time.Now(), &passed)
+	}
+	fgLuminance, fgOk := relativeLuminance(toString(fg))
+	bgLuminance, bgOk := relativeLuminance(toString(bg))
+	if !fgOk || !bgOk {
+		return false
+	}
+
+	lighter, darker := math.Max(fgLuminance, bgLuminance), math.Min(fgLuminance, bgLuminance)
+	return (lighter+0.05)/(darker+0.05) >= ratio
+}
+
+// relativeLuminance returns the WCAG relative luminance of the given hexadecimal or "rgb(r, g, b)" color, or false
+// if the color cannot be parsed.
+func relativeLuminance(color string) (float64, bool) {
+	channels, ok := parseRGBColor(strings.TrimSpace(color))
+	if !ok {
+		return 0, false
+	}
+
+	var linear [3]float64
+	for i, channel := range channels {
+		c := float64(channel) / 255
+		if c <= 0.03928 {
+			linear[i] = c / 12.92
+		} else {
+			linear[i] = math.Pow((c+0.055)/1.055, 2.4)
+		}
+	}
+	return 0.2126*linear[0] + 0.7152*linear[1] + 0.0722*linear[2], true
+}
+
+// parseRGBColor returns the red, green and blue channels of the given hexadecimal or "rgb(r, g, b)" color.
+func parseRGBColor(color string) ([3]uint8, bool) {
+	var channels [3]uint8
+	if regexp.MustCompile(hexColorPattern).MatchString(color) {
+		hex := color[1:]
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+		for i := range channels {
+			value, _ := strconv.ParseUint(hex[i*2:i*2+2], 16, 8)
+			channels[i] = uint8(value)
+		}
+		return channels, true
+	}
+
+	regex := regexp.MustCompile(`^(?i)rgb\(\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(\d{1,3})\s*\)$`)
+	matches := regex.FindStringSubmatch(color)
+	if matches == nil {
+		return channels, false
+	}
+	for i := range channels {
+		value, err := strconv.ParseUint(matches[i+1], 10, 8)
+		if err != nil {
+			return channels, false
+		}
+		channels[i] = uint8(value)
+	}
+	return channels, true
+}
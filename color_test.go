@@ -0,0 +1,73 @@
+package checker
+
+import "testing"
+
+func TestIsHexColor(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Long", arg: "#1A2B3C", want: true},
+		{name: "Short", arg: "#fff", want: true},
+		{name: "MixedCase", arg: "#aBcDeF", want: true},
+		{name: "NoHash", arg: "1A2B3C", want: false},
+		{name: "FiveDigits", arg: "#12345", want: false},
+		{name: "WithAlpha", arg: "#1A2B3C4D", want: false},
+		{name: "InvalidDigit", arg: "#GGGGGG", want: false},
+		{name: "Name", arg: "black", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsHexColor(tc.arg); got != tc.want {
+				t.Errorf("IsHexColor(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHasSufficientContrast(t *testing.T) {
+	testCases := []struct {
+		name  string
+		fg    any
+		bg    any
+		ratio float64
+		want  bool
+		panic bool
+	}{
+		{name: "BlackOnWhiteMaximum", fg: "#000", bg: "#fff", ratio: 21, want: true},
+		{name: "WhiteOnBlackSymmetric", fg: "#ffffff", bg: "#000000", ratio: 21, want: true},
+		{name: "SameColor", fg: "#777777", bg: "#777777", ratio: 1, want: true},
+		{name: "SameColorAboveOne", fg: "#777777", bg: "#777777", ratio: 1.1, want: false},
+		{name: "GrayFailsAA", fg: "#777777", bg: "rgb(255, 255, 255)", ratio: 4.5, want: false},
+		{name: "GrayPassesLargeText", fg: "#777777", bg: "rgb(255, 255, 255)", ratio: 3, want: true},
+		{name: "DarkGrayPassesAA", fg: "#767676", bg: "#FFF", ratio: 4.5, want: true},
+		{name: "RGBNotation", fg: "RGB(0,0,0)", bg: "rgb( 255 , 255 , 255 )", ratio: 7, want: true},
+		{name: "ChannelOutOfRange", fg: "rgb(256, 0, 0)", bg: "#fff", ratio: 1, want: false},
+		{name: "InvalidForeground", fg: "black", bg: "#fff", ratio: 1, want: false},
+		{name: "InvalidBackground", fg: "#000", bg: "#ffff", ratio: 1, want: false},
+		{name: "Nil", fg: nil, bg: "#fff", ratio: 1, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := HasSufficientContrast(tc.fg, tc.bg, tc.ratio); got != tc.want {
+				t.Errorf("HasSufficientContrast(%v, %v, %v) = %v, want %v", tc.fg, tc.bg, tc.ratio, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	_ "embed"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// confusablesList is the embedded list of characters confusable with ASCII letters, one "source ; target" pair of
+// hexadecimal code points per line, taken from the Unicode confusables data.
+//
+//go:embed confusables.txt
+var confusablesList string
+
+// confusables holds the characters of confusablesList, built on first use.
+var confusables = lazy(func() map[rune]struct{} {
+	runes := map[rune]struct{}{}
+	for _, line := range strings.Split(confusablesList, "\n") {
+		source, _, found := strings.Cut(line, ";")
+		if !found || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if r, err := strconv.ParseUint(strings.TrimSpace(source), 16, 32); err == nil {
+			runes[rune(r)] = struct{}{}
+		}
+	}
+	return runes
+})
+
+// cjkScripts lists the combinations of scripts that are written together with Latin in Chinese, Japanese and
+// Korean text, which ContainsMixedScripts does not consider mixed.
+var cjkScripts = [][]string{
+	{"Han", "Hiragana", "Katakana"},
+	{"Han", "Bopomofo"},
+	{"Han", "Hangul"},
+}
+
+// ContainsMixedScripts checks if a given value contains letters of more than one script, such as the Cyrillic 'а'
+// in "pаypal.com", the usual sign of a homograph attack on domains and usernames. Digits, punctuation and
+// combining marks belong to no script and are ignored. Following the "highly restrictive" level of Unicode
+// Technical Standard #39, Latin mixed with the scripts of Chinese, Japanese or Korean text is not considered
+// mixed.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked for mixed scripts.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value mixes the letters of different scripts.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(ContainsMixedScripts("pаypal.com")) // true
+//	fmt.Println(ContainsMixedScripts("paypal.com")) // false
+//	fmt.Println(ContainsMixedScripts("москва.рф"))  // false
+//	fmt.Println(ContainsMixedScripts("東京tower"))    // false
+func ContainsMixedScripts(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ContainsMixedScripts", time.Now(), &passed)
+	}
+	scripts := map[string]bool{}
+	for _, r := range toString(a) {
+		if script := letterScript(r); script != "" {
+			scripts[script] = true
+		}
+	}
+	if len(scripts) <= 1 {
+		return false
+	}
+
+	delete(scripts, "Latin")
+
+	for _, combination := range cjkScripts {
+		allowed := 0
+		for _, script := range combination {
+			if scripts[script] {
+				allowed++
+			}
+		}
+		if allowed == len(scripts) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsConfusables checks if a given value contains a character that looks like an ASCII letter without being
+// one, such as the Cyrillic 'а', the Greek 'ο' or the fullwidth 'ｐ', as listed in the Unicode confusables data.
+// Unlike ContainsMixedScripts, it also detects lookalikes in values written entirely in another script, such as
+// "аррӏе.com" spelled with Cyrillic letters only.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked for confusable characters.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value contains a character confusable with ASCII.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(ContainsConfusables("pаypal.com")) // true
+//	fmt.Println(ContainsConfusables("аррӏе.com"))  // true
+//	fmt.Println(ContainsConfusables("ｐaypal.com")) // true
+//	fmt.Println(ContainsConfusables("paypal.com")) // false
+//	fmt.Println(ContainsConfusables("São Paulo"))  // false
+func ContainsConfusables(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ContainsConfusables", time.Now(), &passed)
+	}
+	table := confusables()
+	for _, r := range toString(a) {
+		if r >= '\uFF01' && r <= '\uFF5E' {
+			return true
+		}
+		if _, ok := table[r]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// letterScript returns the name of the Unicode script of the letter, or an empty string if the rune is not a
+// letter or belongs to the Common or Inherited scripts.
+func letterScript(r rune) string {
+	if r < utf8.RuneSelf {
+		if ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') {
+			return "Latin"
+		}
+		return ""
+	}
+	if !unicode.IsLetter(r) {
+		return ""
+	}
+	for name, table := range unicode.Scripts {
+		if name != "Common" && name != "Inherited" && unicode.Is(table, r) {
+			return name
+		}
+	}
+	return ""
+}
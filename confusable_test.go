@@ -0,0 +1,73 @@
+package checker
+
+import "testing"
+
+func TestContainsMixedScripts(t *testing.T) {
+	testCases := []baseCase{
+		{name: "LatinOnly", arg: "paypal.com", want: false},
+		{name: "CyrillicA", arg: "pаypal.com", want: true},
+		{name: "CyrillicOnly", arg: "москва.рф", want: false},
+		{name: "GreekOmicron", arg: "gοogle.com", want: true},
+		{name: "LatinWithAccents", arg: "São Paulo", want: false},
+		{name: "DigitsAndPunctuation", arg: "123-456_789", want: false},
+		{name: "Japanese", arg: "東京タワーとtower", want: false},
+		{name: "Chinese", arg: "北京abc", want: false},
+		{name: "Korean", arg: "서울한국abc", want: false},
+		{name: "HangulAndKana", arg: "서울タワー", want: true},
+		{name: "CyrillicAndGreek", arg: "αб", want: true},
+		{name: "CombiningMark", arg: "café", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Number", arg: 12345, want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := ContainsMixedScripts(tc.arg); got != tc.want {
+				t.Errorf("ContainsMixedScripts(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContainsConfusables(t *testing.T) {
+	testCases := []baseCase{
+		{name: "LatinOnly", arg: "paypal.com", want: false},
+		{name: "CyrillicA", arg: "pаypal.com", want: true},
+		{name: "CyrillicOnly", arg: "аррӏе.com", want: true},
+		{name: "GreekOmicron", arg: "gοogle.com", want: true},
+		{name: "Armenian", arg: "gօօgle.com", want: true},
+		{name: "Fullwidth", arg: "ｐaypal.com", want: true},
+		{name: "RomanNumeral", arg: "ⅰnbox", want: true},
+		{name: "DotlessI", arg: "lınk", want: true},
+		{name: "LatinWithAccents", arg: "São Paulo", want: false},
+		{name: "CyrillicWithoutLookalikes", arg: "жизнь", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Number", arg: 12345, want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := ContainsConfusables(tc.arg); got != tc.want {
+				t.Errorf("ContainsConfusables(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
@@ -23,15 +23,18 @@
 package checker
 
 import (
-	"fmt"
 	"reflect"
 	"strings"
+	"time"
 )
 
 // Contains checks if the provided value 'b' is contained within the value 'a'.
 // It uses reflection to determine the type of 'a' and performs appropriate checks
 // for slice, array, map, struct, and string types. For a slice or an array,
 // it iterates over each element and uses reflect.DeepEqual to compare with 'b'.
+// The common types []string, []int, []int64, []float64 and maps with string keys and
+// basic values are compared directly with the == operator, avoiding reflection on large
+// collections, with the same result as the reflective comparison.
 //
 // Example usage:
 //
@@ -50,17 +53,29 @@ import (
 //
 //	strA := "Hello World"
 //	fmt.Println(Contains(strA, "World"))  // true
-func Contains(a, b any) bool {
+func Contains(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("Contains", time.Now(), &passed)
+	}
+	return contains(a, b)
+}
+
+// contains is the unobserved implementation of Contains.
+func contains(a, b any) bool {
 	validateContainsParams(a)
 
 	reflectValueA := reflect.ValueOf(a)
 	if reflectValueA.Kind() == reflect.Ptr || reflectValueA.Kind() == reflect.Interface {
-		return Contains(reflectValueA.Elem().Interface(), b)
+		return contains(reflectValueA.Elem().Interface(), b)
 	}
 
 	reflectValueB := reflect.ValueOf(b)
 	if reflectValueB.Kind() == reflect.Ptr || reflectValueB.Kind() == reflect.Interface {
-		return Contains(a, reflectValueB.Elem().Interface())
+		return contains(a, reflectValueB.Elem().Interface())
+	}
+
+	if result, ok := containsFastPath(a, b); ok {
+		return result
 	}
 
 	if reflectValueA.Kind() == reflect.Slice || reflectValueA.Kind() == reflect.Array {
@@ -108,8 +123,11 @@ func Contains(a, b any) bool {
 //	}
 //	s := structD{field1: "John", field2: 30}
 //	fmt.Println(NotContains(s, "Jane"))  // true
-func NotContains(a, b any) bool {
-	return !Contains(a, b)
+func NotContains(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NotContains", time.Now(), &passed)
+	}
+	return !contains(a, b)
 }
 
 // ContainsIgnoreCase checks if the provided value 'b' is contained within the value 'a',
@@ -121,17 +139,25 @@ func NotContains(a, b any) bool {
 //	strA := "Hello World"
 //	fmt.Println(ContainsIgnoreCase(strA, "WORLD"))   // true
 //	fmt.Println(ContainsIgnoreCase(strA, "goodbye")) // false
-func ContainsIgnoreCase(a, b any) bool {
+func ContainsIgnoreCase(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ContainsIgnoreCase", time.Now(), &passed)
+	}
+	return containsIgnoreCase(a, b)
+}
+
+// containsIgnoreCase is the unobserved implementation of ContainsIgnoreCase.
+func containsIgnoreCase(a, b any) bool {
 	validateContainsIgnoreCaseParams(a)
 
 	reflectValueA := reflect.ValueOf(a)
 	if reflectValueA.Kind() == reflect.Ptr || reflectValueA.Kind() == reflect.Interface {
-		return ContainsIgnoreCase(reflectValueA.Elem().Interface(), b)
+		return containsIgnoreCase(reflectValueA.Elem().Interface(), b)
 	}
 
 	reflectValueB := reflect.ValueOf(b)
 	if reflectValueB.Kind() == reflect.Ptr || reflectValueB.Kind() == reflect.Interface {
-		return ContainsIgnoreCase(a, reflectValueB.Elem().Interface())
+		return containsIgnoreCase(a, reflectValueB.Elem().Interface())
 	}
 
 	return reflectValueB.Kind() == reflect.String &&
@@ -157,10 +183,13 @@ func ContainsIgnoreCase(a, b any) bool {
 //
 // Please note:
 // Depending on the types of 'a' and 'b', the ContainsIgnoreCase function invoked by this
-// function may panic with an error stating "A is nil", or stating "Unsupported type",
+// function may panic with ErrNilValue, or with ErrUnsupportedType,
 // if 'a' is not a string or cannot be converted to one.
-func NotContainsIgnoreCase(a, b any) bool {
-	return !ContainsIgnoreCase(a, b)
+func NotContainsIgnoreCase(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NotContainsIgnoreCase", time.Now(), &passed)
+	}
+	return !containsIgnoreCase(a, b)
 }
 
 // ContainsKey checks if the provided key 'key' is present in the value 'a'.
@@ -180,17 +209,25 @@ func NotContainsIgnoreCase(a, b any) bool {
 //	s := structA{field1: 1, field2: "value"}
 //	fmt.Println(ContainsKey(s, "field1"))  // true
 //	fmt.Println(ContainsKey(s, "field3"))  // false
-func ContainsKey(a, key any) bool {
+func ContainsKey(a, key any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ContainsKey", time.Now(), &passed)
+	}
+	return containsKey(a, key)
+}
+
+// containsKey is the unobserved implementation of ContainsKey.
+func containsKey(a, key any) bool {
 	validateContainsKeyParams(a)
 
 	reflectValue := reflect.ValueOf(a)
 	if reflectValue.Kind() == reflect.Ptr || reflectValue.Kind() == reflect.Interface {
-		return ContainsKey(reflectValue.Elem().Interface(), key)
+		return containsKey(reflectValue.Elem().Interface(), key)
 	}
 
 	reflectKey := reflect.ValueOf(key)
 	if reflectKey.Kind() == reflect.Ptr || reflectKey.Kind() == reflect.Interface {
-		return ContainsKey(a, reflectKey.Elem().Interface())
+		return containsKey(a, reflectKey.Elem().Interface())
 	}
 
 	if reflectValue.Kind() == reflect.Struct && reflectKey.Kind() == reflect.String {
@@ -212,8 +249,8 @@ func ContainsKey(a, key any) bool {
 //   - bool: A boolean value indicating whether the provided key is not present in the value.
 //
 // Panic:
-//   - If 'a' is nil, it panics with the message "A is nil"
-//   - If 'a' is neither a map nor a struct, it panics with a formatted string indicating the unsupported type
+//   - If 'a' is nil, it panics with ErrNilValue
+//   - If 'a' is neither a map nor a struct, it panics with an ErrUnsupportedType indicating the unsupported type
 //
 // Example:
 //
@@ -228,8 +265,11 @@ func ContainsKey(a, key any) bool {
 //	s := structA{field1: 1, field2: "value"}
 //	fmt.Println(NotContainsKey(s, "field1")) // false
 //	fmt.Println(NotContainsKey(s, "field3"))  // true
-func NotContainsKey(a, key any) bool {
-	return !ContainsKey(a, key)
+func NotContainsKey(a, key any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NotContainsKey", time.Now(), &passed)
+	}
+	return !containsKey(a, key)
 }
 
 // ContainsOnSlice checks if the provided value 'b' is found by the 'found' function when applied to the elements in the slice 'a'.
@@ -247,7 +287,15 @@ func NotContainsKey(a, key any) bool {
 //	fmt.Println(ContainsOnSlice(elements, func(index int, element int) bool {
 //	    return element == 10
 //	}))  // false
-func ContainsOnSlice[T any](a []T, found func(index int, element T) bool) bool {
+func ContainsOnSlice[T any](a []T, found func(index int, element T) bool) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ContainsOnSlice", time.Now(), &passed)
+	}
+	return containsOnSlice[T](a, found)
+}
+
+// containsOnSlice is the unobserved implementation of ContainsOnSlice.
+func containsOnSlice[T any](a []T, found func(index int, element T) bool) bool {
 	for index, element := range a {
 		if found(index, element) {
 			return true
@@ -277,42 +325,45 @@ func ContainsOnSlice[T any](a []T, found func(index int, element T) bool) bool {
 //	fmt.Println(NotContainsOnSlice(elements, func(index int, element int) bool {
 //	    return element == 10
 //	}))  // true
-func NotContainsOnSlice[T any](a []T, found func(index int, element T) bool) bool {
-	return !ContainsOnSlice(a, found)
+func NotContainsOnSlice[T any](a []T, found func(index int, element T) bool) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NotContainsOnSlice", time.Now(), &passed)
+	}
+	return !containsOnSlice(a, found)
 }
 
 // validateContainsParams validates the value 'a' to ensure it is a supported type for
-// the Contains function. If 'a' is nil, it panics with the message "A is nil".
+// the Contains function. If 'a' is nil, it panics with ErrNilValue.
 // If 'a' is not one of the supported types (slice, array, map, struct, string),
-// it panics with a formatted string message indicating the unsupported type.
+// it panics with an ErrUnsupportedType indicating the unsupported type.
 func validateContainsParams(a any) {
 	reflectValueA := reflect.ValueOf(a)
 
-	if IsNil(a) {
-		panic("A is nil")
+	if isNil(a) {
+		panic(ErrNilValue)
 	} else if reflectValueA.Kind() != reflect.Slice && reflectValueA.Kind() != reflect.Array &&
 		reflectValueA.Kind() != reflect.Map && reflectValueA.Kind() != reflect.Struct &&
 		reflectValueA.Kind() != reflect.String && reflectValueA.Kind() != reflect.Ptr {
-		panic(fmt.Sprintf("Unsupported type: %s", reflectValueA.Kind().String()))
+		panic(ErrUnsupportedType{Kind: reflectValueA.Kind()})
 	}
 }
 
 // validateContainsIgnoreCaseParams validates the value 'a' to ensure it is not nil and of type string.
-// If 'a' is nil, it panics with the message "A is nil".
-// If 'a' is not of type string, it panics with a formatted message indicating the unsupported type.
+// If 'a' is nil, it panics with ErrNilValue.
+// If 'a' is not of type string, it panics with an ErrUnsupportedType indicating the unsupported type.
 func validateContainsIgnoreCaseParams(a any) {
 	reflectValueA := reflect.ValueOf(a)
 
-	if IsNil(a) {
-		panic("A is nil")
+	if isNil(a) {
+		panic(ErrNilValue)
 	} else if reflectValueA.Kind() != reflect.String && reflectValueA.Kind() != reflect.Ptr {
-		panic(fmt.Sprintf("Unsupported type: %s", reflectValueA.Kind().String()))
+		panic(ErrUnsupportedType{Kind: reflectValueA.Kind()})
 	}
 }
 
 // validateContainsKeyParams validates the value 'a' to ensure it is a map or struct.
-// If 'a' is nil, it panics with the message "A is nil".
-// If 'a' is neither a map nor a struct, it panics with a formatted string
+// If 'a' is nil, it panics with ErrNilValue.
+// If 'a' is neither a map nor a struct, it panics with an ErrUnsupportedType
 // indicating the unsupported type.
 // This function uses reflection to determine the type of 'a'.
 // It is used by the ContainsKey function to validateStringParams the input value before
@@ -320,14 +371,72 @@ func validateContainsIgnoreCaseParams(a any) {
 func validateContainsKeyParams(a any) {
 	reflectValueA := reflect.ValueOf(a)
 
-	if IsNil(a) {
-		panic("A is nil")
+	if isNil(a) {
+		panic(ErrNilValue)
 	} else if reflectValueA.Kind() != reflect.Map && reflectValueA.Kind() != reflect.Struct &&
 		reflectValueA.Kind() != reflect.Ptr && reflectValueA.Kind() != reflect.Interface {
-		panic(fmt.Sprintf("Unsupported type: %s", reflectValueA.Kind().String()))
+		panic(ErrUnsupportedType{Kind: reflectValueA.Kind()})
 	}
 }
 
+// containsFastPath checks the most common slice and map types without reflection. The value 'b' must have the
+// exact element type of 'a', otherwise the result is false, matching the reflect.DeepEqual semantics used by the
+// generic path. The second return value is false when 'a' is not one of the optimized types.
+func containsFastPath(a, b any) (bool, bool) {
+	switch typedA := a.(type) {
+	case []string:
+		return containsComparableInSlice(typedA, b), true
+	case []int:
+		return containsComparableInSlice(typedA, b), true
+	case []int64:
+		return containsComparableInSlice(typedA, b), true
+	case []float64:
+		return containsComparableInSlice(typedA, b), true
+	case map[string]string:
+		return containsComparableInMap(typedA, b), true
+	case map[string]int:
+		return containsComparableInMap(typedA, b), true
+	case map[string]int64:
+		return containsComparableInMap(typedA, b), true
+	case map[string]float64:
+		return containsComparableInMap(typedA, b), true
+	case map[string]bool:
+		return containsComparableInMap(typedA, b), true
+	default:
+		return false, false
+	}
+}
+
+// containsComparableInSlice checks if the provided value 'value' has the element type T and is contained within
+// the slice 'slice', comparing the elements with the == operator.
+func containsComparableInSlice[T comparable](slice []T, value any) bool {
+	typedValue, ok := value.(T)
+	if !ok {
+		return false
+	}
+	for _, element := range slice {
+		if element == typedValue {
+			return true
+		}
+	}
+	return false
+}
+
+// containsComparableInMap checks if the provided value 'value' has the value type V and is contained within
+// the values of the map 'm', comparing them with the == operator.
+func containsComparableInMap[K comparable, V comparable](m map[K]V, value any) bool {
+	typedValue, ok := value.(V)
+	if !ok {
+		return false
+	}
+	for _, mapValue := range m {
+		if mapValue == typedValue {
+			return true
+		}
+	}
+	return false
+}
+
 // containsValueInSlice checks if the provided value 'value' is contained within the slice 'reflectValueSlice'.
 // It iterates over the elements of the slice and uses reflect.DeepEqual to compare each element with the value.
 // If a match is found, it returns true. Otherwise, it returns false.
@@ -1,6 +1,8 @@
 package checker
 
 import (
+	"reflect"
+	"strconv"
 	"testing"
 )
 
@@ -524,3 +526,90 @@ func TestNotContainsOnSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestContainsFastPath(t *testing.T) {
+	tests := []containsCase{
+		{name: "StringSlice", a: []string{"a", "b", "c"}, b: "c", want: true},
+		{name: "StringSliceMissing", a: []string{"a", "b", "c"}, b: "d", want: false},
+		{name: "StringSliceOtherType", a: []string{"1"}, b: 1, want: false},
+		{name: "IntSlice", a: []int{1, 2, 3}, b: 2, want: true},
+		{name: "IntSliceInt64Value", a: []int{1, 2, 3}, b: int64(2), want: false},
+		{name: "Int64Slice", a: []int64{1, 2, 3}, b: int64(3), want: true},
+		{name: "Float64Slice", a: []float64{1.5, 2.5}, b: 2.5, want: true},
+		{name: "Float64SliceMissing", a: []float64{1.5, 2.5}, b: 3.5, want: false},
+		{name: "StringMap", a: map[string]string{"x": "go"}, b: "go", want: true},
+		{name: "IntMap", a: map[string]int{"x": 1}, b: 1, want: true},
+		{name: "IntMapMissing", a: map[string]int{"x": 1}, b: 2, want: false},
+		{name: "Int64Map", a: map[string]int64{"x": 1}, b: int64(1), want: true},
+		{name: "Float64Map", a: map[string]float64{"x": 1.1}, b: 1.1, want: true},
+		{name: "BoolMap", a: map[string]bool{"x": false}, b: true, want: false},
+		{name: "StringSlicePointer", a: &[]string{"a"}, b: "a", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Contains(tt.a, tt.b); got != tt.want {
+				t.Errorf("Contains() = %v, want = %v", got, tt.want)
+			}
+			if result, ok := containsFastPath(tt.a, tt.b); ok && result != containsValueInSliceOrMap(tt.a, tt.b) {
+				t.Errorf("containsFastPath() = %v, differs from the reflection path", result)
+			}
+		})
+	}
+}
+
+func BenchmarkContainsStringSlice(b *testing.B) {
+	allowlist := buildStringAllowlist(100_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Contains(allowlist, "value-99999")
+	}
+}
+
+func BenchmarkContainsStringSliceReflection(b *testing.B) {
+	allowlist := buildStringAllowlist(100_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		containsValueInSliceOrMap(allowlist, "value-99999")
+	}
+}
+
+func BenchmarkContainsIntSlice(b *testing.B) {
+	allowlist := make([]int, 100_000)
+	for i := range allowlist {
+		allowlist[i] = i
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Contains(allowlist, 99_999)
+	}
+}
+
+func BenchmarkContainsStringMap(b *testing.B) {
+	allowlist := make(map[string]string, 100_000)
+	for _, value := range buildStringAllowlist(100_000) {
+		allowlist[value] = value
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Contains(allowlist, "missing")
+	}
+}
+
+func buildStringAllowlist(size int) []string {
+	allowlist := make([]string, size)
+	for i := range allowlist {
+		allowlist[i] = "value-" + strconv.Itoa(i)
+	}
+	return allowlist
+}
+
+func containsValueInSliceOrMap(a, b any) bool {
+	reflectValueA := reflect.ValueOf(a)
+	if reflectValueA.Kind() == reflect.Ptr {
+		reflectValueA = reflectValueA.Elem()
+	}
+	if reflectValueA.Kind() == reflect.Map {
+		return containsValueInMap(reflectValueA, b)
+	}
+	return containsValueInSlice(reflectValueA, b)
+}
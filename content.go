@@ -0,0 +1,282 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"time"
+)
+
+// IsZIPBytes checks whether the given bytes hold a ZIP archive. It verifies the local file header signature and
+// reads the central directory with archive/zip, without decompressing any entry.
+//
+// Parameters:
+//   - b: The content to be inspected.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the content is a readable ZIP archive.
+//
+// Example:
+//
+//	content, _ := os.ReadFile("archive.zip")
+//	fmt.Println(IsZIPBytes(content))             // true
+//	fmt.Println(IsZIPBytes([]byte("not a zip"))) // false
+func IsZIPBytes(b []byte) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsZIPBytes", time.Now(), &passed)
+	}
+	return isZIPBytes(b)
+}
+
+// isZIPBytes is the unobserved implementation of IsZIPBytes.
+func isZIPBytes(b []byte) bool {
+	if !bytes.HasPrefix(b, []byte("PK\x03\x04")) && !bytes.HasPrefix(b, []byte("PK\x05\x06")) {
+		return false
+	}
+	_, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	return err == nil
+}
+
+// IsTarBytes checks whether the given bytes hold a tar archive. It parses the first header with archive/tar,
+// which validates the header checksum, without reading the entry contents.
+//
+// Parameters:
+//   - b: The content to be inspected.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the content starts with a valid tar header.
+//
+// Example:
+//
+//	content, _ := os.ReadFile("archive.tar")
+//	fmt.Println(IsTarBytes(content))             // true
+//	fmt.Println(IsTarBytes([]byte("not a tar"))) // false
+func IsTarBytes(b []byte) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsTarBytes", time.Now(), &passed)
+	}
+	if len(b) < 512 {
+		return false
+	}
+	_, err := tar.NewReader(bytes.NewReader(b)).Next()
+	return err == nil
+}
+
+// Is7zBytes checks whether the given bytes start with the 7-Zip archive signature.
+//
+// Parameters:
+//   - b: The content to be inspected.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the content starts with the 7z signature.
+//
+// Example:
+//
+//	content, _ := os.ReadFile("archive.7z")
+//	fmt.Println(Is7zBytes(content))            // true
+//	fmt.Println(Is7zBytes([]byte("not a 7z"))) // false
+func Is7zBytes(b []byte) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("Is7zBytes", time.Now(), &passed)
+	}
+	return bytes.HasPrefix(b, []byte{'7', 'z', 0xBC, 0xAF, 0x27, 0x1C})
+}
+
+// IsDocxBytes checks whether the given bytes hold a Word document (Office Open XML). It uses IsZIPBytes and then
+// requires the "[Content_Types].xml" and "word/document.xml" entries in the archive directory.
+//
+// Parameters:
+//   - b: The content to be inspected.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the content is a DOCX document.
+//
+// Example:
+//
+//	content, _ := os.ReadFile("contract.docx")
+//	fmt.Println(IsDocxBytes(content)) // true
+func IsDocxBytes(b []byte) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsDocxBytes", time.Now(), &passed)
+	}
+	return zipContainsEntries(b, "[Content_Types].xml", "word/document.xml")
+}
+
+// IsXlsxBytes checks whether the given bytes hold an Excel workbook (Office Open XML). It uses IsZIPBytes and then
+// requires the "[Content_Types].xml" and "xl/workbook.xml" entries in the archive directory.
+//
+// Parameters:
+//   - b: The content to be inspected.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the content is an XLSX workbook.
+//
+// Example:
+//
+//	content, _ := os.ReadFile("report.xlsx")
+//	fmt.Println(IsXlsxBytes(content)) // true
+func IsXlsxBytes(b []byte) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsXlsxBytes", time.Now(), &passed)
+	}
+	return zipContainsEntries(b, "[Content_Types].xml", "xl/workbook.xml")
+}
+
+// zipContainsEntries checks if the given bytes are a ZIP archive whose directory lists all the given entry names.
+// Only the central directory is read, entries are not decompressed.
+func zipContainsEntries(b []byte, names ...string) bool {
+	if !isZIPBytes(b) {
+		return false
+	}
+
+	reader, _ := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	entries := map[string]bool{}
+	for _, file := range reader.File {
+		entries[file.Name] = true
+	}
+	for _, name := range names {
+		if !entries[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsMP3Bytes checks whether the given bytes hold MP3 audio. The content must start with an ID3v2 tag or with an MPEG
+// audio Layer III frame header (frame sync, a valid version, bitrate and sampling rate).
+//
+// Parameters:
+//   - b: The content to be inspected.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the content is MP3 audio.
+//
+// Example:
+//
+//	content, _ := os.ReadFile("song.mp3")
+//	fmt.Println(IsMP3Bytes(content))                        // true
+//	fmt.Println(IsMP3Bytes([]byte{0xFF, 0xFB, 0x90, 0x00})) // true
+//	fmt.Println(IsMP3Bytes([]byte("not an mp3")))           // false
+func IsMP3Bytes(b []byte) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMP3Bytes", time.Now(), &passed)
+	}
+	if len(b) >= 10 && bytes.HasPrefix(b, []byte("ID3")) {
+		return b[3] != 0xFF && b[4] != 0xFF
+	} else if len(b) < 4 || b[0] != 0xFF || b[1]&0xE0 != 0xE0 {
+		return false
+	}
+
+	version := (b[1] >> 3) & 0x03
+	layer := (b[1] >> 1) & 0x03
+	bitrate := b[2] >> 4
+	sampling := (b[2] >> 2) & 0x03
+	return version != 0x01 && layer == 0x01 && bitrate != 0x00 && bitrate != 0x0F && sampling != 0x03
+}
+
+// IsMP4Bytes checks whether the given bytes hold an MP4 (ISO base media) file. The content must start with an
+// "ftyp" box, and the still image brands of the same container family (HEIF and AVIF) are rejected.
+//
+// Parameters:
+//   - b: The content to be inspected.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the content is an MP4 file.
+//
+// Example:
+//
+//	content, _ := os.ReadFile("video.mp4")
+//	fmt.Println(IsMP4Bytes(content))              // true
+//	fmt.Println(IsMP4Bytes([]byte("not an mp4"))) // false
+func IsMP4Bytes(b []byte) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMP4Bytes", time.Now(), &passed)
+	}
+	if len(b) < 12 || !bytes.Equal(b[4:8], []byte("ftyp")) {
+		return false
+	}
+
+	size := int(b[0])<<24 | int(b[1])<<16 | int(b[2])<<8 | int(b[3])
+	if size < 12 {
+		return false
+	}
+
+	switch string(b[8:12]) {
+	case "heic", "heix", "hevc", "hevx", "mif1", "msf1", "avif", "avis":
+		return false
+	default:
+		return true
+	}
+}
+
+// IsWebMBytes checks whether the given bytes hold a WebM file. The content must start with the EBML header
+// signature and declare the "webm" document type, which tells it apart from other Matroska files.
+//
+// Parameters:
+//   - b: The content to be inspected.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the content is a WebM file.
+//
+// Example:
+//
+//	content, _ := os.ReadFile("clip.webm")
+//	fmt.Println(IsWebMBytes(content))              // true
+//	fmt.Println(IsWebMBytes([]byte("not a webm"))) // false
+func IsWebMBytes(b []byte) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsWebMBytes", time.Now(), &passed)
+	}
+	if !bytes.HasPrefix(b, []byte{0x1A, 0x45, 0xDF, 0xA3}) {
+		return false
+	}
+
+	header := b
+	if len(header) > 64 {
+		header = header[:64]
+	}
+	return bytes.Contains(header, []byte{0x42, 0x82, 0x84, 'w', 'e', 'b', 'm'})
+}
+
+// IsOGGBytes checks whether the given bytes hold an Ogg container, such as Vorbis or Opus audio. The content must
+// start with the "OggS" capture pattern followed by stream structure version 0.
+//
+// Parameters:
+//   - b: The content to be inspected.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the content is an Ogg file.
+//
+// Example:
+//
+//	content, _ := os.ReadFile("audio.ogg")
+//	fmt.Println(IsOGGBytes(content))              // true
+//	fmt.Println(IsOGGBytes([]byte("not an ogg"))) // false
+func IsOGGBytes(b []byte) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsOGGBytes", time.Now(), &passed)
+	}
+	return len(b) >= 27 && bytes.HasPrefix(b, []byte("OggS")) && b[4] == 0x00
+}
@@ -0,0 +1,168 @@
+package checker
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"testing"
+)
+
+type contentCase struct {
+	name string
+	arg  []byte
+	want bool
+}
+
+func TestIsZIPBytes(t *testing.T) {
+	archive := buildZIP(t, "file.txt")
+	tests := []contentCase{
+		{name: "ZIP", arg: archive, want: true},
+		{name: "EmptyZIP", arg: buildZIP(t), want: true},
+		{name: "Truncated", arg: archive[:20], want: false},
+		{name: "SignatureOnly", arg: []byte("PK\x03\x04"), want: false},
+		{name: "Text", arg: []byte("not a zip"), want: false},
+		{name: "Nil", arg: nil, want: false},
+	}
+	runContentCases(t, "IsZIPBytes", IsZIPBytes, tests)
+}
+
+func TestIsTarBytes(t *testing.T) {
+	archive := buildTar(t)
+	tests := []contentCase{
+		{name: "Tar", arg: archive, want: true},
+		{name: "CorruptedChecksum", arg: append([]byte("x"), archive[1:]...), want: false},
+		{name: "Short", arg: archive[:100], want: false},
+		{name: "Zeros", arg: make([]byte, 1024), want: false},
+		{name: "ZIP", arg: buildZIP(t, "file.txt"), want: false},
+		{name: "Nil", arg: nil, want: false},
+	}
+	runContentCases(t, "IsTarBytes", IsTarBytes, tests)
+}
+
+func TestIs7zBytes(t *testing.T) {
+	tests := []contentCase{
+		{name: "7z", arg: []byte{'7', 'z', 0xBC, 0xAF, 0x27, 0x1C, 0x00, 0x04}, want: true},
+		{name: "PartialSignature", arg: []byte{'7', 'z', 0xBC}, want: false},
+		{name: "Text", arg: []byte("7z archive"), want: false},
+		{name: "Nil", arg: nil, want: false},
+	}
+	runContentCases(t, "Is7zBytes", Is7zBytes, tests)
+}
+
+func TestIsDocxBytes(t *testing.T) {
+	tests := []contentCase{
+		{name: "Docx", arg: buildZIP(t, "[Content_Types].xml", "word/document.xml", "word/styles.xml"), want: true},
+		{name: "Xlsx", arg: buildZIP(t, "[Content_Types].xml", "xl/workbook.xml"), want: false},
+		{name: "MissingContentTypes", arg: buildZIP(t, "word/document.xml"), want: false},
+		{name: "PlainZIP", arg: buildZIP(t, "file.txt"), want: false},
+		{name: "Text", arg: []byte("document"), want: false},
+	}
+	runContentCases(t, "IsDocxBytes", IsDocxBytes, tests)
+}
+
+func TestIsXlsxBytes(t *testing.T) {
+	tests := []contentCase{
+		{name: "Xlsx", arg: buildZIP(t, "[Content_Types].xml", "xl/workbook.xml", "xl/worksheets/sheet1.xml"), want: true},
+		{name: "Docx", arg: buildZIP(t, "[Content_Types].xml", "word/document.xml"), want: false},
+		{name: "MissingWorkbook", arg: buildZIP(t, "[Content_Types].xml"), want: false},
+		{name: "Nil", arg: nil, want: false},
+	}
+	runContentCases(t, "IsXlsxBytes", IsXlsxBytes, tests)
+}
+
+func runContentCases(t *testing.T, name string, fn func([]byte) bool, tests []contentCase) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fn(tt.arg); got != tt.want {
+				t.Errorf("%s() = %v, want %v", name, got, tt.want)
+			}
+		})
+	}
+}
+
+func buildZIP(t *testing.T, names ...string) []byte {
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for _, name := range names {
+		file, err := writer.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = file.Write([]byte("content of " + name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func buildTar(t *testing.T) []byte {
+	var buffer bytes.Buffer
+	writer := tar.NewWriter(&buffer)
+	content := []byte("hello")
+	if err := writer.WriteHeader(&tar.Header{Name: "hello.txt", Mode: 0600, Size: int64(len(content))}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := writer.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func TestIsMP3Bytes(t *testing.T) {
+	tests := []contentCase{
+		{name: "ID3", arg: []byte("ID3\x04\x00\x00\x00\x00\x00\x00"), want: true},
+		{name: "FrameSync", arg: []byte{0xFF, 0xFB, 0x90, 0x00}, want: true},
+		{name: "MPEG2FrameSync", arg: []byte{0xFF, 0xF3, 0x40, 0x00}, want: true},
+		{name: "ReservedVersion", arg: []byte{0xFF, 0xEB, 0x90, 0x00}, want: false},
+		{name: "LayerII", arg: []byte{0xFF, 0xFD, 0x90, 0x00}, want: false},
+		{name: "BadBitrate", arg: []byte{0xFF, 0xFB, 0xF0, 0x00}, want: false},
+		{name: "BadSampling", arg: []byte{0xFF, 0xFB, 0x9C, 0x00}, want: false},
+		{name: "ShortID3", arg: []byte("ID3"), want: false},
+		{name: "Text", arg: []byte("not an mp3"), want: false},
+		{name: "Nil", arg: nil, want: false},
+	}
+	runContentCases(t, "IsMP3Bytes", IsMP3Bytes, tests)
+}
+
+func TestIsMP4Bytes(t *testing.T) {
+	tests := []contentCase{
+		{name: "ISOM", arg: []byte("\x00\x00\x00\x18ftypisom\x00\x00\x02\x00isomiso2"), want: true},
+		{name: "M4A", arg: []byte("\x00\x00\x00\x14ftypM4A \x00\x00\x00\x00M4A "), want: true},
+		{name: "HEIC", arg: []byte("\x00\x00\x00\x18ftypheic\x00\x00\x00\x00mif1heic"), want: false},
+		{name: "AVIF", arg: []byte("\x00\x00\x00\x18ftypavif\x00\x00\x00\x00mif1avif"), want: false},
+		{name: "InvalidBoxSize", arg: []byte("\x00\x00\x00\x04ftypisom"), want: false},
+		{name: "Short", arg: []byte("\x00\x00\x00\x18ftyp"), want: false},
+		{name: "Text", arg: []byte("not an mp4 file"), want: false},
+		{name: "Nil", arg: nil, want: false},
+	}
+	runContentCases(t, "IsMP4Bytes", IsMP4Bytes, tests)
+}
+
+func TestIsWebMBytes(t *testing.T) {
+	tests := []contentCase{
+		{name: "WebM", arg: []byte("\x1A\x45\xDF\xA3\x9F\x42\x86\x81\x01\x42\x82\x84webm\x42\x87\x81\x04"), want: true},
+		{name: "Matroska", arg: []byte("\x1A\x45\xDF\xA3\xA3\x42\x86\x81\x01\x42\x82\x88matroska"), want: false},
+		{name: "SignatureOnly", arg: []byte{0x1A, 0x45, 0xDF, 0xA3}, want: false},
+		{name: "Text", arg: []byte("webm"), want: false},
+		{name: "Nil", arg: nil, want: false},
+	}
+	runContentCases(t, "IsWebMBytes", IsWebMBytes, tests)
+}
+
+func TestIsOGGBytes(t *testing.T) {
+	page := append([]byte("OggS\x00\x02"), make([]byte, 21)...)
+	tests := []contentCase{
+		{name: "OGG", arg: page, want: true},
+		{name: "UnknownVersion", arg: append([]byte("OggS\x01"), page[5:]...), want: false},
+		{name: "Short", arg: []byte("OggS\x00"), want: false},
+		{name: "Text", arg: []byte("not an ogg file, just some text"), want: false},
+		{name: "Nil", arg: nil, want: false},
+	}
+	runContentCases(t, "IsOGGBytes", IsOGGBytes, tests)
+}
@@ -0,0 +1,113 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import "strconv"
+
+// CanConvertToString checks if a given value can be converted into a string by the same rules the checkers use
+// internally, explaining why when it cannot. Strings, numbers, bools, arrays, slices, maps, structs and non-nil
+// pointers or interfaces to them are convertible.
+//
+// Parameters:
+//   - a: Any value to be checked.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value can be converted into a string.
+//   - error: The reason why the value cannot be converted, wrapping ErrNilValue or ErrUnsupportedType, or nil.
+//
+// Example:
+//
+//	fmt.Println(CanConvertToString(123))            // true <nil>
+//	fmt.Println(CanConvertToString(nil))            // false error getting a string: value is nil
+//	fmt.Println(CanConvertToString(make(chan int))) // false error getting a string: unsupported type chan
+func CanConvertToString(a any) (bool, error) {
+	_, err := toStringWithErr(a)
+	return err == nil, err
+}
+
+// CanConvertToInt checks if a given value can be converted into an int by the same rules as IsInt, which
+// converts the value into a string and parses it with strconv.Atoi, explaining why when it cannot.
+//
+// Parameters:
+//   - a: Any value to be checked.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value can be converted into an int.
+//   - error: The reason why the value cannot be converted, such as a *strconv.NumError, or nil.
+//
+// Example:
+//
+//	fmt.Println(CanConvertToInt("123")) // true <nil>
+//	fmt.Println(CanConvertToInt("1.5")) // false strconv.Atoi: parsing "1.5": invalid syntax
+//	fmt.Println(CanConvertToInt(nil))   // false error getting a string: value is nil
+func CanConvertToInt(a any) (bool, error) {
+	s, err := toStringWithErr(a)
+	if err == nil {
+		_, err = strconv.Atoi(s)
+	}
+	return err == nil, err
+}
+
+// CanConvertToFloat checks if a given value can be converted into a float64 by the same rules the checkers use
+// internally, explaining why when it cannot. Numbers, strings parsed by strconv.ParseFloat and non-nil pointers
+// or interfaces to them are convertible.
+//
+// Parameters:
+//   - a: Any value to be checked.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value can be converted into a float64.
+//   - error: The reason why the value cannot be converted, wrapping ErrNilValue, ErrUnsupportedType or the
+//     parsing error, or nil.
+//
+// Example:
+//
+//	fmt.Println(CanConvertToFloat("1.5")) // true <nil>
+//	fmt.Println(CanConvertToFloat("abc")) // false error getting float: strconv.ParseFloat: parsing "abc": invalid syntax
+//	fmt.Println(CanConvertToFloat(true))  // false error getting float: unsupported type bool
+func CanConvertToFloat(a any) (bool, error) {
+	_, err := toFloatWithErr(a)
+	return err == nil, err
+}
+
+// CanConvertToTime checks if a given value can be converted into a time.Time by the same rules the time checkers
+// use internally, explaining why when it cannot. time.Time values, numbers taken as Unix milliseconds, strings in
+// one of the standard layouts of the time package and non-nil pointers or interfaces to them are convertible.
+//
+// Parameters:
+//   - a: Any value to be checked.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value can be converted into a time.Time.
+//   - error: The reason why the value cannot be converted, wrapping ErrNilValue or ErrUnsupportedType when it
+//     applies, or nil.
+//
+// Example:
+//
+//	fmt.Println(CanConvertToTime("2024-06-01"))  // true <nil>
+//	fmt.Println(CanConvertToTime(1717200000000)) // true <nil>
+//	fmt.Println(CanConvertToTime("tomorrow"))    // false cannot convert string to time.Time: Unknown format "tomorrow"
+func CanConvertToTime(a any) (bool, error) {
+	_, err := toTimeWithErr(a)
+	return err == nil, err
+}
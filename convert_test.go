@@ -0,0 +1,164 @@
+package checker
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type textID [4]byte
+
+func (id textID) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%x", id[:])), nil
+}
+
+type textAmount struct {
+	units, cents int64
+}
+
+func (a textAmount) String() string {
+	return fmt.Sprintf("%d.%02d", a.units, a.cents)
+}
+
+type textDate struct {
+	year, month, day int
+}
+
+func (d *textDate) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%04d-%02d-%02d", d.year, d.month, d.day)), nil
+}
+
+type textFailing struct{}
+
+func (textFailing) MarshalText() ([]byte, error) {
+	return nil, errors.New("cannot marshal")
+}
+
+func (textFailing) String() string {
+	return "fallback"
+}
+
+type convertCase struct {
+	name      string
+	arg       any
+	want      bool
+	wantNil   bool
+	wantUnsup bool
+}
+
+func runConvertCases(t *testing.T, fnName string, fn func(a any) (bool, error), testCases []convertCase) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fn(tc.arg)
+			if got != tc.want || (err == nil) != tc.want {
+				t.Fatalf("%s(%v) = %v, %v, want %v", fnName, tc.arg, got, err, tc.want)
+			}
+
+			var unsupported ErrUnsupportedType
+			if tc.wantNil && !errors.Is(err, ErrNilValue) {
+				t.Errorf("%s(%v) error = %v, want ErrNilValue", fnName, tc.arg, err)
+			} else if tc.wantUnsup && !errors.As(err, &unsupported) {
+				t.Errorf("%s(%v) error = %v, want ErrUnsupportedType", fnName, tc.arg, err)
+			}
+		})
+	}
+}
+
+func TestCanConvertToString(t *testing.T) {
+	var nilString *string
+	runConvertCases(t, "CanConvertToString", CanConvertToString, []convertCase{
+		{name: "String", arg: "test", want: true},
+		{name: "Int", arg: 123, want: true},
+		{name: "Slice", arg: []int{1, 2}, want: true},
+		{name: "Bytes", arg: []byte("test"), want: true},
+		{name: "ByteArray", arg: [4]byte{'t', 'e', 's', 't'}, want: true},
+		{name: "Struct", arg: struct{ A int }{1}, want: true},
+		{name: "Nil", arg: nil, wantNil: true},
+		{name: "NilPointer", arg: nilString, wantNil: true},
+		{name: "Chan", arg: make(chan int), wantUnsup: true},
+	})
+}
+
+func TestCanConvertToInt(t *testing.T) {
+	runConvertCases(t, "CanConvertToInt", CanConvertToInt, []convertCase{
+		{name: "String", arg: "123", want: true},
+		{name: "Negative", arg: "-42", want: true},
+		{name: "Int", arg: 7, want: true},
+		{name: "Decimal", arg: "1.5"},
+		{name: "Letters", arg: "abc"},
+		{name: "Nil", arg: nil, wantNil: true},
+		{name: "Func", arg: func() {}, wantUnsup: true},
+	})
+
+	_, err := CanConvertToInt("abc")
+	var numError *strconv.NumError
+	if !errors.As(err, &numError) {
+		t.Errorf("CanConvertToInt(abc) error = %v, want a *strconv.NumError", err)
+	}
+}
+
+func TestCanConvertToFloat(t *testing.T) {
+	value := 1.5
+	runConvertCases(t, "CanConvertToFloat", CanConvertToFloat, []convertCase{
+		{name: "String", arg: "1.5", want: true},
+		{name: "Int", arg: 10, want: true},
+		{name: "Pointer", arg: &value, want: true},
+		{name: "Stringer", arg: textAmount{units: 12, cents: 50}, want: true},
+		{name: "Letters", arg: "abc"},
+		{name: "Bool", arg: true, wantUnsup: true},
+		{name: "Nil", arg: nil, wantNil: true},
+	})
+}
+
+func TestCanConvertToTime(t *testing.T) {
+	var nilTime *time.Time
+	runConvertCases(t, "CanConvertToTime", CanConvertToTime, []convertCase{
+		{name: "Time", arg: time.Now(), want: true},
+		{name: "DateOnly", arg: "2024-06-01", want: true},
+		{name: "RFC3339", arg: "2024-06-01T10:00:00Z", want: true},
+		{name: "UnixMilli", arg: 1717200000000, want: true},
+		{name: "TextMarshaler", arg: &textDate{year: 2024, month: 6, day: 1}, want: true},
+		{name: "UnknownFormat", arg: "tomorrow"},
+		{name: "Bool", arg: true, wantUnsup: true},
+		{name: "Nil", arg: nil, wantNil: true},
+		{name: "NilPointer", arg: nilTime, wantNil: true},
+	})
+}
+
+func TestToStringTextualTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  any
+		want string
+	}{
+		{name: "TextMarshalerArray", arg: textID{0xde, 0xad, 0xbe, 0xef}, want: "deadbeef"},
+		{name: "StringerStruct", arg: textAmount{units: 12, cents: 5}, want: "12.05"},
+		{name: "StringerPointer", arg: &textAmount{units: 1}, want: "1.00"},
+		{name: "PointerReceiver", arg: &textDate{year: 2024, month: 6, day: 1}, want: "2024-06-01"},
+		{name: "ValueWithoutPointerReceiver", arg: textDate{}, want: "{}"},
+		{name: "MarshalTextFailure", arg: textFailing{}, want: "fallback"},
+		{name: "StringerSlice", arg: net.ParseIP("10.0.0.1"), want: "10.0.0.1"},
+		{name: "Time", arg: time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC), want: "2024-06-01T10:00:00Z"},
+		{name: "BasicKindKept", arg: time.Second, want: "1000000000"},
+		{name: "BasicKindPointerKept", arg: func() *time.Duration { d := time.Second; return &d }(), want: "1000000000"},
+		{name: "PlainBytes", arg: []byte("raw"), want: "raw"},
+		{name: "PlainByteArray", arg: [3]byte{'r', 'a', 'w'}, want: "raw"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := toString(tc.arg); got != tc.want {
+				t.Errorf("toString(%v) = %q, want %q", tc.arg, got, tc.want)
+			}
+		})
+	}
+
+	if !IsValidIP(net.ParseIP("192.168.0.1")) {
+		t.Errorf("IsValidIP(net.IP) = false, want true")
+	}
+	if !Equals(toFloat(textAmount{units: 12, cents: 50}), 12.5) {
+		t.Errorf("toFloat(textAmount) = %v, want 12.5", toFloat(textAmount{units: 12, cents: 50}))
+	}
+}
@@ -0,0 +1,230 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// nationalIDRules maps the ISO 3166-1 alpha-2 code of each country supported by IsNationalID to the checker of
+// the personal identification number of the country. "UK" is accepted as an alias of "GB".
+var nationalIDRules = map[string]Rule{
+	"BR": isCPF,
+	"CL": isChileRUT,
+	"ES": isSpainDNI,
+	"GB": isUKNINO,
+	"PT": isPortugalNIF,
+	"UK": isUKNINO,
+	"US": isUSSSN,
+}
+
+// postalCodePatterns maps the ISO 3166-1 alpha-2 code of each country supported by IsPostalCode to the pattern of
+// its postal codes. "UK" is accepted as an alias of "GB".
+var postalCodePatterns = map[string]string{
+	"CA": `^[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d$`,
+	"DE": `^(0[1-9]|[1-9]\d)\d{3}$`,
+	"ES": `^(0[1-9]|[1-4]\d|5[0-2])\d{3}$`,
+	"FR": `^\d{5}$`,
+	"GB": ukPostcodePattern,
+	"PT": `^[1-9]\d{3}-\d{3}$`,
+	"UK": ukPostcodePattern,
+	"US": `^\d{5}(-\d{4})?$`,
+}
+
+// ukPostcodePattern is the pattern of the postcodes of the United Kingdom, such as "SW1A 1AA" or "M1 1AE".
+const ukPostcodePattern = `^(GIR ?0AA|[A-PR-UWYZ](\d{1,2}|[A-HK-Y]\d[\dABEHMNPRV-Y]?|\d[A-HJKPSTUW]) ?` +
+	`\d[ABD-HJLNP-UW-Z]{2})$`
+
+// IsNationalID checks if a given value is a valid personal identification number of the given country, so that
+// multi-country code paths can validate documents through a single entry point keyed by the country code. The
+// supported countries and documents are:
+//
+//   - BR: CPF, as checked by IsCPF.
+//   - CL: RUT, such as "12.345.678-5", with its modulo 11 verifier.
+//   - ES: DNI or NIE, such as "12345678Z" or "X1234567L", with its control letter.
+//   - GB (or UK): National Insurance number, such as "AB 12 34 56 C".
+//   - PT: NIF, such as "123456789", with its modulo 11 check digit.
+//   - US: Social Security number, such as "123-45-6789", rejecting the never-assigned areas and groups.
+//
+// Parameters:
+//   - country: The ISO 3166-1 alpha-2 code of the country, such as "BR", in any case.
+//   - a: Any value to be converted into a string and checked as a national ID of the country.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a national ID of the country.
+//
+// Panic:
+//   - The function will panic if the country is not supported, or if the value cannot be converted to a string
+//     through the toString function.
+//
+// Example:
+//
+//	fmt.Println(IsNationalID("BR", "121.017.210-07")) // true
+//	fmt.Println(IsNationalID("PT", "123456789"))      // true
+//	fmt.Println(IsNationalID("es", "12345678Z"))      // true
+//	fmt.Println(IsNationalID("US", "000-12-3456"))    // false
+func IsNationalID(country string, a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNationalID", time.Now(), &passed)
+	}
+	rule, ok := nationalIDRules[strings.ToUpper(country)]
+	if !ok {
+		panic(fmt.Sprintf("Unsupported country: %s", country))
+	}
+	return rule(a)
+}
+
+// IsPostalCode checks if a given value is a postal code in the format of the given country. The supported
+// countries are BR ("01310-100", as checked by IsCEP), CA ("K1A 0B1"), DE ("10115"), ES ("28013"), FR ("75008"),
+// GB or UK ("SW1A 1AA"), PT ("1000-001") and US ("94105" or "94105-1804"). Letters must be uppercase.
+//
+// Parameters:
+//   - country: The ISO 3166-1 alpha-2 code of the country, such as "BR", in any case.
+//   - a: Any value to be converted into a string and checked as a postal code of the country.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a postal code of the country.
+//
+// Panic:
+//   - The function will panic if the country is not supported, or if the value cannot be converted to a string
+//     through the toString function.
+//
+// Example:
+//
+//	fmt.Println(IsPostalCode("BR", "01310-100")) // true
+//	fmt.Println(IsPostalCode("UK", "SW1A 1AA"))  // true
+//	fmt.Println(IsPostalCode("DE", "10115"))     // true
+//	fmt.Println(IsPostalCode("PT", "1000001"))   // false
+func IsPostalCode(country string, a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsPostalCode", time.Now(), &passed)
+	}
+	code := strings.ToUpper(country)
+	if code == "BR" {
+		return isCEP(a)
+	}
+	pattern, ok := postalCodePatterns[code]
+	if !ok {
+		panic(fmt.Sprintf("Unsupported country: %s", country))
+	}
+	regex := regexp.MustCompile(pattern)
+	return regex.MatchString(toString(a))
+}
+
+// isChileRUT checks if the value is a Chilean RUT, with or without the thousands dots and the dash before the
+// verifier, whose last character is the modulo 11 verifier of the digits, "K" standing for 10.
+func isChileRUT(a any) bool {
+	s := strings.ToUpper(strings.NewReplacer(".", "", "-", "").Replace(toString(a)))
+	regex := regexp.MustCompile(`^\d{7,8}[\dK]$`)
+	if !regex.MatchString(s) {
+		return false
+	}
+
+	sum, weight := 0, 2
+	for i := len(s) - 2; i >= 0; i-- {
+		sum += int(s[i]-'0') * weight
+		weight++
+		if weight > 7 {
+			weight = 2
+		}
+	}
+	verifier := "0123456789K0"[11-sum%11]
+	return s[len(s)-1] == verifier
+}
+
+// isSpainDNI checks if the value is a Spanish DNI (8 digits) or NIE (X, Y or Z followed by 7 digits) whose control
+// letter matches the number.
+func isSpainDNI(a any) bool {
+	s := strings.ToUpper(strings.ReplaceAll(toString(a), "-", ""))
+	regex := regexp.MustCompile(`^([XYZ]\d{7}|\d{8})[A-Z]$`)
+	if !regex.MatchString(s) {
+		return false
+	}
+
+	digits := strings.NewReplacer("X", "0", "Y", "1", "Z", "2").Replace(s[:8])
+	number := 0
+	for _, digit := range digits {
+		number = number*10 + int(digit-'0')
+	}
+	return s[8] == "TRWAGMYFPDXBNJZSQVHLCKE"[number%23]
+}
+
+// isUKNINO checks if the value is a National Insurance number of the United Kingdom, spaces being ignored, whose
+// prefix is not one of the prefixes never allocated.
+func isUKNINO(a any) bool {
+	s := strings.ToUpper(strings.ReplaceAll(toString(a), " ", ""))
+	regex := regexp.MustCompile(`^[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z]\d{6}[A-D]$`)
+	if !regex.MatchString(s) {
+		return false
+	}
+
+	switch s[:2] {
+	case "BG", "GB", "KN", "NK", "NT", "TN", "ZZ":
+		return false
+	default:
+		return true
+	}
+}
+
+// isPortugalNIF checks if the value is a Portuguese NIF, 9 digits starting with one of the allocated prefixes and
+// ending with the modulo 11 check digit.
+func isPortugalNIF(a any) bool {
+	s := removeNonDigits(toString(a))
+	if len(s) != 9 {
+		return false
+	}
+	if !strings.ContainsRune("1235689", rune(s[0])) {
+		switch s[:2] {
+		case "45", "70", "71", "72", "74", "75", "77", "79":
+		default:
+			return false
+		}
+	}
+
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(s[i]-'0') * (9 - i)
+	}
+	check := 11 - sum%11
+	if check >= 10 {
+		check = 0
+	}
+	return int(s[8]-'0') == check
+}
+
+// isUSSSN checks if the value is a Social Security number of the United States, with or without dashes, rejecting
+// the area numbers 000, 666 and 900-999, the group 00 and the serial 0000, which are never assigned.
+func isUSSSN(a any) bool {
+	s := toString(a)
+	regex := regexp.MustCompile(`^(\d{3}-\d{2}-\d{4}|\d{9})$`)
+	if !regex.MatchString(s) {
+		return false
+	}
+
+	s = removeNonDigits(s)
+	area, group, serial := s[:3], s[3:5], s[5:]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
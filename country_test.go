@@ -0,0 +1,98 @@
+package checker
+
+import "testing"
+
+type countryCase struct {
+	name    string
+	country string
+	arg     any
+	want    bool
+	panic   bool
+}
+
+func runCountryCases(t *testing.T, name string, fn func(string, any) bool, tests []countryCase) {
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := fn(tc.country, tc.arg); got != tc.want {
+				t.Errorf("%s(%q, %v) = %v, want %v", name, tc.country, tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsNationalID(t *testing.T) {
+	runCountryCases(t, "IsNationalID", IsNationalID, []countryCase{
+		{name: "BrazilCPF", country: "BR", arg: "12101721007", want: true},
+		{name: "BrazilMaskedCPF", country: "BR", arg: "121.017.210-07", want: true},
+		{name: "BrazilInvalidCPF", country: "BR", arg: "12345678900", want: false},
+		{name: "LowercaseCountry", country: "br", arg: "12101721007", want: true},
+		{name: "ChileRUT", country: "CL", arg: "12.345.678-5", want: true},
+		{name: "ChileBareRUT", country: "CL", arg: "123456785", want: true},
+		{name: "ChileRUTWithK", country: "CL", arg: "10.000.013-k", want: true},
+		{name: "ChileInvalidVerifier", country: "CL", arg: "12.345.678-9", want: false},
+		{name: "ChileTooShort", country: "CL", arg: "12345-6", want: false},
+		{name: "SpainDNI", country: "ES", arg: "12345678Z", want: true},
+		{name: "SpainLowercaseDNI", country: "ES", arg: "12345678z", want: true},
+		{name: "SpainNIE", country: "ES", arg: "X1234567L", want: true},
+		{name: "SpainInvalidLetter", country: "ES", arg: "12345678A", want: false},
+		{name: "SpainMissingLetter", country: "ES", arg: "12345678", want: false},
+		{name: "UKNINO", country: "UK", arg: "AB123456C", want: true},
+		{name: "GBSpacedNINO", country: "GB", arg: "AB 12 34 56 C", want: true},
+		{name: "UKInvalidPrefixLetter", country: "UK", arg: "DA123456C", want: false},
+		{name: "UKUnallocatedPrefix", country: "UK", arg: "GB123456A", want: false},
+		{name: "UKInvalidSuffix", country: "UK", arg: "AB123456E", want: false},
+		{name: "PortugalNIF", country: "PT", arg: "123456789", want: true},
+		{name: "PortugalCompanyNIF", country: "PT", arg: "500000000", want: true},
+		{name: "PortugalNonResidentNIF", country: "PT", arg: "450000001", want: true},
+		{name: "PortugalInvalidCheckDigit", country: "PT", arg: "123456780", want: false},
+		{name: "PortugalInvalidPrefix", country: "PT", arg: "400000000", want: false},
+		{name: "PortugalTooShort", country: "PT", arg: "12345678", want: false},
+		{name: "USSSN", country: "US", arg: "123-45-6789", want: true},
+		{name: "USBareSSN", country: "US", arg: "123456789", want: true},
+		{name: "USMixedSeparators", country: "US", arg: "123-456789", want: false},
+		{name: "USZeroArea", country: "US", arg: "000-45-6789", want: false},
+		{name: "USArea666", country: "US", arg: "666-45-6789", want: false},
+		{name: "USArea900", country: "US", arg: "900-45-6789", want: false},
+		{name: "USZeroGroup", country: "US", arg: "123-00-6789", want: false},
+		{name: "USZeroSerial", country: "US", arg: "123-45-0000", want: false},
+		{name: "UnsupportedCountry", country: "XX", arg: "123", panic: true},
+		{name: "Nil", country: "PT", arg: nil, panic: true},
+	})
+}
+
+func TestIsPostalCode(t *testing.T) {
+	runCountryCases(t, "IsPostalCode", IsPostalCode, []countryCase{
+		{name: "BrazilCEP", country: "BR", arg: "01310-100", want: true},
+		{name: "BrazilBareCEP", country: "br", arg: "01310100", want: true},
+		{name: "BrazilZeroCEP", country: "BR", arg: "00000-000", want: false},
+		{name: "CanadaPostalCode", country: "CA", arg: "K1A 0B1", want: true},
+		{name: "CanadaInvalidLetter", country: "CA", arg: "D1A 0B1", want: false},
+		{name: "GermanyPostalCode", country: "DE", arg: "10115", want: true},
+		{name: "GermanyZeroRegion", country: "DE", arg: "00115", want: false},
+		{name: "GermanyTooShort", country: "DE", arg: "1011", want: false},
+		{name: "SpainPostalCode", country: "ES", arg: "28013", want: true},
+		{name: "SpainInvalidProvince", country: "ES", arg: "53013", want: false},
+		{name: "FrancePostalCode", country: "FR", arg: "75008", want: true},
+		{name: "UKPostcode", country: "UK", arg: "SW1A 1AA", want: true},
+		{name: "GBShortPostcode", country: "GB", arg: "M1 1AE", want: true},
+		{name: "UKUnspacedPostcode", country: "UK", arg: "EC1A1BB", want: true},
+		{name: "UKGirobankPostcode", country: "UK", arg: "GIR 0AA", want: true},
+		{name: "UKLowercasePostcode", country: "UK", arg: "sw1a 1aa", want: false},
+		{name: "UKInvalidPostcode", country: "UK", arg: "Q1A 1AA", want: false},
+		{name: "PortugalPostalCode", country: "PT", arg: "1000-001", want: true},
+		{name: "PortugalMissingDash", country: "PT", arg: "1000001", want: false},
+		{name: "USZIP", country: "US", arg: "94105", want: true},
+		{name: "USZIPPlusFour", country: "US", arg: "94105-1804", want: true},
+		{name: "USNumericZIP", country: "US", arg: 94105, want: true},
+		{name: "USInvalidZIP", country: "US", arg: "9410", want: false},
+		{name: "UnsupportedCountry", country: "XX", arg: "12345", panic: true},
+		{name: "Nil", country: "US", arg: nil, panic: true},
+	})
+}
@@ -0,0 +1,156 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Descriptor describes a Rule as plain data, by the name it is registered under and its parameters, so that
+// checks can be marshaled with encoding/json or encoding/gob, shipped between services and turned back into a
+// Rule with Descriptor.Rule, which function values cannot. A Descriptor is written as "name" or "name=param" in
+// policies, as parsed by ParseDescriptor.
+type Descriptor struct {
+	// Name is the name of the Rule, either one known by RuleByName, such as "is_email", or a parameterized Rule,
+	// such as "max".
+	Name string `json:"name"`
+	// Params holds the parameters of a parameterized Rule, such as "10000" for "max", and is empty otherwise.
+	Params []string `json:"params,omitempty"`
+}
+
+// paramRules builds the Rules that take a parameter, such as "max=10000", from the text of the parameter. The
+// builders return an error when the parameter is not valid for the Rule.
+var paramRules = map[string]func(param string) (Rule, error){
+	"min": func(param string) (Rule, error) {
+		limit, err := strconv.ParseFloat(param, 64)
+		return func(a any) bool { return isGreaterThanOrEqual(a, limit) }, err
+	},
+	"max": func(param string) (Rule, error) {
+		limit, err := strconv.ParseFloat(param, 64)
+		return func(a any) bool { return isLessThanOrEqual(a, limit) }, err
+	},
+	"min_length": func(param string) (Rule, error) {
+		limit, err := parseLengthParam(param)
+		return func(a any) bool { return isLengthGreaterThanOrEqual(a, limit) }, err
+	},
+	"max_length": func(param string) (Rule, error) {
+		limit, err := parseLengthParam(param)
+		return func(a any) bool { return isLengthLessThanOrEqual(a, limit) }, err
+	},
+	"length": func(param string) (Rule, error) {
+		limit, err := parseLengthParam(param)
+		return func(a any) bool { return isLengthEquals(a, limit) }, err
+	},
+}
+
+// ParseDescriptor parses a Descriptor written as in policies, either a Rule name such as "is_email" or a
+// parameterized Rule such as "max=10000". Several parameters are separated by commas. The Rule itself is not
+// resolved, so the Descriptor may reference a Rule registered later.
+//
+// Parameters:
+//   - s: The text of the Descriptor.
+//
+// Returns:
+//   - Descriptor: The parsed Descriptor.
+//   - error: An error if the name or a parameter is empty.
+//
+// Example:
+//
+//	d, _ := ParseDescriptor("max=10000")
+//	fmt.Println(d.Name, d.Params) // max [10000]
+//	_, err := ParseDescriptor("=10")
+//	fmt.Println(err) // empty rule name in "=10"
+func ParseDescriptor(s string) (Descriptor, error) {
+	name, params, hasParams := strings.Cut(s, "=")
+	d := Descriptor{Name: strings.TrimSpace(name)}
+	if d.Name == "" {
+		return Descriptor{}, fmt.Errorf("empty rule name in %q", s)
+	}
+	if hasParams {
+		for _, param := range strings.Split(params, ",") {
+			if param = strings.TrimSpace(param); param == "" {
+				return Descriptor{}, fmt.Errorf("empty parameter in %q", s)
+			}
+			d.Params = append(d.Params, param)
+		}
+	}
+	return d, nil
+}
+
+// String returns the Descriptor written as ParseDescriptor parses it, such as "max=10000".
+func (d Descriptor) String() string {
+	if len(d.Params) == 0 {
+		return d.Name
+	}
+	return d.Name + "=" + strings.Join(d.Params, ",")
+}
+
+// Rule resolves the Descriptor into the Rule it describes. A Descriptor without parameters is looked up with
+// RuleByName, so Rules registered with RegisterRule are available, and a Descriptor with parameters is one of
+// the parameterized Rules "min", "max" (numeric bounds), "min_length", "max_length" and "length", which take a
+// single parameter.
+//
+// Returns:
+//   - Rule: The Rule described, or nil if it could not be resolved.
+//   - error: An error if the Rule is unknown or a parameter is not valid for it.
+//
+// Example:
+//
+//	var d Descriptor
+//	_ = json.Unmarshal([]byte(`{"name": "max", "params": ["10000"]}`), &d)
+//	rule, err := d.Rule()
+//	fmt.Println(err, rule(20000)) // <nil> false
+//	_, err = Descriptor{Name: "is_unknown"}.Rule()
+//	fmt.Println(err) // unknown rule "is_unknown"
+func (d Descriptor) Rule() (Rule, error) {
+	if len(d.Params) == 0 {
+		rule, ok := RuleByName(d.Name)
+		if !ok {
+			return nil, fmt.Errorf("unknown rule %q", d.Name)
+		}
+		return rule, nil
+	}
+
+	build, ok := paramRules[d.Name]
+	if !ok {
+		return nil, fmt.Errorf("unknown parameterized rule %q", d.Name)
+	} else if len(d.Params) != 1 {
+		return nil, fmt.Errorf("rule %q takes 1 parameter, got %d", d.Name, len(d.Params))
+	}
+	rule, err := build(d.Params[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid parameter of rule %q: %w", d.String(), err)
+	}
+	return rule, nil
+}
+
+// parseLengthParam parses the parameter of a length Rule, which must be a non-negative integer.
+func parseLengthParam(param string) (int, error) {
+	n, err := strconv.Atoi(param)
+	if err == nil && n < 0 {
+		err = fmt.Errorf("negative length %d", n)
+	}
+	return n, err
+}
@@ -0,0 +1,137 @@
+package checker
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseDescriptor(t *testing.T) {
+	testCases := []struct {
+		name    string
+		text    string
+		want    Descriptor
+		wantErr bool
+	}{
+		{name: "Name", text: "is_email", want: Descriptor{Name: "is_email"}},
+		{name: "Param", text: "max=10000", want: Descriptor{Name: "max", Params: []string{"10000"}}},
+		{name: "Spaces", text: " max = 10000 ", want: Descriptor{Name: "max", Params: []string{"10000"}}},
+		{name: "Params", text: "between=1,10", want: Descriptor{Name: "between", Params: []string{"1", "10"}}},
+		{name: "Empty", text: "", wantErr: true},
+		{name: "EmptyName", text: "=10", wantErr: true},
+		{name: "EmptyParam", text: "max=", wantErr: true},
+		{name: "EmptySecondParam", text: "between=1,", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseDescriptor(tc.text)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ParseDescriptor(%q) error = %v, wantErr %v", tc.text, err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ParseDescriptor(%q) = %+v, want %+v", tc.text, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDescriptorString(t *testing.T) {
+	testCases := []struct {
+		name       string
+		descriptor Descriptor
+		want       string
+	}{
+		{name: "Name", descriptor: Descriptor{Name: "is_email"}, want: "is_email"},
+		{name: "Param", descriptor: Descriptor{Name: "max", Params: []string{"10000"}}, want: "max=10000"},
+		{name: "Params", descriptor: Descriptor{Name: "between", Params: []string{"1", "10"}}, want: "between=1,10"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.descriptor.String(); got != tc.want {
+				t.Errorf("Descriptor.String() = %q, want %q", got, tc.want)
+			}
+			if parsed, err := ParseDescriptor(tc.want); err != nil || !reflect.DeepEqual(parsed, tc.descriptor) {
+				t.Errorf("ParseDescriptor(%q) = %+v, %v, want %+v", tc.want, parsed, err, tc.descriptor)
+			}
+		})
+	}
+}
+
+func TestDescriptorRule(t *testing.T) {
+	RegisterRule("is_descriptor_test_code", func(a any) bool { return toString(a) == "ok" })
+
+	testCases := []struct {
+		name       string
+		descriptor Descriptor
+		arg        any
+		want       bool
+		wantErr    bool
+	}{
+		{name: "Builtin", descriptor: Descriptor{Name: "is_email"}, arg: "test@example.com", want: true},
+		{name: "BuiltinFails", descriptor: Descriptor{Name: "is_email"}, arg: "test", want: false},
+		{name: "Registered", descriptor: Descriptor{Name: "is_descriptor_test_code"}, arg: "ok", want: true},
+		{name: "Min", descriptor: Descriptor{Name: "min", Params: []string{"0"}}, arg: -1, want: false},
+		{name: "Max", descriptor: Descriptor{Name: "max", Params: []string{"10000"}}, arg: 20000, want: false},
+		{name: "MaxWithin", descriptor: Descriptor{Name: "max", Params: []string{"10000"}}, arg: 100, want: true},
+		{name: "MinLength", descriptor: Descriptor{Name: "min_length", Params: []string{"3"}}, arg: "ab", want: false},
+		{name: "MaxLength", descriptor: Descriptor{Name: "max_length", Params: []string{"3"}}, arg: "ab", want: true},
+		{name: "Length", descriptor: Descriptor{Name: "length", Params: []string{"2"}}, arg: "ab", want: true},
+		{name: "UnknownRule", descriptor: Descriptor{Name: "is_unknown"}, wantErr: true},
+		{name: "UnknownParameterized", descriptor: Descriptor{Name: "is_email", Params: []string{"1"}}, wantErr: true},
+		{name: "TooManyParams", descriptor: Descriptor{Name: "max", Params: []string{"1", "2"}}, wantErr: true},
+		{name: "InvalidNumber", descriptor: Descriptor{Name: "max", Params: []string{"ten"}}, wantErr: true},
+		{name: "NegativeLength", descriptor: Descriptor{Name: "length", Params: []string{"-1"}}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := tc.descriptor.Rule()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Descriptor.Rule() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				if rule != nil {
+					t.Errorf("Descriptor.Rule() returned a Rule with an error")
+				}
+				return
+			}
+			if got := rule(tc.arg); got != tc.want {
+				t.Errorf("Descriptor.Rule()(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDescriptorMarshaling(t *testing.T) {
+	descriptors := []Descriptor{{Name: "is_email"}, {Name: "max", Params: []string{"10000"}}}
+
+	b, err := json.Marshal(descriptors)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `[{"name":"is_email"},{"name":"max","params":["10000"]}]`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+	var fromJSON []Descriptor
+	if err := json.Unmarshal(b, &fromJSON); err != nil || !reflect.DeepEqual(fromJSON, descriptors) {
+		t.Errorf("json.Unmarshal() = %+v, %v, want %+v", fromJSON, err, descriptors)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(descriptors); err != nil {
+		t.Fatalf("gob.Encode() error = %v", err)
+	}
+	var fromGob []Descriptor
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil || !reflect.DeepEqual(fromGob, descriptors) {
+		t.Errorf("gob.Decode() = %+v, %v, want %+v", fromGob, err, descriptors)
+	}
+
+	rule, err := fromGob[1].Rule()
+	if err != nil || rule(20000) {
+		t.Errorf("Descriptor.Rule() after gob round trip = %v, want a failing max Rule", err)
+	}
+}
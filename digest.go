@@ -0,0 +1,166 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MatchesSHA256 checks if the SHA-256 digest of the given data matches the declared digest, such as the checksum
+// sent along an upload. The data may be a []byte or any value accepted by toString, and the digest may be
+// hex-encoded, in any case, or base64-encoded, with the standard or URL-safe alphabet and with or without
+// padding. The comparison runs in constant time.
+//
+// Parameters:
+//   - data: Any value to be converted into bytes and hashed.
+//   - digest: Any value to be converted into a string and decoded as the expected digest.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the digest of the data matches the declared one.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(MatchesSHA256("hello", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"))  // true
+//	fmt.Println(MatchesSHA256([]byte("hello"), "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ="))              // true
+//	fmt.Println(MatchesSHA256("hello!", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824")) // false
+func MatchesSHA256(data any, digest any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("MatchesSHA256", time.Now(), &passed)
+	}
+	sum := sha256.Sum256(toBytes(data))
+	expected, ok := decodeDigest(toString(digest), sha256.Size)
+	return ok && subtle.ConstantTimeCompare(sum[:], expected) == 1
+}
+
+// MatchesMD5 checks if the MD5 digest of the given data matches the declared digest, such as a Content-MD5 header
+// or an S3 ETag of a single part upload. The data and the digest are handled as MatchesSHA256 does. MD5 is not
+// collision resistant, so it only guards against accidental corruption.
+//
+// Parameters:
+//   - data: Any value to be converted into bytes and hashed.
+//   - digest: Any value to be converted into a string and decoded as the expected digest.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the digest of the data matches the declared one.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(MatchesMD5("hello", "5d41402abc4b2a76b9719d911017c592")) // true
+//	fmt.Println(MatchesMD5("hello", "XUFAKrxLKna5cZ2REBfFkg=="))         // true
+//	fmt.Println(MatchesMD5("hello", "5d41402abc4b2a76b9719d911017c593")) // false
+func MatchesMD5(data any, digest any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("MatchesMD5", time.Now(), &passed)
+	}
+	sum := md5.Sum(toBytes(data))
+	expected, ok := decodeDigest(toString(digest), md5.Size)
+	return ok && subtle.ConstantTimeCompare(sum[:], expected) == 1
+}
+
+// MatchesETag checks if the current entity tag of a resource matches a conditional request header, such as
+// If-Match or If-None-Match, following RFC 9110: the header may be "*", which matches any entity tag, or a
+// comma-separated list of entity tags like `"abc", W/"def"`. With the strong comparison, used by If-Match, both
+// tags must be strong and equal; with the weak comparison, used by If-None-Match, the W/ prefixes are ignored.
+// Malformed entity tags never match.
+//
+// Parameters:
+//   - header: Any value to be converted into a string and parsed as the conditional header.
+//   - etag: Any value to be converted into a string and parsed as the current entity tag.
+//   - weak: Whether to use the weak comparison instead of the strong one.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the entity tag matches the header.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(MatchesETag(`"v1", "v2"`, `"v2"`, false)) // true
+//	fmt.Println(MatchesETag(`W/"v1"`, `"v1"`, true))      // true
+//	fmt.Println(MatchesETag(`W/"v1"`, `"v1"`, false))     // false
+//	fmt.Println(MatchesETag("*", `"v1"`, false))          // true
+func MatchesETag(header, etag any, weak bool) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("MatchesETag", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^(W/)?"[\x21\x23-\x7e\x80-\xff]*"$`)
+	current := strings.TrimSpace(toString(etag))
+	if !regex.MatchString(current) {
+		return false
+	}
+
+	s := strings.TrimSpace(toString(header))
+	if s == "*" {
+		return true
+	}
+	for _, candidate := range strings.Split(s, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if !regex.MatchString(candidate) {
+			return false
+		} else if weak && strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(current, "W/") {
+			return true
+		} else if !weak && !strings.HasPrefix(candidate, "W/") && candidate == current {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeDigest decodes a hex or base64 digest, in any of the standard and URL-safe alphabets with or without
+// padding, returning it only when it has the expected size.
+func decodeDigest(digest string, size int) ([]byte, bool) {
+	digest = strings.TrimSpace(digest)
+	if len(digest) == hex.EncodedLen(size) {
+		if decoded, err := hex.DecodeString(digest); err == nil {
+			return decoded, true
+		}
+	}
+
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding,
+		base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(digest); err == nil && len(decoded) == size {
+			return decoded, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,98 @@
+package checker
+
+import "testing"
+
+type digestCase struct {
+	name   string
+	data   any
+	digest any
+	want   bool
+	panic  bool
+}
+
+func runDigestCases(t *testing.T, name string, fn func(data, digest any) bool, testCases []digestCase) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := fn(tc.data, tc.digest); got != tc.want {
+				t.Errorf("%s(%v, %v) = %v, want %v", name, tc.data, tc.digest, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMatchesSHA256(t *testing.T) {
+	hexDigest := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	runDigestCases(t, "MatchesSHA256", MatchesSHA256, []digestCase{
+		{name: "Hex", data: "hello", digest: hexDigest, want: true},
+		{name: "UpperHex", data: "hello", digest: "2CF24DBA5FB0A30E26E83B2AC5B9E29E1B161E5C1FA7425E73043362938B9824",
+			want: true},
+		{name: "Bytes", data: []byte("hello"), digest: hexDigest, want: true},
+		{name: "Base64", data: "hello", digest: "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=", want: true},
+		{name: "RawBase64URL", data: "hello", digest: "LPJNul-wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ", want: true},
+		{name: "PointerDigest", data: "hello", digest: &hexDigest, want: true},
+		{name: "DifferentData", data: "hello!", digest: hexDigest, want: false},
+		{name: "MD5Digest", data: "hello", digest: "5d41402abc4b2a76b9719d911017c592", want: false},
+		{name: "InvalidDigest", data: "hello", digest: "not a digest", want: false},
+		{name: "NilData", data: nil, digest: hexDigest, panic: true},
+		{name: "NilDigest", data: "hello", digest: nil, panic: true},
+	})
+}
+
+func TestMatchesMD5(t *testing.T) {
+	runDigestCases(t, "MatchesMD5", MatchesMD5, []digestCase{
+		{name: "Hex", data: "hello", digest: "5d41402abc4b2a76b9719d911017c592", want: true},
+		{name: "Base64", data: []byte("hello"), digest: "XUFAKrxLKna5cZ2REBfFkg==", want: true},
+		{name: "RawBase64", data: "hello", digest: "XUFAKrxLKna5cZ2REBfFkg", want: true},
+		{name: "WrongDigest", data: "hello", digest: "5d41402abc4b2a76b9719d911017c593", want: false},
+		{name: "SHA256Digest", data: "hello", digest: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+			want: false},
+		{name: "Empty", data: "", digest: "d41d8cd98f00b204e9800998ecf8427e", want: true},
+		{name: "Nil", data: nil, digest: "d41d8cd98f00b204e9800998ecf8427e", panic: true},
+	})
+}
+
+func TestMatchesETag(t *testing.T) {
+	testCases := []struct {
+		name   string
+		header any
+		etag   any
+		weak   bool
+		want   bool
+		panic  bool
+	}{
+		{name: "StrongList", header: `"v1", "v2"`, etag: `"v2"`, want: true},
+		{name: "StrongMismatch", header: `"v1"`, etag: `"v2"`, want: false},
+		{name: "StrongRejectsWeakHeader", header: `W/"v1"`, etag: `"v1"`, want: false},
+		{name: "StrongRejectsWeakETag", header: `W/"v1"`, etag: `W/"v1"`, want: false},
+		{name: "WeakHeader", header: `W/"v1"`, etag: `"v1"`, weak: true, want: true},
+		{name: "WeakETag", header: `"v1"`, etag: `W/"v1"`, weak: true, want: true},
+		{name: "WeakMismatch", header: `W/"v1"`, etag: `W/"v2"`, weak: true, want: false},
+		{name: "Wildcard", header: "*", etag: `"v1"`, want: true},
+		{name: "UnquotedETag", header: `"v1"`, etag: "v1", want: false},
+		{name: "MalformedHeader", header: `v1, "v2"`, etag: `"v2"`, want: false},
+		{name: "Empty", header: "", etag: `"v1"`, want: false},
+		{name: "Nil", header: nil, etag: `"v1"`, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := MatchesETag(tc.header, tc.etag, tc.weak); got != tc.want {
+				t.Errorf("MatchesETag(%v, %v, %v) = %v, want %v", tc.header, tc.etag, tc.weak, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	imageTagPattern    = `[\w][\w.-]{0,127}`
+	imageDigestPattern = `[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,}`
+	imageDomainPattern = `(?:(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])` +
+		`(?:\.(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]))*|\[[0-9a-fA-F:]+\])(?::[0-9]+)?`
+	imagePathPattern = `[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*`
+)
+
+// IsDockerImageReference checks if a given value is a container image reference following the grammar of the
+// distribution/reference package, in the form "[registry[:port]/]repository[:tag][@digest]", such as "nginx",
+// "library/nginx:1.25", "ghcr.io/org/app:v1.2.0" or "registry.local:5000/app@sha256:<hex>". The repository name
+// must have at most 255 characters, the tag is checked with IsImageTag and the digest with IsImageDigest.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid image reference.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsDockerImageReference("nginx"))                  // true
+//	fmt.Println(IsDockerImageReference("ghcr.io/org/app:v1.2.0")) // true
+//	fmt.Println(IsDockerImageReference("localhost:5000/app"))     // true
+//	fmt.Println(IsDockerImageReference("Nginx:latest"))           // false
+//	fmt.Println(IsDockerImageReference("nginx:"))                 // false
+func IsDockerImageReference(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsDockerImageReference", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^((?:` + imageDomainPattern + `/)?` + imagePathPattern + `(?:/` + imagePathPattern +
+		`)*)(?::(` + imageTagPattern + `))?(?:@(` + imageDigestPattern + `))?$`)
+
+	matches := regex.FindStringSubmatch(s)
+	if matches == nil || len(matches[1]) > 255 {
+		return false
+	}
+	return matches[3] == "" || isImageDigest(matches[3])
+}
+
+// IsImageTag checks if a given value is a valid container image tag. A tag must have at most 128 characters, made
+// of word characters, '.' and '-', and cannot start with '.' or '-'.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid image tag.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsImageTag("latest"))      // true
+//	fmt.Println(IsImageTag("1.25-alpine")) // true
+//	fmt.Println(IsImageTag(".hidden"))     // false
+//	fmt.Println(IsImageTag("v1+build"))    // false
+func IsImageTag(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsImageTag", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^` + imageTagPattern + `$`)
+	return regex.MatchString(toString(a))
+}
+
+// IsImageDigest checks if a given value is a content digest as used in image references, in the form
+// "algorithm:hex", such as "sha256:<64 hex characters>". The well-known algorithms sha256 and sha512 must carry
+// exactly 64 and 128 lowercase hexadecimal characters respectively, while other algorithms only need at least 32
+// hexadecimal characters.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid digest.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsImageDigest("sha256:" + strings.Repeat("a", 64))) // true
+//	fmt.Println(IsImageDigest("sha256:" + strings.Repeat("a", 63))) // false
+//	fmt.Println(IsImageDigest("latest"))                            // false
+func IsImageDigest(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsImageDigest", time.Now(), &passed)
+	}
+	return isImageDigest(a)
+}
+
+// isImageDigest is the unobserved implementation of IsImageDigest.
+func isImageDigest(a any) bool {
+	s := toString(a)
+	regex := regexp.MustCompile(`^` + imageDigestPattern + `$`)
+	if !regex.MatchString(s) {
+		return false
+	}
+
+	algorithm, encoded, _ := strings.Cut(s, ":")
+	switch algorithm {
+	case "sha256":
+		return regexp.MustCompile(`^[a-f0-9]{64}$`).MatchString(encoded)
+	case "sha512":
+		return regexp.MustCompile(`^[a-f0-9]{128}$`).MatchString(encoded)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,106 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsDockerImageReference(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("ab", 32)
+	testCases := []baseCase{
+		{name: "Name", arg: "nginx", want: true},
+		{name: "Repository", arg: "library/nginx:1.25", want: true},
+		{name: "Registry", arg: "ghcr.io/org/app:v1.2.0", want: true},
+		{name: "RegistryWithPort", arg: "localhost:5000/app", want: true},
+		{name: "IPv6Registry", arg: "[::1]:5000/app:latest", want: true},
+		{name: "Digest", arg: "nginx@" + digest, want: true},
+		{name: "TagAndDigest", arg: "registry.local:5000/team/app:1.0@" + digest, want: true},
+		{name: "Separators", arg: "org/my_app__v2.beta-1", want: true},
+		{name: "UpperCaseRepository", arg: "Nginx:latest", want: false},
+		{name: "EmptyTag", arg: "nginx:", want: false},
+		{name: "InvalidDigest", arg: "nginx@sha256:abc", want: false},
+		{name: "ShortSHA256", arg: "nginx@sha256:" + strings.Repeat("a", 40), want: false},
+		{name: "TrailingSlash", arg: "org/app/", want: false},
+		{name: "DoubleSlash", arg: "org//app", want: false},
+		{name: "NameTooLong", arg: strings.Repeat("a", 256), want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsDockerImageReference(tc.arg); got != tc.want {
+				t.Errorf("IsDockerImageReference(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsImageTag(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Latest", arg: "latest", want: true},
+		{name: "Version", arg: "1.25-alpine", want: true},
+		{name: "Underscore", arg: "_build", want: true},
+		{name: "MaxLength", arg: strings.Repeat("a", 128), want: true},
+		{name: "TooLong", arg: strings.Repeat("a", 129), want: false},
+		{name: "LeadingDot", arg: ".hidden", want: false},
+		{name: "LeadingHyphen", arg: "-rc", want: false},
+		{name: "Plus", arg: "v1+build", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsImageTag(tc.arg); got != tc.want {
+				t.Errorf("IsImageTag(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsImageDigest(t *testing.T) {
+	testCases := []baseCase{
+		{name: "SHA256", arg: "sha256:" + strings.Repeat("a", 64), want: true},
+		{name: "SHA512", arg: "sha512:" + strings.Repeat("0", 128), want: true},
+		{name: "OtherAlgorithm", arg: "multihash+base58:" + strings.Repeat("F", 32), want: true},
+		{name: "ShortSHA256", arg: "sha256:" + strings.Repeat("a", 63), want: false},
+		{name: "UpperCaseSHA256", arg: "sha256:" + strings.Repeat("A", 64), want: false},
+		{name: "ShortEncoded", arg: "md5:" + strings.Repeat("a", 31), want: false},
+		{name: "MissingAlgorithm", arg: ":" + strings.Repeat("a", 64), want: false},
+		{name: "Tag", arg: "latest", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsImageDigest(tc.arg); got != tc.want {
+				t.Errorf("IsImageDigest(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	_ "embed"
+	"strings"
+	"time"
+)
+
+// freeEmailDomainsList is the embedded list of free email provider domains, one per line, covering the largest
+// providers worldwide and in Brazil.
+//
+//go:embed free_email_domains.txt
+var freeEmailDomainsList string
+
+// disposableEmailDomainsList is the embedded list of disposable email provider domains, one per line, covering
+// the best-known temporary inbox services.
+//
+//go:embed disposable_email_domains.txt
+var disposableEmailDomainsList string
+
+// freeEmailDomains holds the free email provider domains, built on first use.
+var freeEmailDomains = lazySet(freeEmailDomainsList, strings.ToLower)
+
+// disposableEmailDomains holds the disposable email provider domains, built on first use.
+var disposableEmailDomains = lazySet(disposableEmailDomainsList, strings.ToLower)
+
+// IsDisposableEmail checks if a given value is a valid email, as checked by IsEmail, whose domain, or one of its
+// parent domains, belongs to a disposable email provider such as mailinator.com, without any network call.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an email.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an email of a disposable provider.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsDisposableEmail("user@mailinator.com"))    // true
+//	fmt.Println(IsDisposableEmail("user@eu.mailinator.com")) // true
+//	fmt.Println(IsDisposableEmail("user@gmail.com"))         // false
+//	fmt.Println(IsDisposableEmail("mailinator.com"))         // false
+func IsDisposableEmail(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsDisposableEmail", time.Now(), &passed)
+	}
+	domain, ok := emailDomain(toString(a))
+	return ok && domainInList(domain, disposableEmailDomains())
+}
+
+// IsFreeEmail checks if a given value is a valid email, as checked by IsEmail, whose domain belongs to a free
+// email provider such as gmail.com or outlook.com, without any network call.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an email.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an email of a free provider.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsFreeEmail("user@gmail.com"))   // true
+//	fmt.Println(IsFreeEmail("user@Outlook.com")) // true
+//	fmt.Println(IsFreeEmail("user@example.com")) // false
+func IsFreeEmail(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsFreeEmail", time.Now(), &passed)
+	}
+	domain, ok := emailDomain(toString(a))
+	return ok && domainInList(domain, freeEmailDomains())
+}
+
+// IsCorporateEmail checks if a given value is a valid email, as checked by IsEmail, that belongs neither to a
+// free provider, as checked by IsFreeEmail, nor to a disposable one, as checked by IsDisposableEmail. It is meant
+// for B2B signup flows that require a work email; it does not check that the domain exists.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an email.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a corporate email.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsCorporateEmail("jane@acme.com"))    // true
+//	fmt.Println(IsCorporateEmail("jane@gmail.com"))   // false
+//	fmt.Println(IsCorporateEmail("jane@yopmail.com")) // false
+//	fmt.Println(IsCorporateEmail("not an email"))     // false
+func IsCorporateEmail(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsCorporateEmail", time.Now(), &passed)
+	}
+	domain, ok := emailDomain(toString(a))
+	return ok && !domainInList(domain, freeEmailDomains()) && !domainInList(domain, disposableEmailDomains())
+}
+
+// IsEmailFromDomain checks if a given value is a valid email, as checked by IsEmail, whose domain is one of the
+// given domains or a subdomain of one of them. The comparison is case-insensitive.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an email.
+//   - domains: The allowed domains, such as "acme.com".
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an email from one of the domains. It returns false
+//     when no domain is given.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsEmailFromDomain("jane@acme.com", "acme.com"))                  // true
+//	fmt.Println(IsEmailFromDomain("jane@eu.acme.com", "acme.com"))               // true
+//	fmt.Println(IsEmailFromDomain("jane@notacme.com", "acme.com"))               // false
+//	fmt.Println(IsEmailFromDomain("jane@globex.com", "acme.com", "initech.com")) // false
+func IsEmailFromDomain(a any, domains ...string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsEmailFromDomain", time.Now(), &passed)
+	}
+	domain, ok := emailDomain(toString(a))
+	if !ok {
+		return false
+	}
+	allowed := map[string]struct{}{}
+	for _, d := range domains {
+		allowed[strings.ToLower(strings.Trim(strings.TrimSpace(d), "."))] = struct{}{}
+	}
+	return domainInList(domain, allowed)
+}
+
+// emailDomain returns the lower-cased domain of s, reporting false if s is not a valid email.
+func emailDomain(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if !isEmail(s) {
+		return "", false
+	}
+	return strings.ToLower(s[strings.LastIndex(s, "@")+1:]), true
+}
+
+// domainInList reports whether domain, or one of its parent domains, is in list.
+func domainInList(domain string, list map[string]struct{}) bool {
+	for {
+		if _, ok := list[domain]; ok {
+			return true
+		}
+		dot := strings.Index(domain, ".")
+		if dot < 0 {
+			return false
+		}
+		domain = domain[dot+1:]
+	}
+}
@@ -0,0 +1,127 @@
+package checker
+
+import "testing"
+
+func TestIsDisposableEmail(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Disposable", arg: "user@mailinator.com", want: true},
+		{name: "Subdomain", arg: "user@eu.mailinator.com", want: true},
+		{name: "UpperCase", arg: "User@YOPMAIL.COM", want: true},
+		{name: "Free", arg: "user@gmail.com", want: false},
+		{name: "Corporate", arg: "jane@acme.com", want: false},
+		{name: "LookAlike", arg: "user@notmailinator.com", want: false},
+		{name: "NotEmail", arg: "mailinator.com", want: false},
+		{name: "Unsupported", arg: func() {}, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsDisposableEmail(tc.arg); got != tc.want {
+				t.Errorf("IsDisposableEmail(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsFreeEmail(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Gmail", arg: "user@gmail.com", want: true},
+		{name: "UpperCase", arg: "user@Outlook.com", want: true},
+		{name: "Brazilian", arg: "user@uol.com.br", want: true},
+		{name: "Corporate", arg: "user@example.com", want: false},
+		{name: "Disposable", arg: "user@yopmail.com", want: false},
+		{name: "NotEmail", arg: "gmail.com", want: false},
+		{name: "Unsupported", arg: func() {}, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsFreeEmail(tc.arg); got != tc.want {
+				t.Errorf("IsFreeEmail(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsCorporateEmail(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Corporate", arg: "jane@acme.com", want: true},
+		{name: "Subdomain", arg: "jane@eu.acme.com", want: true},
+		{name: "Padded", arg: " jane@acme.com ", want: true},
+		{name: "Free", arg: "jane@gmail.com", want: false},
+		{name: "Disposable", arg: "jane@yopmail.com", want: false},
+		{name: "DisposableSubdomain", arg: "jane@x.mailinator.com", want: false},
+		{name: "NotEmail", arg: "not an email", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Unsupported", arg: func() {}, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsCorporateEmail(tc.arg); got != tc.want {
+				t.Errorf("IsCorporateEmail(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsEmailFromDomain(t *testing.T) {
+	testCases := []struct {
+		name    string
+		arg     any
+		domains []string
+		want    bool
+		panic   bool
+	}{
+		{name: "SameDomain", arg: "jane@acme.com", domains: []string{"acme.com"}, want: true},
+		{name: "Subdomain", arg: "jane@eu.acme.com", domains: []string{"acme.com"}, want: true},
+		{name: "CaseInsensitive", arg: "Jane@ACME.com", domains: []string{"Acme.COM"}, want: true},
+		{name: "SecondDomain", arg: "jane@initech.com", domains: []string{"acme.com", "initech.com"}, want: true},
+		{name: "LookAlike", arg: "jane@notacme.com", domains: []string{"acme.com"}, want: false},
+		{name: "ParentDomain", arg: "jane@acme.com", domains: []string{"eu.acme.com"}, want: false},
+		{name: "OtherDomain", arg: "jane@globex.com", domains: []string{"acme.com", "initech.com"}, want: false},
+		{name: "NoDomains", arg: "jane@acme.com", want: false},
+		{name: "NotEmail", arg: "acme.com", domains: []string{"acme.com"}, want: false},
+		{name: "Unsupported", arg: func() {}, domains: []string{"acme.com"}, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsEmailFromDomain(tc.arg, tc.domains...); got != tc.want {
+				t.Errorf("IsEmailFromDomain(%v, %v) = %v, want %v", tc.arg, tc.domains, got, tc.want)
+			}
+		})
+	}
+}
@@ -23,11 +23,15 @@
 package checker
 
 import (
+	"database/sql/driver"
+	"math"
 	"reflect"
 	"strings"
+	"time"
 )
 
-// IsNil determines whether a given value is nil using reflection.
+// IsNil determines whether a given value is nil using reflection. Strings, numbers, booleans, []byte and
+// map[string]any, among the most checked types, take a fast path without reflection.
 //
 // This function takes a {} interface parameter `a` and returns a boolean value
 // indicating whether the value is nil. It uses reflection to determine the type
@@ -41,6 +45,8 @@ import (
 //   - Slices
 //   - Functions
 //   - Interfaces
+//   - Values implementing driver.Valuer, such as sql.NullString and the other sql.Null types, whose Value is nil,
+//     as it is for SQL NULL
 //
 // Parameters:
 //   - a: Any interface value to be checked for nil.
@@ -54,17 +60,20 @@ import (
 //	y := 10
 //	fmt.Println(IsNil(x)) // true
 //	fmt.Println(IsNil(y)) // false
-func IsNil(a any) bool {
-	rv := reflect.ValueOf(a)
+//	fmt.Println(IsNil(sql.NullString{Valid: false})) // true
+func IsNil(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNil", time.Now(), &passed)
+	}
+	return isNil(a)
+}
 
-	switch rv.Kind() {
-	case reflect.Invalid:
-		return true
-	case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Array, reflect.Chan, reflect.Slice, reflect.Func:
-		return rv.IsNil()
-	default:
-		return false
+// isNil is the unobserved implementation of IsNil.
+func isNil(a any) bool {
+	if result, ok := isNilFast(a); ok {
+		return result
 	}
+	return isNilReflect(a)
 }
 
 // NonNil determines whether a given value is not nil. It uses the IsNil function
@@ -82,8 +91,16 @@ func IsNil(a any) bool {
 //	y := 10
 //	fmt.Println(NonNil(x)) // false
 //	fmt.Println(NonNil(y)) // true
-func NonNil(a any) bool {
-	return !IsNil(a)
+func NonNil(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NonNil", time.Now(), &passed)
+	}
+	return nonNil(a)
+}
+
+// nonNil is the unobserved implementation of NonNil.
+func nonNil(a any) bool {
+	return !isNil(a)
 }
 
 // AllNil determines whether multiple values are all nil by iterating over them and checking if any of them is not nil.
@@ -101,9 +118,12 @@ func NonNil(a any) bool {
 //	fmt.Println(AllNil(nil, 0, "hello"))   // false
 //	fmt.Println(AllNil(nil, nil, nil, nil))// true
 //	fmt.Println(AllNil(nil))               // true
-func AllNil(a, b any, c ...any) bool {
+func AllNil(a, b any, c ...any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("AllNil", time.Now(), &passed)
+	}
 	for _, i := range append([]any{a, b}, c...) {
-		if NonNil(i) {
+		if nonNil(i) {
 			return false
 		}
 	}
@@ -136,10 +156,13 @@ func AllNil(a, b any, c ...any) bool {
 //	k := 15
 //	fmt.Println(NoneNil(j, k, "example", 20)) // true
 //	fmt.Println(NoneNil(i, j, k)) // false
-func NoneNil(a, b any, c ...any) bool {
+func NoneNil(a, b any, c ...any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NoneNil", time.Now(), &passed)
+	}
 	c = append([]any{a, b}, c...)
 	for _, i := range c {
-		if IsNil(i) {
+		if isNil(i) {
 			return false
 		}
 	}
@@ -151,8 +174,12 @@ func NoneNil(a, b any, c ...any) bool {
 // The function first calls the IsNil function to check if the value is nil. If it is nil,
 // the function immediately returns true.
 //
-// If the value is not nil, it then uses reflection to determine the type of the value and
-// checks if it is empty based on its type. The following checks are performed:
+// If the value implements driver.Valuer, such as sql.NullString and the other sql.Null types, the value it
+// returns is checked instead, so a valid sql.NullString is empty only if its String is. Pointers are followed
+// through any number of levels, so a pointer to a pointer to an empty string is empty.
+//
+// Otherwise, it determines the type of the value, without reflection for strings, numbers, booleans, []byte and
+// map[string]any, and checks if it is empty based on its type. The following checks are performed:
 //   - For strings, it trims whitespace from the string and checks if the resulting string has zero length.
 //   - For slices and arrays, it checks if the length is zero.
 //   - For maps, it checks if the number of keys is zero.
@@ -176,26 +203,23 @@ func NoneNil(a, b any, c ...any) bool {
 //
 //	var ptr *int
 //	fmt.Println(IsEmpty(ptr))  // true
-func IsEmpty(a any) bool {
-	if IsNil(a) {
-		return true
-	}
-
-	reflectValue := reflect.ValueOf(a)
-	if reflectValue.Kind() == reflect.Pointer || reflectValue.Kind() == reflect.Interface {
-		reflectValue = reflectValue.Elem()
+//
+//	str := ""
+//	strPtr := &str
+//	fmt.Println(IsEmpty(&strPtr))  // true
+//
+//	fmt.Println(IsEmpty(sql.NullString{Valid: false}))  // true
+//	fmt.Println(IsEmpty(sql.NullString{String: "text", Valid: true}))  // false
+func IsEmpty(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsEmpty", time.Now(), &passed)
 	}
+	return isEmpty(a)
+}
 
-	switch reflectValue.Kind() {
-	case reflect.String:
-		return len(strings.TrimSpace(reflectValue.String())) == 0
-	case reflect.Slice, reflect.Array:
-		return reflectValue.Len() == 0
-	case reflect.Map:
-		return len(reflectValue.MapKeys()) == 0
-	default:
-		return reflectValue.IsZero()
-	}
+// isEmpty is the unobserved implementation of IsEmpty.
+func isEmpty(a any) bool {
+	return isEmptyValue(a, true)
 }
 
 // IsNotEmpty checks if a given value is not empty based on its type by calling the IsEmpty function and negating its result.
@@ -225,8 +249,65 @@ func IsEmpty(a any) bool {
 //
 //	var ptr *int
 //	fmt.Println(IsNotEmpty(ptr))  // false
-func IsNotEmpty(a any) bool {
-	return !IsEmpty(a)
+func IsNotEmpty(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNotEmpty", time.Now(), &passed)
+	}
+	return isNotEmpty(a)
+}
+
+// isNotEmpty is the unobserved implementation of IsNotEmpty.
+func isNotEmpty(a any) bool {
+	return !isEmpty(a)
+}
+
+// IsEmptyStrict checks if a given value is empty like IsEmpty does, except that strings are not trimmed, so a
+// whitespace-only string such as " " is not empty. Use it for fields where whitespace is a meaningful value to be
+// rejected by other checks, such as passwords and tokens.
+//
+// Parameters:
+//   - a: Any interface value to be checked for being empty.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is empty, whitespace being kept.
+//
+// Example:
+//
+//	fmt.Println(IsEmptyStrict(""))                                       // true
+//	fmt.Println(IsEmptyStrict(" "))                                      // false
+//	fmt.Println(IsEmptyStrict([]int{}))                                  // true
+//	fmt.Println(IsEmptyStrict(sql.NullString{String: " ", Valid: true})) // false
+func IsEmptyStrict(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsEmptyStrict", time.Now(), &passed)
+	}
+	return isEmptyStrict(a)
+}
+
+// isEmptyStrict is the unobserved implementation of IsEmptyStrict.
+func isEmptyStrict(a any) bool {
+	return isEmptyValue(a, false)
+}
+
+// IsNotEmptyStrict checks if a given value is not empty by calling the IsEmptyStrict function and negating its
+// result, so a whitespace-only string such as " " is not empty.
+//
+// Parameters:
+//   - a: Any interface value to be checked for not being empty.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is not empty, whitespace being kept.
+//
+// Example:
+//
+//	fmt.Println(IsNotEmptyStrict(" ")) // true
+//	fmt.Println(IsNotEmptyStrict(""))  // false
+//	fmt.Println(IsNotEmptyStrict(nil)) // false
+func IsNotEmptyStrict(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNotEmptyStrict", time.Now(), &passed)
+	}
+	return !isEmptyStrict(a)
 }
 
 // AllEmpty checks if all given values are empty. Empty means either a value
@@ -249,10 +330,13 @@ func IsNotEmpty(a any) bool {
 //	a, b := "hello", 10
 //	c, d := []int{1, 2, 3}, "world"
 //	fmt.Println(AllEmpty(a, b, c, d)) // false
-func AllEmpty(a, b any, c ...any) bool {
+func AllEmpty(a, b any, c ...any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("AllEmpty", time.Now(), &passed)
+	}
 	c = append([]any{a, b}, c...)
 	for _, i := range c {
-		if IsNotEmpty(i) {
+		if isNotEmpty(i) {
 			return false
 		}
 	}
@@ -290,10 +374,13 @@ func AllEmpty(a, b any, c ...any) bool {
 //	m1 := make(map[string]int{"key": 1})
 //	m2 := make(map[string]int)
 //	fmt.Println(NoneEmpty(m1, m2))  // false
-func NoneEmpty(a, b any, c ...any) bool {
+func NoneEmpty(a, b any, c ...any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NoneEmpty", time.Now(), &passed)
+	}
 	c = append([]any{a, b}, c...)
 	for _, i := range c {
-		if IsEmpty(i) {
+		if isEmpty(i) {
 			return false
 		}
 	}
@@ -324,8 +411,16 @@ func NoneEmpty(a, b any, c ...any) bool {
 //
 //	y := 10
 //	fmt.Println(IsNilOrEmpty(y)) // false
-func IsNilOrEmpty(a any) bool {
-	return IsNil(a) || IsEmpty(a)
+func IsNilOrEmpty(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNilOrEmpty", time.Now(), &passed)
+	}
+	return isNilOrEmpty(a)
+}
+
+// isNilOrEmpty is the unobserved implementation of IsNilOrEmpty.
+func isNilOrEmpty(a any) bool {
+	return isNil(a) || isEmpty(a)
 }
 
 // IsNotNilOrEmpty checks whether a given value is not nil or empty. It uses the IsNilOrEmpty function
@@ -353,8 +448,16 @@ func IsNilOrEmpty(a any) bool {
 //
 //	y := 10
 //	fmt.Println(IsNotNilOrEmpty(y)) // true
-func IsNotNilOrEmpty(a any) bool {
-	return !IsNilOrEmpty(a)
+func IsNotNilOrEmpty(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNotNilOrEmpty", time.Now(), &passed)
+	}
+	return isNotNilOrEmpty(a)
+}
+
+// isNotNilOrEmpty is the unobserved implementation of IsNotNilOrEmpty.
+func isNotNilOrEmpty(a any) bool {
+	return !isNilOrEmpty(a)
 }
 
 // AllNilOrEmpty checks whether all supplied values are nil or empty.
@@ -378,10 +481,13 @@ func IsNotNilOrEmpty(a any) bool {
 //	fmt.Println(AllNilOrEmpty(str)) // true
 //	fmt.Println(AllNilOrEmpty(x)) // false
 //	fmt.Println(AllNilOrEmpty(m, x, str)) // false
-func AllNilOrEmpty(a, b any, c ...any) bool {
+func AllNilOrEmpty(a, b any, c ...any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("AllNilOrEmpty", time.Now(), &passed)
+	}
 	c = append([]any{a, b}, c...)
 	for _, v := range c {
-		if IsNotNilOrEmpty(v) {
+		if isNotNilOrEmpty(v) {
 			return false
 		}
 	}
@@ -417,10 +523,13 @@ func AllNilOrEmpty(a, b any, c ...any) bool {
 //	z := []int{}
 //	w := map[string]int{}
 //	fmt.Println(NoneNilOrEmpty(x, y, z, w)) // false
-func NoneNilOrEmpty(a, b any, c ...any) bool {
+func NoneNilOrEmpty(a, b any, c ...any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NoneNilOrEmpty", time.Now(), &passed)
+	}
 	c = append([]any{a, b}, c...)
 	for _, v := range c {
-		if IsNilOrEmpty(v) {
+		if isNilOrEmpty(v) {
 			return false
 		}
 	}
@@ -450,7 +559,7 @@ func NoneNilOrEmpty(a, b any, c ...any) bool {
 //	*x = 5
 //	fmt.Println(IfNilReturns(x, y)) // 5
 func IfNilReturns[T any](a *T, b T) T {
-	if IsNil(a) {
+	if isNil(a) {
 		return b
 	}
 	return *a
@@ -480,8 +589,226 @@ func IfNilReturns[T any](a *T, b T) T {
 //	listB := []int{1, 2, 3}
 //	fmt.Println(ifEmptyReturns(listA, listB))  // output: [1 2 3]
 func IfEmptyReturns[T any](a T, b T) T {
-	if IsEmpty(a) {
+	if isEmpty(a) {
 		return b
 	}
 	return a
 }
+
+// IsRequiredIf checks a conditional requirement: when the condition is true, the given value must not be empty.
+// Emptiness follows the IsEmpty semantics, so nil values, zero values and whitespace-only strings are considered
+// absent. When the condition is false, the requirement does not apply and the function returns true.
+//
+// Parameters:
+//   - a: The value that is required when the condition holds.
+//   - condition: A boolean indicating whether the value is required.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the requirement is satisfied.
+//
+// Example:
+//
+//	fmt.Println(IsRequiredIf("", false))     // true
+//	fmt.Println(IsRequiredIf("   ", true))   // false
+//	fmt.Println(IsRequiredIf("value", true)) // true
+func IsRequiredIf(a any, condition bool) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsRequiredIf", time.Now(), &passed)
+	}
+	return !condition || isNotEmpty(a)
+}
+
+// IsRequiredUnless checks a conditional requirement: the given value must not be empty unless the condition is
+// true. It is the counterpart of IsRequiredIf and follows the same IsEmpty semantics.
+//
+// Parameters:
+//   - a: The value that is required when the condition does not hold.
+//   - condition: A boolean indicating whether the value is exempt from the requirement.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the requirement is satisfied.
+//
+// Example:
+//
+//	fmt.Println(IsRequiredUnless("", true))       // true
+//	fmt.Println(IsRequiredUnless("", false))      // false
+//	fmt.Println(IsRequiredUnless("value", false)) // true
+func IsRequiredUnless(a any, condition bool) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsRequiredUnless", time.Now(), &passed)
+	}
+	return condition || isNotEmpty(a)
+}
+
+// IsRequiredWith checks a conditional requirement: if any of the other values is present (not empty),
+// the given value must not be empty too. When every other value is empty, the requirement does not apply
+// and the function returns true. Emptiness follows the IsEmpty semantics.
+//
+// Parameters:
+//   - a: The value that is required when any of the others is present.
+//   - others: The values whose presence makes 'a' required.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the requirement is satisfied.
+//
+// Example:
+//
+//	street, number := "Main Street", ""
+//	fmt.Println(IsRequiredWith(number, street)) // false
+//	fmt.Println(IsRequiredWith(number, ""))     // true
+//	fmt.Println(IsRequiredWith("10", street))   // true
+func IsRequiredWith(a any, others ...any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsRequiredWith", time.Now(), &passed)
+	}
+	for _, other := range others {
+		if isNotEmpty(other) {
+			return isNotEmpty(a)
+		}
+	}
+	return true
+}
+
+// valuerValue returns the value of a driver.Valuer, such as sql.NullString, reporting false when the value does
+// not implement driver.Valuer or its Value method fails.
+func valuerValue(a any) (driver.Value, bool) {
+	valuer, ok := a.(driver.Valuer)
+	if !ok {
+		return nil, false
+	}
+	value, err := valuer.Value()
+	return value, err == nil
+}
+
+// isNilFast reports whether the value is nil without reflection for the types most checked, such as strings,
+// numbers, []byte and map[string]any. The second return value is false for the other types, which must be
+// checked by isNilReflect.
+func isNilFast(a any) (bool, bool) {
+	switch v := a.(type) {
+	case nil:
+		return true, true
+	case string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return false, true
+	case []byte:
+		return v == nil, true
+	case []string:
+		return v == nil, true
+	case []any:
+		return v == nil, true
+	case map[string]any:
+		return v == nil, true
+	case map[string]string:
+		return v == nil, true
+	default:
+		return false, false
+	}
+}
+
+// isNilReflect reports whether the value is nil using reflection, including the values of driver.Valuer types
+// that are SQL NULL.
+func isNilReflect(a any) bool {
+	rv := reflect.ValueOf(a)
+
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Array, reflect.Chan, reflect.Slice, reflect.Func:
+		if rv.IsNil() {
+			return true
+		}
+	}
+
+	value, ok := valuerValue(a)
+	return ok && value == nil
+}
+
+// isEmptyValue reports whether the value is empty, as IsEmpty does when 'trim' is true and IsEmptyStrict does
+// when it is false.
+func isEmptyValue(a any, trim bool) bool {
+	if result, ok := isEmptyFast(a, trim); ok {
+		return result
+	}
+	return isEmptyReflect(a, trim)
+}
+
+// isEmptyFast reports whether the value is empty without reflection for the types most checked, with the same
+// result as isEmptyReflect. The second return value is false for the other types, which must be checked by
+// isEmptyReflect.
+func isEmptyFast(a any, trim bool) (bool, bool) {
+	switch v := a.(type) {
+	case nil:
+		return true, true
+	case string:
+		return isEmptyString(v, trim), true
+	case bool:
+		return !v, true
+	case int:
+		return v == 0, true
+	case int8:
+		return v == 0, true
+	case int16:
+		return v == 0, true
+	case int32:
+		return v == 0, true
+	case int64:
+		return v == 0, true
+	case uint:
+		return v == 0, true
+	case uint8:
+		return v == 0, true
+	case uint16:
+		return v == 0, true
+	case uint32:
+		return v == 0, true
+	case uint64:
+		return v == 0, true
+	case float32:
+		return math.Float32bits(v) == 0, true
+	case float64:
+		return math.Float64bits(v) == 0, true
+	case []byte:
+		return len(v) == 0, true
+	case []string:
+		return len(v) == 0, true
+	case []any:
+		return len(v) == 0, true
+	case map[string]any:
+		return len(v) == 0, true
+	case map[string]string:
+		return len(v) == 0, true
+	default:
+		return false, false
+	}
+}
+
+// isEmptyReflect reports whether the value is empty using reflection, dereferencing pointers, interfaces and
+// driver.Valuer types.
+func isEmptyReflect(a any, trim bool) bool {
+	if isNil(a) {
+		return true
+	} else if value, ok := valuerValue(a); ok {
+		return isEmptyValue(value, trim)
+	}
+
+	reflectValue := reflect.ValueOf(a)
+	if reflectValue.Kind() == reflect.Pointer || reflectValue.Kind() == reflect.Interface {
+		return isEmptyValue(reflectValue.Elem().Interface(), trim)
+	}
+
+	switch reflectValue.Kind() {
+	case reflect.String:
+		return isEmptyString(reflectValue.String(), trim)
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return reflectValue.Len() == 0
+	default:
+		return reflectValue.IsZero()
+	}
+}
+
+// isEmptyString reports whether the string is empty, ignoring whitespace when 'trim' is true.
+func isEmptyString(s string, trim bool) bool {
+	if trim {
+		s = strings.TrimSpace(s)
+	}
+	return len(s) == 0
+}
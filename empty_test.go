@@ -1,9 +1,15 @@
 package checker
 
 import (
+	"database/sql"
 	"testing"
+	"time"
 )
 
+func pointerTo[T any](v T) *T {
+	return &v
+}
+
 type emptyCase struct {
 	name string
 	args []any
@@ -70,6 +76,26 @@ func TestIsNotEmpty(t *testing.T) {
 	}
 }
 
+func TestIsEmptyStrict(t *testing.T) {
+	for _, tc := range buildIsEmptyStrictCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsEmptyStrict(tc.args[0]); got != tc.want {
+				t.Errorf("IsEmptyStrict() = %v, want = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsNotEmptyStrict(t *testing.T) {
+	for _, tc := range buildIsEmptyStrictCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsNotEmptyStrict(tc.args[0]); got == tc.want {
+				t.Errorf("IsNotEmptyStrict() = %v, want = %v", got, !tc.want)
+			}
+		})
+	}
+}
+
 func TestAllEmpty(t *testing.T) {
 	for _, tc := range buildAllEmptyCases() {
 		t.Run(tc.name, func(t *testing.T) {
@@ -210,6 +236,12 @@ func buildIsNilCases() []emptyCase {
 		{name: "InterfaceNonNil", args: []any{new(interface{})}, want: false},
 		{name: "ChannelNonNil", args: []any{make(chan struct{})}, want: false},
 		{name: "FunctionNonNil", args: []any{func() {}}, want: false},
+		{name: "NullStringInvalid", args: []any{sql.NullString{String: "text"}}, want: true},
+		{name: "NullInt64Invalid", args: []any{sql.NullInt64{}}, want: true},
+		{name: "NullStringInvalidPointer", args: []any{&sql.NullString{}}, want: true},
+		{name: "NullStringValid", args: []any{sql.NullString{Valid: true}}, want: false},
+		{name: "NullInt64Valid", args: []any{sql.NullInt64{Int64: 1, Valid: true}}, want: false},
+		{name: "PointerToNilPointer", args: []any{pointerTo((*int)(nil))}, want: false},
 	}
 }
 
@@ -277,6 +309,16 @@ func buildIsEmptyCases() []emptyCase {
 		{name: "InterfaceNonNil", args: []any{new(interface{})}, want: true},
 		{name: "ChannelNonNil", args: []any{make(chan struct{})}, want: false},
 		{name: "FunctionNonNil", args: []any{func() {}}, want: false},
+		{name: "PointerToNilPointer", args: []any{pointerTo((*int)(nil))}, want: true},
+		{name: "PointerToPointerEmpty", args: []any{pointerTo(pointerTo(""))}, want: true},
+		{name: "PointerToPointerNonEmpty", args: []any{pointerTo(pointerTo("text"))}, want: false},
+		{name: "NullStringInvalid", args: []any{sql.NullString{String: "text"}}, want: true},
+		{name: "NullStringValidEmpty", args: []any{sql.NullString{String: " ", Valid: true}}, want: true},
+		{name: "NullStringValid", args: []any{sql.NullString{String: "text", Valid: true}}, want: false},
+		{name: "NullInt64ValidZero", args: []any{sql.NullInt64{Valid: true}}, want: true},
+		{name: "NullInt64Valid", args: []any{sql.NullInt64{Int64: 42, Valid: true}}, want: false},
+		{name: "NullTimeValid", args: []any{sql.NullTime{Time: time.Now(), Valid: true}}, want: false},
+		{name: "NullStringPointer", args: []any{&sql.NullString{String: "text", Valid: true}}, want: false},
 	}
 }
 
@@ -300,6 +342,28 @@ func buildIsNotEmptyCases() []emptyCase {
 		{name: "StringEmpty", args: []any{""}, want: false},
 		{name: "FloatNil", args: []any{(*float64)(nil)}, want: false},
 		{name: "IntNil", args: []any{(*int)(nil)}, want: false},
+		{name: "NullStringInvalid", args: []any{sql.NullString{}}, want: false},
+		{name: "NullStringValid", args: []any{sql.NullString{String: "text", Valid: true}}, want: true},
+		{name: "PointerToPointerNonEmpty", args: []any{pointerTo(pointerTo(1))}, want: true},
+	}
+}
+
+func buildIsEmptyStrictCases() []emptyCase {
+	return []emptyCase{
+		{name: "StringEmpty", args: []any{""}, want: true},
+		{name: "StringSpace", args: []any{" "}, want: false},
+		{name: "StringWhitespace", args: []any{"\t\n"}, want: false},
+		{name: "StringNonEmpty", args: []any{"token"}, want: false},
+		{name: "NamedStringSpace", args: []any{Gender(" ")}, want: false},
+		{name: "PointerToSpace", args: []any{pointerTo(" ")}, want: false},
+		{name: "PointerToEmptyString", args: []any{pointerTo("")}, want: true},
+		{name: "NullStringSpace", args: []any{sql.NullString{String: " ", Valid: true}}, want: false},
+		{name: "NullStringInvalid", args: []any{sql.NullString{}}, want: true},
+		{name: "Nil", args: []any{nil}, want: true},
+		{name: "SliceEmpty", args: []any{[]int{}}, want: true},
+		{name: "MapEmpty", args: []any{map[string]any{}}, want: true},
+		{name: "IntZero", args: []any{0}, want: true},
+		{name: "IntNonZero", args: []any{1}, want: false},
 	}
 }
 
@@ -450,3 +514,143 @@ func buildNoneNilOrEmptyCases() []emptyCase {
 		},
 	}
 }
+
+func TestIsRequiredIf(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         any
+		condition bool
+		want      bool
+	}{
+		{name: "ConditionFalseEmpty", a: "", condition: false, want: true},
+		{name: "ConditionFalseNil", a: nil, condition: false, want: true},
+		{name: "ConditionTrueEmpty", a: "", condition: true, want: false},
+		{name: "ConditionTrueWhitespace", a: "   ", condition: true, want: false},
+		{name: "ConditionTrueNil", a: (*string)(nil), condition: true, want: false},
+		{name: "ConditionTrueZero", a: 0, condition: true, want: false},
+		{name: "ConditionTrueValue", a: "value", condition: true, want: true},
+		{name: "ConditionTrueSlice", a: []int{1}, condition: true, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRequiredIf(tt.a, tt.condition); got != tt.want {
+				t.Errorf("IsRequiredIf() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRequiredUnless(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         any
+		condition bool
+		want      bool
+	}{
+		{name: "ConditionTrueEmpty", a: "", condition: true, want: true},
+		{name: "ConditionFalseEmpty", a: "", condition: false, want: false},
+		{name: "ConditionFalseWhitespace", a: " \t", condition: false, want: false},
+		{name: "ConditionFalseValue", a: "value", condition: false, want: true},
+		{name: "ConditionFalseMap", a: map[string]int{}, condition: false, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRequiredUnless(tt.a, tt.condition); got != tt.want {
+				t.Errorf("IsRequiredUnless() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRequiredWith(t *testing.T) {
+	for _, tc := range buildIsRequiredWithCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsRequiredWith(tc.args[0], tc.args[1:]...); got != tc.want {
+				t.Errorf("IsRequiredWith() = %v, want = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func buildIsRequiredWithCases() []emptyCase {
+	return []emptyCase{
+		{name: "NoOthers", args: []any{""}, want: true},
+		{name: "OthersEmpty", args: []any{"", "", nil, "  "}, want: true},
+		{name: "OtherPresentValueEmpty", args: []any{"", "street"}, want: false},
+		{name: "OtherPresentValueWhitespace", args: []any{"  ", "", "street"}, want: false},
+		{name: "OtherPresentValuePresent", args: []any{"10", "street"}, want: true},
+		{name: "OthersEmptyValuePresent", args: []any{"10", ""}, want: true},
+	}
+}
+
+func TestEmptyFastPathsMatchReflection(t *testing.T) {
+	values := []any{
+		nil, "", "  ", "text", true, false, 0, 1, int8(0), int16(-1), int32(0), int64(7), uint(0), uint8(1),
+		uint16(0), uint32(2), uint64(0), float32(0), float32(1.5), 0.0, -0.0, 2.5, []byte(nil), []byte{},
+		[]byte("x"), []string(nil), []string{}, []string{"a"}, []any(nil), []any{}, []any{nil},
+		map[string]any(nil), map[string]any{}, map[string]any{"a": 1}, map[string]string(nil),
+		map[string]string{}, map[string]string{"a": "b"},
+	}
+	for _, value := range values {
+		if got, ok := isNilFast(value); !ok || got != isNilReflect(value) {
+			t.Errorf("isNilFast(%#v) = %v, %v, want %v, true", value, got, ok, isNilReflect(value))
+		}
+		for _, trim := range []bool{true, false} {
+			if got, ok := isEmptyFast(value, trim); !ok || got != isEmptyReflect(value, trim) {
+				t.Errorf("isEmptyFast(%#v, %v) = %v, %v, want %v, true", value, trim, got, ok,
+					isEmptyReflect(value, trim))
+			}
+		}
+	}
+
+	for _, value := range []any{sql.NullString{}, pointerTo(""), struct{}{}, time.Time{}} {
+		if _, ok := isNilFast(value); ok {
+			t.Errorf("isNilFast(%#v) took the fast path", value)
+		}
+		if _, ok := isEmptyFast(value, true); ok {
+			t.Errorf("isEmptyFast(%#v) took the fast path", value)
+		}
+	}
+}
+
+func BenchmarkIsNilString(b *testing.B) {
+	benchmarkEmptyCheck(b, IsNil, "value")
+}
+
+func BenchmarkIsNilStringReflection(b *testing.B) {
+	benchmarkEmptyCheck(b, isNilReflect, "value")
+}
+
+func BenchmarkIsEmptyString(b *testing.B) {
+	benchmarkEmptyCheck(b, IsEmpty, "value")
+}
+
+func BenchmarkIsEmptyStringReflection(b *testing.B) {
+	benchmarkEmptyCheck(b, func(a any) bool { return isEmptyReflect(a, true) }, "value")
+}
+
+func BenchmarkIsEmptyInt(b *testing.B) {
+	benchmarkEmptyCheck(b, IsEmpty, 42)
+}
+
+func BenchmarkIsEmptyIntReflection(b *testing.B) {
+	benchmarkEmptyCheck(b, func(a any) bool { return isEmptyReflect(a, true) }, 42)
+}
+
+func BenchmarkIsEmptyMap(b *testing.B) {
+	benchmarkEmptyCheck(b, IsEmpty, map[string]any{"id": 1, "name": "value"})
+}
+
+func BenchmarkIsEmptyMapReflection(b *testing.B) {
+	benchmarkEmptyCheck(b, func(a any) bool { return isEmptyReflect(a, true) },
+		map[string]any{"id": 1, "name": "value"})
+}
+
+func benchmarkEmptyCheck(b *testing.B, check func(any) bool, value any) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		check(value)
+	}
+}
@@ -0,0 +1,141 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"time"
+	"unicode/utf8"
+)
+
+// IsGzipDecompressibleWithin checks if a given value is gzip compressed content that decompresses to at most
+// maxBytes. The content is decompressed in chunks and discarded, and decompression stops as soon as more than
+// maxBytes are produced, so a small payload that expands to a huge one (a "gzip bomb") is rejected without being
+// fully inflated. Concatenated gzip members are decompressed as a single content.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes, so []byte and string values are used as is.
+//   - maxBytes: The maximum number of decompressed bytes allowed.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is valid gzip that decompresses within maxBytes.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	zw := gzip.NewWriter(&buf)
+//	zw.Write([]byte("Hello World"))
+//	zw.Close()
+//
+//	fmt.Println(IsGzipDecompressibleWithin(buf.Bytes(), 1024))   // true
+//	fmt.Println(IsGzipDecompressibleWithin(buf.Bytes(), 5))      // false
+//	fmt.Println(IsGzipDecompressibleWithin("Hello World", 1024)) // false
+func IsGzipDecompressibleWithin(a any, maxBytes int64) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsGzipDecompressibleWithin", time.Now(), &passed)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(toBytes(a)))
+	if err != nil {
+		return false
+	}
+	defer reader.Close()
+	limited := &limitedReader{r: reader, limit: maxBytes}
+
+	_, err = io.Copy(io.Discard, limited)
+	return err == nil && !limited.exceeded()
+}
+
+// DecodesToJSON checks if a given value is a standard Base64 string, the same encoding accepted by IsBase64, whose
+// decoded content is a JSON object or array, the same shapes accepted by IsJSON.
+//
+// Parameters:
+//   - a: Any value to be checked.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is Base64 encoded JSON.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(DecodesToJSON("eyJrZXkiOiJ2YWx1ZSJ9")) // true
+//	fmt.Println(DecodesToJSON("WzEsMiwzXQ=="))         // true
+//	fmt.Println(DecodesToJSON("SGVsbG8gV29ybGQ="))     // false
+//	fmt.Println(DecodesToJSON(`{"key":"value"}`))      // false
+func DecodesToJSON(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("DecodesToJSON", time.Now(), &passed)
+	}
+	decoded, ok := decodeBase64(a)
+	return ok && isJSON(decoded)
+}
+
+// DecodesToUTF8 checks if a given value is a standard Base64 string, the same encoding accepted by IsBase64, whose
+// decoded content is valid UTF-8 text.
+//
+// Parameters:
+//   - a: Any value to be checked.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is Base64 encoded UTF-8 text.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(DecodesToUTF8("SGVsbG8gV29ybGQ=")) // true
+//	fmt.Println(DecodesToUTF8("w6fDo28="))         // true
+//	fmt.Println(DecodesToUTF8("/w=="))             // false
+//	fmt.Println(DecodesToUTF8("not base64!"))      // false
+func DecodesToUTF8(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("DecodesToUTF8", time.Now(), &passed)
+	}
+	decoded, ok := decodeBase64(a)
+	return ok && utf8.Valid(decoded)
+}
+
+// decodeBase64 decodes a non-empty standard Base64 value, reporting false when it is empty or not valid Base64.
+func decodeBase64(a any) ([]byte, bool) {
+	s := toString(a)
+	if isEmpty(s) {
+		return nil, false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(s)
+	return decoded, err == nil
+}
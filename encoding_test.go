@@ -0,0 +1,115 @@
+package checker
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+)
+
+type gzipCase struct {
+	name     string
+	arg      any
+	maxBytes int64
+	want     bool
+	panic    bool
+}
+
+func gzipString(s string) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, _ = zw.Write([]byte(s))
+	_ = zw.Close()
+	return buf.Bytes()
+}
+
+func TestIsGzipDecompressibleWithin(t *testing.T) {
+	compressed := gzipString("Hello World")
+	truncated := compressed[:len(compressed)-4]
+	tests := []gzipCase{
+		{name: "Valid", arg: compressed, maxBytes: 1024, want: true},
+		{name: "ExactLimit", arg: compressed, maxBytes: 11, want: true},
+		{name: "OverLimit", arg: compressed, maxBytes: 10, want: false},
+		{name: "String", arg: string(compressed), maxBytes: 1024, want: true},
+		{name: "Pointer", arg: &compressed, maxBytes: 1024, want: true},
+		{name: "Empty", arg: gzipString(""), maxBytes: 0, want: true},
+		{name: "Concatenated", arg: append(gzipString("Hello "), gzipString("World")...), maxBytes: 11, want: true},
+		{name: "Bomb", arg: gzipString(strings.Repeat("0", 1<<20)), maxBytes: 1024, want: false},
+		{name: "Truncated", arg: truncated, maxBytes: 1024, want: false},
+		{name: "NotGzip", arg: "Hello World", maxBytes: 1024, want: false},
+		{name: "NoContent", arg: []byte{}, maxBytes: 1024, want: false},
+		{name: "Nil", arg: nil, maxBytes: 1024, panic: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsGzipDecompressibleWithin(tc.arg, tc.maxBytes); got != tc.want {
+				t.Errorf("IsGzipDecompressibleWithin(%v, %v) = %v, want %v", tc.arg, tc.maxBytes, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDecodesToJSON(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Object", arg: "eyJrZXkiOiJ2YWx1ZSJ9", want: true},
+		{name: "Array", arg: "WzEsMiwzXQ==", want: true},
+		{name: "Bytes", arg: []byte("eyJrZXkiOiJ2YWx1ZSJ9"), want: true},
+		{name: "NotJSON", arg: "SGVsbG8gV29ybGQ=", want: false},
+		{name: "JSONString", arg: "InRleHQi", want: false},
+		{name: "RawJSON", arg: `{"key":"value"}`, want: false},
+		{name: "URLSafe", arg: "eyJrZXkiOiI_In0", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := DecodesToJSON(tc.arg); got != tc.want {
+				t.Errorf("DecodesToJSON(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDecodesToUTF8(t *testing.T) {
+	testCases := []baseCase{
+		{name: "ASCII", arg: "SGVsbG8gV29ybGQ=", want: true},
+		{name: "Accented", arg: "w6fDo28=", want: true},
+		{name: "Bytes", arg: []byte("w6fDo28="), want: true},
+		{name: "InvalidUTF8", arg: "/w==", want: false},
+		{name: "Invalid", arg: "not base64!", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := DecodesToUTF8(tc.arg); got != tc.want {
+				t.Errorf("DecodesToUTF8(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
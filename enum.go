@@ -22,7 +22,10 @@
 
 package checker
 
-import "reflect"
+import (
+	"reflect"
+	"time"
+)
 
 // Document represents a custom type for different types of documents.
 type Document string
@@ -34,6 +37,58 @@ const (
 	DocumentCNPJ Document = "CNPJ"
 )
 
+// Gender represents a custom type for the gender codes used in registry data.
+type Gender string
+
+const (
+	// GenderMale represents a constant of type Gender that indicates the male gender.
+	GenderMale Gender = "M"
+	// GenderFemale represents a constant of type Gender that indicates the female gender.
+	GenderFemale Gender = "F"
+	// GenderOther represents a constant of type Gender that indicates another gender.
+	GenderOther Gender = "O"
+	// GenderNotInformed represents a constant of type Gender that indicates the gender was not informed.
+	GenderNotInformed Gender = "N"
+)
+
+// IsEnumValid returns whether the gender is one of the Gender constants.
+func (g Gender) IsEnumValid() bool {
+	switch g {
+	case GenderMale, GenderFemale, GenderOther, GenderNotInformed:
+		return true
+	}
+	return false
+}
+
+// MaritalStatus represents a custom type for the marital status values used in registry data.
+type MaritalStatus string
+
+const (
+	// MaritalStatusSingle represents a constant of type MaritalStatus that indicates a single person.
+	MaritalStatusSingle MaritalStatus = "SINGLE"
+	// MaritalStatusMarried represents a constant of type MaritalStatus that indicates a married person.
+	MaritalStatusMarried MaritalStatus = "MARRIED"
+	// MaritalStatusStableUnion represents a constant of type MaritalStatus that indicates a person in a stable union
+	// (união estável).
+	MaritalStatusStableUnion MaritalStatus = "STABLE_UNION"
+	// MaritalStatusSeparated represents a constant of type MaritalStatus that indicates a separated person.
+	MaritalStatusSeparated MaritalStatus = "SEPARATED"
+	// MaritalStatusDivorced represents a constant of type MaritalStatus that indicates a divorced person.
+	MaritalStatusDivorced MaritalStatus = "DIVORCED"
+	// MaritalStatusWidowed represents a constant of type MaritalStatus that indicates a widowed person.
+	MaritalStatusWidowed MaritalStatus = "WIDOWED"
+)
+
+// IsEnumValid returns whether the marital status is one of the MaritalStatus constants.
+func (m MaritalStatus) IsEnumValid() bool {
+	switch m {
+	case MaritalStatusSingle, MaritalStatusMarried, MaritalStatusStableUnion, MaritalStatusSeparated,
+		MaritalStatusDivorced, MaritalStatusWidowed:
+		return true
+	}
+	return false
+}
+
 // BaseEnum is an interface that defines a method IsEnumValid.
 //
 // It is used in conjunction with the BaseEnum interface to validate enum values.
@@ -73,12 +128,20 @@ type BaseEnum interface {
 //	   fmt.Println(IsEnumValid(a)) // true
 //	   fmt.Println(IsEnumValid(b)) // false
 //	}
-func IsEnumValid(a any) bool {
+func IsEnumValid(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsEnumValid", time.Now(), &passed)
+	}
+	return isEnumValid(a)
+}
+
+// isEnumValid is the unobserved implementation of IsEnumValid.
+func isEnumValid(a any) bool {
 	reflectValueA := reflect.ValueOf(a)
 	if (reflectValueA.Kind() == reflect.Ptr || reflectValueA.Kind() == reflect.Interface) && !reflectValueA.IsNil() {
-		return IsEnumValid(reflectValueA.Elem().Interface())
+		return isEnumValid(reflectValueA.Elem().Interface())
 	}
 
 	baseEnum, ok := reflectValueA.Interface().(BaseEnum)
-	return ok && NonNil(baseEnum) && baseEnum.IsEnumValid()
+	return ok && nonNil(baseEnum) && baseEnum.IsEnumValid()
 }
@@ -87,3 +87,23 @@ func nilInterfaceOfNonBaseEnum() any {
 	var x *int
 	return x
 }
+
+func TestGenderIsEnumValid(t *testing.T) {
+	for _, gender := range []Gender{GenderMale, GenderFemale, GenderOther, GenderNotInformed} {
+		if !gender.IsEnumValid() {
+			t.Errorf("Gender(%v).IsEnumValid() = false, want true", gender)
+		}
+	}
+	if Gender("X").IsEnumValid() {
+		t.Errorf("Gender(X).IsEnumValid() = true, want false")
+	}
+}
+
+func TestMaritalStatusIsEnumValid(t *testing.T) {
+	if !IsEnumValid(MaritalStatusMarried) {
+		t.Errorf("IsEnumValid(%v) = false, want true", MaritalStatusMarried)
+	}
+	if IsEnumValid(MaritalStatus("married")) {
+		t.Errorf("IsEnumValid(married) = true, want false")
+	}
+}
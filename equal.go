@@ -23,9 +23,9 @@
 package checker
 
 import (
-	"fmt"
 	"reflect"
 	"strings"
+	"time"
 )
 
 // Equals checks whether two parameters a and b are profoundly equal.
@@ -45,18 +45,26 @@ import (
 //	fmt.Println(Equals(s1, s2))   // Outputs: true
 //
 // Returns true if a and b are deeply equal, false otherwise.
-func Equals(a, b any) bool {
+func Equals(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("Equals", time.Now(), &passed)
+	}
+	return equals(a, b)
+}
+
+// equals is the unobserved implementation of Equals.
+func equals(a, b any) bool {
 	reflectValueA := reflect.ValueOf(a)
 	if (reflectValueA.Kind() == reflect.Ptr || reflectValueA.Kind() == reflect.Interface) && !reflectValueA.IsNil() {
-		return Equals(reflectValueA.Elem().Interface(), b)
+		return equals(reflectValueA.Elem().Interface(), b)
 	}
 
 	reflectValueB := reflect.ValueOf(b)
 	if (reflectValueB.Kind() == reflect.Ptr || reflectValueB.Kind() == reflect.Interface) && !reflectValueB.IsNil() {
-		return Equals(a, reflectValueB.Elem().Interface())
+		return equals(a, reflectValueB.Elem().Interface())
 	}
 
-	if isNumeric(reflectValueA.Kind()) && isNumeric(reflectValueB.Kind()) {
+	if isNumericKind(reflectValueA.Kind()) && isNumericKind(reflectValueB.Kind()) {
 		return toFloat(reflectValueA.Interface()) == toFloat(reflectValueB.Interface())
 	}
 	return reflect.DeepEqual(a, b)
@@ -91,8 +99,16 @@ func Equals(a, b any) bool {
 // Note: An effort should be made to not to use this function to check the inequality
 // of interface values that represents nil, as the result might be ambiguous without
 // a clear understanding of how underlying Equals function handles nil values.
-func NotEquals(a, b any) bool {
-	return !Equals(a, b)
+func NotEquals(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NotEquals", time.Now(), &passed)
+	}
+	return notEquals(a, b)
+}
+
+// notEquals is the unobserved implementation of NotEquals.
+func notEquals(a, b any) bool {
+	return !equals(a, b)
 }
 
 // EqualsIgnoreCase compares two values and returns true if they are equal, ignoring case.
@@ -108,17 +124,25 @@ func NotEquals(a, b any) bool {
 //	fmt.Println(EqualsIgnoreCase("GoLang", "golang")) // true
 //	fmt.Println(EqualsIgnoreCase("Hello", "hello"))   // true
 //	fmt.Println(EqualsIgnoreCase("GoLang", "Java"))   // false
-func EqualsIgnoreCase(a, b any) bool {
+func EqualsIgnoreCase(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("EqualsIgnoreCase", time.Now(), &passed)
+	}
+	return equalsIgnoreCase(a, b)
+}
+
+// equalsIgnoreCase is the unobserved implementation of EqualsIgnoreCase.
+func equalsIgnoreCase(a, b any) bool {
 	validateEqualsIgnoreCaseParams(a)
 
 	reflectValueA := reflect.ValueOf(a)
 	if reflectValueA.Kind() == reflect.Ptr || reflectValueA.Kind() == reflect.Interface {
-		return EqualsIgnoreCase(reflectValueA.Elem().Interface(), b)
+		return equalsIgnoreCase(reflectValueA.Elem().Interface(), b)
 	}
 
 	reflectValueB := reflect.ValueOf(b)
 	if reflectValueB.Kind() == reflect.Ptr || reflectValueB.Kind() == reflect.Interface {
-		return EqualsIgnoreCase(a, reflectValueB.Elem().Interface())
+		return equalsIgnoreCase(a, reflectValueB.Elem().Interface())
 	}
 
 	return reflectValueB.Kind() == reflect.String &&
@@ -145,8 +169,11 @@ func EqualsIgnoreCase(a, b any) bool {
 //	z := "Java"
 //	fmt.Println(NotEqualsIgnoreCase(x, y)) // false
 //	fmt.Println(NotEqualsIgnoreCase(x, z)) // true
-func NotEqualsIgnoreCase(a, b any) bool {
-	return !EqualsIgnoreCase(a, b)
+func NotEqualsIgnoreCase(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NotEqualsIgnoreCase", time.Now(), &passed)
+	}
+	return !equalsIgnoreCase(a, b)
 }
 
 // AllEquals checks whether all parameters in the variadic arguments b are
@@ -167,11 +194,14 @@ func NotEqualsIgnoreCase(a, b any) bool {
 //	fmt.Println(AllEquals(x, y, z))  // Outputs: false
 //
 // Returns true if all parameters are deeply equal to a, false otherwise.
-func AllEquals(a, b any, c ...any) bool {
+func AllEquals(a, b any, c ...any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("AllEquals", time.Now(), &passed)
+	}
 	c = append([]any{a, b}, c...)
 	for i1, v1 := range c {
 		for i2, v2 := range c {
-			if NotEquals(i1, i2) && NotEquals(v1, v2) {
+			if notEquals(i1, i2) && notEquals(v1, v2) {
 				return false
 			}
 		}
@@ -204,11 +234,14 @@ func AllEquals(a, b any, c ...any) bool {
 // Note: This function uses DeepEqual for comparison. DeepEqual considers public and
 // private fields, so two different instances of the same struct type with equal field
 // values are deeply equal, even if their memory addresses differ.
-func NoneEquals(a, b any, c ...any) bool {
+func NoneEquals(a, b any, c ...any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NoneEquals", time.Now(), &passed)
+	}
 	c = append([]any{a, b}, c...)
 	for i1, v1 := range c {
 		for i2, v2 := range c {
-			if NotEquals(i1, i2) && Equals(v1, v2) {
+			if notEquals(i1, i2) && equals(v1, v2) {
 				return false
 			}
 		}
@@ -217,20 +250,20 @@ func NoneEquals(a, b any, c ...any) bool {
 }
 
 // validateEqualsIgnoreCaseParams validates the input value to ensure that it is not nil and is either a string or a pointer.
-// If the value is nil, it panics with an error message "A is nil".
-// If the value is not a string or a pointer, it panics with an error message "Unsupported type: {type}".
+// If the value is nil, it panics with ErrNilValue.
+// If the value is not a string or a pointer, it panics with an ErrUnsupportedType indicating the type.
 // The function uses reflection to determine the kind of the value and perform the necessary checks.
 func validateEqualsIgnoreCaseParams(a any) {
 	reflectValueA := reflect.ValueOf(a)
 
-	if IsNil(a) {
-		panic("A is nil")
+	if isNil(a) {
+		panic(ErrNilValue)
 	} else if reflectValueA.Kind() != reflect.String && reflectValueA.Kind() != reflect.Ptr {
-		panic(fmt.Sprintf("Unsupported type: %s", reflectValueA.Kind().String()))
+		panic(ErrUnsupportedType{Kind: reflectValueA.Kind()})
 	}
 }
 
-func isNumeric(kind reflect.Kind) bool {
+func isNumericKind(kind reflect.Kind) bool {
 	switch kind {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
 		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
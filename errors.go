@@ -0,0 +1,191 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// ErrNilValue is the error panicked by the checkers when they receive a nil value, such as nil or a nil pointer,
+// where a value is required. The conversion helpers wrap it with the failed conversion, so recover paths must
+// compare it with errors.Is.
+//
+// Example:
+//
+//	defer func() {
+//		if err, ok := recover().(error); ok && errors.Is(err, ErrNilValue) {
+//			fmt.Println("nil value") // nil value
+//		}
+//	}()
+//	IsCPF(nil)
+var ErrNilValue = errors.New("value is nil")
+
+// ErrUnsupportedType is the error panicked by the checkers when they receive a value whose type they cannot
+// handle, such as a channel. The conversion helpers wrap it with the failed conversion, so recover paths must
+// extract it with errors.As.
+//
+// Example:
+//
+//	defer func() {
+//		var unsupported ErrUnsupportedType
+//		if err, ok := recover().(error); ok && errors.As(err, &unsupported) {
+//			fmt.Println(unsupported.Kind) // chan
+//		}
+//	}()
+//	IsCPF(make(chan int))
+type ErrUnsupportedType struct {
+	// Kind is the kind of the unsupported value.
+	Kind reflect.Kind
+}
+
+// FieldError represents a failed check of a single field, such as the "email" field not being an email.
+type FieldError struct {
+	// Field is the name of the field that failed the check.
+	Field string
+	// Message describes why the field failed the check.
+	Message string
+}
+
+// Error returns the failure as a "field: message" pair.
+func (f FieldError) Error() string {
+	return f.Field + ": " + f.Message
+}
+
+// Error returns the description of the unsupported type, such as "unsupported type chan".
+func (e ErrUnsupportedType) Error() string {
+	return "unsupported type " + e.Kind.String()
+}
+
+// Errors collects the failures of many checks, so that a handler can run all the checks of a request and report
+// every invalid field at once. The zero value is an empty collection ready to use, and the failures are kept in
+// the order they were added.
+//
+// Example:
+//
+//	var errs Errors
+//	errs.Add("email", IsEmail("invalid"), "must be a valid email")
+//	errs.AddIf("document", IsCPF, "12101721007", "must be a valid CPF")
+//	if errs.HasAny() {
+//		fmt.Println(errs.Error()) // email: must be a valid email
+//	}
+type Errors []FieldError
+
+// Add records a failure of the given field with the given message when ok is false, the result of a check
+// performed by the caller. Nothing is recorded when ok is true.
+//
+// Parameters:
+//   - field: The name of the checked field.
+//   - ok: The result of the check.
+//   - msg: The message recorded when the check failed.
+//
+// Example:
+//
+//	var errs Errors
+//	errs.Add("name", IsFullName("Maria Silva"), "must be a full name")
+//	errs.Add("email", IsEmail("invalid"), "must be a valid email")
+//	fmt.Println(len(errs)) // 1
+func (e *Errors) Add(field string, ok bool, msg string) {
+	if !ok {
+		*e = append(*e, FieldError{Field: field, Message: msg})
+	}
+}
+
+// AddIf runs the given Rule against the value and records a failure of the given field with the given message
+// when the Rule returns false. A Rule that panics, as checkers do with unsupported or nil values, is also
+// recorded as a failure.
+//
+// Parameters:
+//   - field: The name of the checked field.
+//   - rule: The Rule to be run against the value.
+//   - value: The value of the field.
+//   - msg: The message recorded when the Rule fails.
+//
+// Example:
+//
+//	var errs Errors
+//	errs.AddIf("email", IsEmail, "test@example.com", "must be a valid email")
+//	errs.AddIf("email", IsEmail, nil, "must be a valid email")
+//	fmt.Println(len(errs)) // 1
+func (e *Errors) AddIf(field string, rule Rule, value any, msg string) {
+	e.Add(field, Guard(rule)(value), msg)
+}
+
+// HasAny returns whether any failure was recorded.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the collection has at least one failure.
+//
+// Example:
+//
+//	var errs Errors
+//	fmt.Println(errs.HasAny()) // false
+//	errs.Add("email", false, "must be a valid email")
+//	fmt.Println(errs.HasAny()) // true
+func (e Errors) HasAny() bool {
+	return len(e) > 0
+}
+
+// Error returns the recorded failures as "field: message" pairs separated by "; ", in the order they were
+// added, which allows Errors to be returned as an error.
+//
+// Returns:
+//   - string: The description of all the failures, or an empty string if there is none.
+//
+// Example:
+//
+//	var errs Errors
+//	errs.Add("email", false, "must be a valid email")
+//	errs.Add("name", false, "is required")
+//	fmt.Println(errs.Error()) // email: must be a valid email; name: is required
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldError := range e {
+		messages[i] = fieldError.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// MarshalJSON encodes the recorded failures as an object that maps each field to the list of its messages, the
+// body usually returned with a 422 Unprocessable Entity response.
+//
+// Returns:
+//   - []byte: The JSON object, "{}" if there is no failure.
+//   - error: An error if the messages could not be encoded.
+//
+// Example:
+//
+//	var errs Errors
+//	errs.Add("email", false, "must be a valid email")
+//	errs.Add("email", false, "is required")
+//	b, _ := json.Marshal(errs)
+//	fmt.Println(string(b)) // {"email":["must be a valid email","is required"]}
+func (e Errors) MarshalJSON() ([]byte, error) {
+	fields := make(map[string][]string, len(e))
+	for _, fieldError := range e {
+		fields[fieldError.Field] = append(fields[fieldError.Field], fieldError.Message)
+	}
+	return json.Marshal(fields)
+}
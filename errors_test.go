@@ -0,0 +1,143 @@
+package checker
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPanicErrors(t *testing.T) {
+	var nilString *string
+	testCases := []struct {
+		name     string
+		fn       func()
+		wantNil  bool
+		wantKind reflect.Kind
+	}{
+		{name: "ToStringNil", fn: func() { IsCPF(nil) }, wantNil: true},
+		{name: "ToStringNilPointer", fn: func() { IsCPF(nilString) }, wantNil: true},
+		{name: "ToStringUnsupported", fn: func() { IsCPF(make(chan int)) }, wantKind: reflect.Chan},
+		{name: "ToFloatNil", fn: func() { toFloat(nil) }, wantNil: true},
+		{name: "ToFloatUnsupported", fn: func() { toFloat(func() {}) }, wantKind: reflect.Func},
+		{name: "ToLengthNilPointer", fn: func() { toLength(nilString) }, wantNil: true},
+		{name: "ToLengthUnsupported", fn: func() { toLength(make(chan int)) }, wantKind: reflect.Chan},
+		{name: "ContainsNil", fn: func() { Contains(nil, 1) }, wantNil: true},
+		{name: "ContainsUnsupported", fn: func() { Contains(10, 1) }, wantKind: reflect.Int},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(error)
+				if !ok {
+					t.Fatalf("The code did not panic with an error")
+				}
+
+				var unsupported ErrUnsupportedType
+				if tc.wantNil && !errors.Is(err, ErrNilValue) {
+					t.Errorf("panic %v is not ErrNilValue", err)
+				} else if !tc.wantNil && (!errors.As(err, &unsupported) || unsupported.Kind != tc.wantKind) {
+					t.Errorf("panic %v is not ErrUnsupportedType{Kind: %v}", err, tc.wantKind)
+				}
+			}()
+			tc.fn()
+		})
+	}
+}
+
+func TestErrorsAdd(t *testing.T) {
+	var errs Errors
+	errs.Add("name", true, "is required")
+	if errs.HasAny() {
+		t.Fatalf("HasAny() = true after a successful check, want false")
+	}
+
+	errs.Add("email", false, "must be a valid email")
+	errs.Add("name", false, "is required")
+	if !errs.HasAny() {
+		t.Fatalf("HasAny() = false after a failed check, want true")
+	}
+	if len(errs) != 2 || errs[0].Field != "email" || errs[1].Field != "name" {
+		t.Errorf("Add kept %v, want the email and name failures in order", errs)
+	}
+}
+
+func TestErrorsAddIf(t *testing.T) {
+	testCases := []struct {
+		name  string
+		rule  Rule
+		value any
+		want  bool
+	}{
+		{name: "Valid", rule: IsEmail, value: "test@example.com", want: false},
+		{name: "Invalid", rule: IsEmail, value: "invalid", want: true},
+		{name: "Panic", rule: IsEmail, value: nil, want: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var errs Errors
+			errs.AddIf("email", tc.rule, tc.value, "must be a valid email")
+			if got := errs.HasAny(); got != tc.want {
+				t.Errorf("AddIf(%v).HasAny() = %v, want %v", tc.value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestErrorsError(t *testing.T) {
+	var errs Errors
+	if got := errs.Error(); got != "" {
+		t.Errorf("Error() = %q on an empty collection, want empty", got)
+	}
+
+	errs.Add("email", false, "must be a valid email")
+	errs.Add("name", false, "is required")
+	want := "email: must be a valid email; name: is required"
+	if got := errs.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	var err error = errs
+	var target Errors
+	if !errors.As(err, &target) || len(target) != 2 {
+		t.Errorf("errors.As(%v) did not return the collection", err)
+	}
+}
+
+func TestErrorsMarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name string
+		errs Errors
+		want string
+	}{
+		{name: "Empty", errs: nil, want: `{}`},
+		{
+			name: "OneField",
+			errs: Errors{{Field: "email", Message: "must be a valid email"}},
+			want: `{"email":["must be a valid email"]}`,
+		},
+		{
+			name: "GroupedFields",
+			errs: Errors{
+				{Field: "name", Message: "is required"},
+				{Field: "email", Message: "must be a valid email"},
+				{Field: "email", Message: "is too long"},
+			},
+			want: `{"email":["must be a valid email","is too long"],"name":["is required"]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := json.Marshal(tc.errs)
+			if err != nil {
+				t.Fatalf("json.Marshal(%v) returned error %v", tc.errs, err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("json.Marshal(%v) = %s, want %s", tc.errs, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// arithmeticParser is a recursive descent parser and evaluator of simple calculator expressions.
+type arithmeticParser struct {
+	s       string
+	pos     int
+	nesting int
+}
+
+// arithmeticMaxNesting is the maximum number of unary signs and parentheses that may be nested in an arithmetic
+// expression, which bounds the recursion of the parser.
+const arithmeticMaxNesting = 256
+
+// IsArithmeticExpression checks if a given value is a valid arithmetic expression made of decimal numbers, the
+// binary operators +, -, * and /, unary signs and parentheses, such as "(price * 1.1) - 5". Whitespace between
+// tokens is ignored, and expressions that nest signs and parentheses more than 256 levels deep are rejected. Only
+// the syntax is checked, so "1 / 0" is a valid expression.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an arithmetic expression.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid arithmetic expression.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsArithmeticExpression("(10 * 1.1) - 5")) // true
+//	fmt.Println(IsArithmeticExpression("-2 * (3 + 4)"))   // true
+//	fmt.Println(IsArithmeticExpression("10 * "))          // false
+//	fmt.Println(IsArithmeticExpression("(1 + 2"))         // false
+func IsArithmeticExpression(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsArithmeticExpression", time.Now(), &passed)
+	}
+	_, ok := evaluateArithmetic(toString(a))
+	return ok
+}
+
+// EvaluatesWithinRange checks if a given value is a valid arithmetic expression (see IsArithmeticExpression)
+// whose result, evaluated with the usual operator precedence, is between lo and hi, inclusive. Expressions that
+// divide by zero are rejected.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and evaluated as an arithmetic expression.
+//   - lo: The lowest accepted result.
+//   - hi: The highest accepted result.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the expression evaluates within the range.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(EvaluatesWithinRange("2 + 3 * 4", 0, 20))   // true
+//	fmt.Println(EvaluatesWithinRange("(2 + 3) * 4", 0, 15)) // false
+//	fmt.Println(EvaluatesWithinRange("1 / 0", 0, 100))      // false
+func EvaluatesWithinRange(a any, lo, hi float64) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("EvaluatesWithinRange", time.Now(), &passed)
+	}
+	result, ok := evaluateArithmetic(toString(a))
+	return ok && !math.IsNaN(result) && !math.IsInf(result, 0) && result >= lo && result <= hi
+}
+
+// evaluateArithmetic parses and evaluates the whole expression, failing on syntax errors.
+func evaluateArithmetic(s string) (float64, bool) {
+	p := &arithmeticParser{s: s}
+	result, ok := p.parseExpression()
+	p.skipSpaces()
+	return result, ok && p.pos == len(p.s)
+}
+
+// parseExpression parses terms joined by + and -.
+func (p *arithmeticParser) parseExpression() (float64, bool) {
+	result, ok := p.parseTerm()
+	for ok {
+		p.skipSpaces()
+		if p.consume('+') {
+			var term float64
+			term, ok = p.parseTerm()
+			result += term
+		} else if p.consume('-') {
+			var term float64
+			term, ok = p.parseTerm()
+			result -= term
+		} else {
+			break
+		}
+	}
+	return result, ok
+}
+
+// parseTerm parses factors joined by * and /.
+func (p *arithmeticParser) parseTerm() (float64, bool) {
+	result, ok := p.parseFactor()
+	for ok {
+		p.skipSpaces()
+		if p.consume('*') {
+			var factor float64
+			factor, ok = p.parseFactor()
+			result *= factor
+		} else if p.consume('/') {
+			var factor float64
+			factor, ok = p.parseFactor()
+			result /= factor
+		} else {
+			break
+		}
+	}
+	return result, ok
+}
+
+// parseFactor parses a signed factor, a number or a parenthesized expression.
+func (p *arithmeticParser) parseFactor() (float64, bool) {
+	p.skipSpaces()
+	if p.pos < len(p.s) && strings.IndexByte("+-(", p.s[p.pos]) >= 0 {
+		if !p.nest() {
+			return 0, false
+		}
+		defer p.unnest()
+	}
+
+	switch {
+	case p.consume('+'):
+		return p.parseFactor()
+	case p.consume('-'):
+		result, ok := p.parseFactor()
+		return -result, ok
+	case p.consume('('):
+		result, ok := p.parseExpression()
+		p.skipSpaces()
+		return result, ok && p.consume(')')
+	}
+
+	start := p.pos
+	for p.pos < len(p.s) && (p.s[p.pos] >= '0' && p.s[p.pos] <= '9' || p.s[p.pos] == '.') {
+		p.pos++
+	}
+	number := p.s[start:p.pos]
+	if number == "" || number[0] == '.' || number[len(number)-1] == '.' {
+		return 0, false
+	}
+	result, err := strconv.ParseFloat(number, 64)
+	return result, err == nil
+}
+
+// nest enters a signed factor or a parenthesized expression, failing if it is deeper than arithmeticMaxNesting.
+func (p *arithmeticParser) nest() bool {
+	p.nesting++
+	return p.nesting <= arithmeticMaxNesting
+}
+
+// unnest leaves a signed factor or a parenthesized expression.
+func (p *arithmeticParser) unnest() {
+	p.nesting--
+}
+
+// consume consumes the given character if it is the next one.
+func (p *arithmeticParser) consume(c byte) bool {
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// skipSpaces skips any whitespace at the current position.
+func (p *arithmeticParser) skipSpaces() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t') {
+		p.pos++
+	}
+}
@@ -0,0 +1,86 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsArithmeticExpression(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: "1 + 2", want: true},
+		{name: "Precedence", arg: "(10 * 1.1) - 5", want: true},
+		{name: "UnarySign", arg: "-2 * (3 + 4)", want: true},
+		{name: "NestedParentheses", arg: "((1))", want: true},
+		{name: "NestedParenthesesLimit", arg: strings.Repeat("(", 255) + "1" + strings.Repeat(")", 255), want: true},
+		{name: "NoSpaces", arg: "2*3/4-1", want: true},
+		{name: "DivisionByZero", arg: "1 / 0", want: true},
+		{name: "Number", arg: 42, want: true},
+		{name: "TrailingOperator", arg: "10 * ", want: false},
+		{name: "Unbalanced", arg: "(1 + 2", want: false},
+		{name: "ExtraClose", arg: "1 + 2)", want: false},
+		{name: "DoubleOperator", arg: "1 * / 2", want: false},
+		{name: "Variable", arg: "price * 2", want: false},
+		{name: "TrailingDot", arg: "1. + 2", want: false},
+		{name: "TwoDots", arg: "1.2.3", want: false},
+		{name: "Power", arg: "2 ^ 3", want: false},
+		{name: "EmptyParentheses", arg: "()", want: false},
+		{name: "DeepParentheses", arg: strings.Repeat("(", 300) + "1" + strings.Repeat(")", 300), want: false},
+		{name: "DeepUnbalancedParentheses", arg: strings.Repeat("(", 2000000), want: false},
+		{name: "DeepSigns", arg: strings.Repeat("-", 2000000) + "1", want: false},
+		{name: "ManySequentialParentheses", arg: strings.Repeat("(1) + ", 1000) + "1", want: true},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsArithmeticExpression(tc.arg); got != tc.want {
+				t.Errorf("IsArithmeticExpression(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEvaluatesWithinRange(t *testing.T) {
+	testCases := []struct {
+		name  string
+		arg   any
+		lo    float64
+		hi    float64
+		want  bool
+		panic bool
+	}{
+		{name: "Precedence", arg: "2 + 3 * 4", lo: 0, hi: 14, want: true},
+		{name: "Parentheses", arg: "(2 + 3) * 4", lo: 0, hi: 15, want: false},
+		{name: "LowerBound", arg: "10 - 15", lo: -5, hi: 0, want: true},
+		{name: "BelowRange", arg: "-2 * 3", lo: -5, hi: 0, want: false},
+		{name: "LeftAssociative", arg: "8 / 4 / 2", lo: 1, hi: 1, want: true},
+		{name: "DivisionByZero", arg: "1 / 0", lo: 0, hi: 100, want: false},
+		{name: "ZeroByZero", arg: "0 / 0", lo: -100, hi: 100, want: false},
+		{name: "Invalid", arg: "1 +", lo: 0, hi: 100, want: false},
+		{name: "Nil", arg: nil, lo: 0, hi: 100, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := EvaluatesWithinRange(tc.arg, tc.lo, tc.hi); got != tc.want {
+				t.Errorf("EvaluatesWithinRange(%v, %v, %v) = %v, want %v", tc.arg, tc.lo, tc.hi, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// DateFieldAfter checks whether the time held by the struct field named 'fieldA' is after the time held by the
+// struct field named 'fieldB'. Both field values are converted with the toTime function, so fields declared as
+// strings, numeric timestamps or time.Time are compared uniformly.
+//
+// Parameters:
+//   - a: The struct, or pointer to struct, holding both fields.
+//   - fieldA: The name of the field expected to hold the later time, for example "EndDate".
+//   - fieldB: The name of the field expected to hold the earlier time, for example "StartDate".
+//
+// Returns:
+//   - bool: A boolean value indicating whether the time in 'fieldA' is after the time in 'fieldB'.
+//
+// Panic:
+//   - The function will panic if 'a' is nil or not a struct, if any field does not exist or is nil,
+//     or if any field value cannot be converted to a time.Time.
+//
+// Example:
+//
+//	booking := struct {
+//		StartDate string
+//		EndDate   time.Time
+//	}{StartDate: "2024-01-10", EndDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)}
+//	fmt.Println(DateFieldAfter(booking, "EndDate", "StartDate")) // true
+//	fmt.Println(DateFieldAfter(booking, "StartDate", "EndDate")) // false
+func DateFieldAfter(a any, fieldA, fieldB string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("DateFieldAfter", time.Now(), &passed)
+	}
+	return isAfter(structFieldValue(a, fieldA), structFieldValue(a, fieldB))
+}
+
+// DateFieldBefore checks whether the time held by the struct field named 'fieldA' is before the time held by the
+// struct field named 'fieldB'. It is the counterpart of DateFieldAfter and follows the same conversion rules.
+//
+// Parameters:
+//   - a: The struct, or pointer to struct, holding both fields.
+//   - fieldA: The name of the field expected to hold the earlier time.
+//   - fieldB: The name of the field expected to hold the later time.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the time in 'fieldA' is before the time in 'fieldB'.
+//
+// Panic:
+//   - The function will panic if 'a' is nil or not a struct, if any field does not exist or is nil,
+//     or if any field value cannot be converted to a time.Time.
+//
+// Example:
+//
+//	booking := struct {
+//		StartDate string
+//		EndDate   string
+//	}{StartDate: "2024-01-10", EndDate: "2024-01-15"}
+//	fmt.Println(DateFieldBefore(booking, "StartDate", "EndDate")) // true
+func DateFieldBefore(a any, fieldA, fieldB string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("DateFieldBefore", time.Now(), &passed)
+	}
+	return isBefore(structFieldValue(a, fieldA), structFieldValue(a, fieldB))
+}
+
+// NumericFieldLessThan checks whether the number held by the struct field named 'fieldA' is less than the number
+// held by the struct field named 'fieldB'. Both field values are converted with the toFloat function, so numeric
+// strings and any numeric type are compared uniformly.
+//
+// Parameters:
+//   - a: The struct, or pointer to struct, holding both fields.
+//   - fieldA: The name of the field expected to hold the smaller number, for example "MinPrice".
+//   - fieldB: The name of the field expected to hold the greater number, for example "MaxPrice".
+//
+// Returns:
+//   - bool: A boolean value indicating whether the number in 'fieldA' is less than the number in 'fieldB'.
+//
+// Panic:
+//   - The function will panic if 'a' is nil or not a struct, if any field does not exist or is nil,
+//     or if any field value cannot be converted to a float64.
+//
+// Example:
+//
+//	filter := struct {
+//		MinPrice int
+//		MaxPrice string
+//	}{MinPrice: 10, MaxPrice: "20.5"}
+//	fmt.Println(NumericFieldLessThan(filter, "MinPrice", "MaxPrice")) // true
+func NumericFieldLessThan(a any, fieldA, fieldB string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NumericFieldLessThan", time.Now(), &passed)
+	}
+	return isLessThan(structFieldValue(a, fieldA), structFieldValue(a, fieldB))
+}
+
+// NumericFieldGreaterThan checks whether the number held by the struct field named 'fieldA' is greater than the
+// number held by the struct field named 'fieldB'. It is the counterpart of NumericFieldLessThan and follows the
+// same conversion rules.
+//
+// Parameters:
+//   - a: The struct, or pointer to struct, holding both fields.
+//   - fieldA: The name of the field expected to hold the greater number.
+//   - fieldB: The name of the field expected to hold the smaller number.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the number in 'fieldA' is greater than the number in 'fieldB'.
+//
+// Panic:
+//   - The function will panic if 'a' is nil or not a struct, if any field does not exist or is nil,
+//     or if any field value cannot be converted to a float64.
+//
+// Example:
+//
+//	filter := struct {
+//		MinPrice int
+//		MaxPrice float64
+//	}{MinPrice: 10, MaxPrice: 20.5}
+//	fmt.Println(NumericFieldGreaterThan(filter, "MaxPrice", "MinPrice")) // true
+func NumericFieldGreaterThan(a any, fieldA, fieldB string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NumericFieldGreaterThan", time.Now(), &passed)
+	}
+	return isGreaterThan(structFieldValue(a, fieldA), structFieldValue(a, fieldB))
+}
+
+// structFieldValue returns the value of the exported field named 'field' of the struct 'a', dereferencing
+// pointers to the struct and to the field value.
+// If 'a' is nil, it panics with ErrNilValue and if it is not a struct, with an ErrUnsupportedType.
+// If the field does not exist or is nil, it panics with a formatted message.
+func structFieldValue(a any, field string) any {
+	if isNil(a) {
+		panic(ErrNilValue)
+	}
+
+	reflectValue := reflect.ValueOf(a)
+	for reflectValue.Kind() == reflect.Ptr || reflectValue.Kind() == reflect.Interface {
+		reflectValue = reflectValue.Elem()
+	}
+	if reflectValue.Kind() != reflect.Struct {
+		panic(ErrUnsupportedType{Kind: reflectValue.Kind()})
+	}
+
+	fieldValue := reflectValue.FieldByName(field)
+	if !fieldValue.IsValid() {
+		panic(fmt.Sprintf("Field %s not found in %s", field, reflectValue.Type().String()))
+	}
+	for fieldValue.Kind() == reflect.Ptr || fieldValue.Kind() == reflect.Interface {
+		if fieldValue.IsNil() {
+			panic(fmt.Sprintf("Field %s is nil", field))
+		}
+		fieldValue = fieldValue.Elem()
+	}
+	return fieldValue.Interface()
+}
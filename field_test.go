@@ -0,0 +1,145 @@
+package checker
+
+import (
+	"testing"
+	"time"
+)
+
+type fieldCase struct {
+	name   string
+	arg    any
+	fieldA string
+	fieldB string
+	want   bool
+	panic  bool
+}
+
+type dateFieldsStruct struct {
+	StartString string
+	EndString   string
+	StartTime   time.Time
+	EndTime     *time.Time
+	StartMilli  int64
+	NilTime     *time.Time
+	private     string
+}
+
+type numericFieldsStruct struct {
+	Min       int
+	Max       float64
+	MaxString string
+	Invalid   string
+}
+
+func TestDateFieldAfter(t *testing.T) {
+	end := time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)
+	s := dateFieldsStruct{
+		StartString: "2024-01-10",
+		EndString:   "2024-01-15",
+		StartTime:   time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC),
+		EndTime:     &end,
+		StartMilli:  time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC).UnixMilli(),
+	}
+
+	tests := []fieldCase{
+		{name: "Strings", arg: s, fieldA: "EndString", fieldB: "StartString", want: true},
+		{name: "StringsReversed", arg: s, fieldA: "StartString", fieldB: "EndString", want: false},
+		{name: "TimeAndPointer", arg: s, fieldA: "EndTime", fieldB: "StartTime", want: true},
+		{name: "MixedTypes", arg: &s, fieldA: "EndString", fieldB: "StartMilli", want: true},
+		{name: "SameField", arg: s, fieldA: "EndString", fieldB: "EndString", want: false},
+		{name: "MissingField", arg: s, fieldA: "Missing", fieldB: "StartString", panic: true},
+		{name: "NilField", arg: s, fieldA: "NilTime", fieldB: "StartString", panic: true},
+		{name: "UnexportedField", arg: s, fieldA: "private", fieldB: "StartString", panic: true},
+		{name: "NotStruct", arg: "text", fieldA: "A", fieldB: "B", panic: true},
+		{name: "Nil", arg: nil, fieldA: "A", fieldB: "B", panic: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := DateFieldAfter(tt.arg, tt.fieldA, tt.fieldB); got != tt.want {
+				t.Errorf("DateFieldAfter() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateFieldBefore(t *testing.T) {
+	s := dateFieldsStruct{StartString: "2024-01-10", EndString: "2024-01-15"}
+
+	tests := []fieldCase{
+		{name: "Before", arg: s, fieldA: "StartString", fieldB: "EndString", want: true},
+		{name: "After", arg: s, fieldA: "EndString", fieldB: "StartString", want: false},
+		{name: "InvalidTime", arg: numericFieldsStruct{Invalid: "x"}, fieldA: "Invalid", fieldB: "Invalid", panic: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := DateFieldBefore(tt.arg, tt.fieldA, tt.fieldB); got != tt.want {
+				t.Errorf("DateFieldBefore() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumericFieldLessThan(t *testing.T) {
+	s := numericFieldsStruct{Min: 10, Max: 20.5, MaxString: "20.5", Invalid: "abc"}
+
+	tests := []fieldCase{
+		{name: "IntAndFloat", arg: s, fieldA: "Min", fieldB: "Max", want: true},
+		{name: "IntAndString", arg: &s, fieldA: "Min", fieldB: "MaxString", want: true},
+		{name: "Reversed", arg: s, fieldA: "Max", fieldB: "Min", want: false},
+		{name: "Equal", arg: s, fieldA: "Max", fieldB: "MaxString", want: false},
+		{name: "InvalidNumber", arg: s, fieldA: "Invalid", fieldB: "Max", panic: true},
+		{name: "MissingField", arg: s, fieldA: "Min", fieldB: "Missing", panic: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := NumericFieldLessThan(tt.arg, tt.fieldA, tt.fieldB); got != tt.want {
+				t.Errorf("NumericFieldLessThan() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumericFieldGreaterThan(t *testing.T) {
+	s := numericFieldsStruct{Min: 10, Max: 20.5, MaxString: "20.5"}
+
+	tests := []fieldCase{
+		{name: "Greater", arg: s, fieldA: "Max", fieldB: "Min", want: true},
+		{name: "StringGreater", arg: s, fieldA: "MaxString", fieldB: "Min", want: true},
+		{name: "Less", arg: s, fieldA: "Min", fieldB: "Max", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NumericFieldGreaterThan(tt.arg, tt.fieldA, tt.fieldB); got != tt.want {
+				t.Errorf("NumericFieldGreaterThan() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// IsValidFileName checks whether the given value, converted to a string, is a file name accepted on Windows, Linux
+// and macOS alike. The name must not be empty, "." or "..", must have at most 255 bytes, must not contain path
+// separators, control characters or any of the characters <>:"/\|?*, must not end with a dot or a space and
+// must not be a reserved Windows device name (CON, PRN, AUX, NUL, COM1-COM9 and LPT1-LPT9), with or without
+// extension and regardless of case.
+//
+// Parameters:
+//   - a: Any value to be checked as a file name.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a portable file name.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsValidFileName("report-2024.pdf")) // true
+//	fmt.Println(IsValidFileName("../etc/passwd"))   // false
+//	fmt.Println(IsValidFileName("CON.txt"))         // false
+//	fmt.Println(IsValidFileName("notes."))          // false
+func IsValidFileName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsValidFileName", time.Now(), &passed)
+	}
+	return isValidFileName(a)
+}
+
+// isValidFileName is the unobserved implementation of IsValidFileName.
+func isValidFileName(a any) bool {
+	s := toString(a)
+	if len(s) == 0 || len(s) > 255 || s == "." || s == ".." ||
+		strings.HasSuffix(s, ".") || strings.HasSuffix(s, " ") {
+		return false
+	}
+
+	if strings.ContainsFunc(s, func(r rune) bool {
+		return r < 32 || r == 127 || strings.ContainsRune(`<>:"/\|?*`, r)
+	}) {
+		return false
+	}
+
+	regex := regexp.MustCompile(`(?i)^(CON|PRN|AUX|NUL|COM[1-9]|LPT[1-9])(\..*)?$`)
+	return !regex.MatchString(s)
+}
+
+// IsHiddenFileName checks whether the given value, converted to a string, is a valid file name according to
+// IsValidFileName that is hidden by Unix conventions, that is, starting with a dot.
+//
+// Parameters:
+//   - a: Any value to be checked as a hidden file name.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid hidden file name.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsHiddenFileName(".env"))     // true
+//	fmt.Println(IsHiddenFileName("file.txt")) // false
+//	fmt.Println(IsHiddenFileName(".."))       // false
+func IsHiddenFileName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsHiddenFileName", time.Now(), &passed)
+	}
+	s := toString(a)
+	return strings.HasPrefix(s, ".") && isValidFileName(s)
+}
@@ -0,0 +1,80 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsValidFileName(t *testing.T) {
+	name := "photo.png"
+	testCases := []baseCase{
+		{name: "Simple", arg: "report-2024.pdf", want: true},
+		{name: "WithSpaces", arg: "my report.pdf", want: true},
+		{name: "Unicode", arg: "relatório_ção.docx", want: true},
+		{name: "NoExtension", arg: "README", want: true},
+		{name: "Hidden", arg: ".gitignore", want: true},
+		{name: "Pointer", arg: &name, want: true},
+		{name: "Numeric", arg: 2024, want: true},
+		{name: "MaxLength", arg: strings.Repeat("a", 255), want: true},
+		{name: "TooLong", arg: strings.Repeat("a", 256), want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Dot", arg: ".", want: false},
+		{name: "DotDot", arg: "..", want: false},
+		{name: "PathTraversal", arg: "../etc/passwd", want: false},
+		{name: "Slash", arg: "dir/file.txt", want: false},
+		{name: "Backslash", arg: `dir\file.txt`, want: false},
+		{name: "Colon", arg: "file:name.txt", want: false},
+		{name: "Asterisk", arg: "file*.txt", want: false},
+		{name: "Question", arg: "file?.txt", want: false},
+		{name: "Quote", arg: `file".txt`, want: false},
+		{name: "Pipe", arg: "file|name", want: false},
+		{name: "AngleBrackets", arg: "<file>", want: false},
+		{name: "NullByte", arg: "file\x00.txt", want: false},
+		{name: "ControlChar", arg: "file\n.txt", want: false},
+		{name: "TrailingDot", arg: "notes.", want: false},
+		{name: "TrailingSpace", arg: "notes ", want: false},
+		{name: "ReservedCON", arg: "CON", want: false},
+		{name: "ReservedLowercase", arg: "nul", want: false},
+		{name: "ReservedWithExtension", arg: "com1.txt", want: false},
+		{name: "ReservedLPT9", arg: "LPT9.log", want: false},
+		{name: "ReservedPrefixOnly", arg: "CONSOLE.txt", want: true},
+		{name: "COM0NotReserved", arg: "COM0", want: true},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsValidFileName(tc.arg); got != tc.want {
+				t.Errorf("IsValidFileName(%q) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsHiddenFileName(t *testing.T) {
+	testCases := []baseCase{
+		{name: "DotFile", arg: ".env", want: true},
+		{name: "DotFileWithExtension", arg: ".config.yaml", want: true},
+		{name: "RegularFile", arg: "file.txt", want: false},
+		{name: "Dot", arg: ".", want: false},
+		{name: "DotDot", arg: "..", want: false},
+		{name: "InvalidHidden", arg: ".env*", want: false},
+		{name: "Empty", arg: "", want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsHiddenFileName(tc.arg); got != tc.want {
+				t.Errorf("IsHiddenFileName(%q) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
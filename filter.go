@@ -0,0 +1,515 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// rsqlParser is a recursive descent parser of RSQL/FIQL expressions that only checks their structure.
+type rsqlParser struct {
+	s       string
+	pos     int
+	nesting int
+}
+
+// odataToken is a token of an OData $filter expression.
+type odataToken struct {
+	kind  byte
+	value string
+}
+
+// odataParser is a recursive descent parser of OData $filter expressions that only checks their structure.
+type odataParser struct {
+	tokens  []odataToken
+	pos     int
+	nesting int
+}
+
+// filterMaxNesting is the maximum number of groups, function calls, lambdas and unary operators that may be
+// nested in an RSQL or OData filter expression, which bounds the recursion of the parsers.
+const filterMaxNesting = 256
+
+const (
+	odataIdentifier = 'i'
+	odataLiteral    = 'l'
+	odataSymbol     = 's'
+)
+
+// IsRSQLExpression checks if a given value is a syntactically valid RSQL (or FIQL) filter expression, such as
+// `name=="Kill Bill";year=gt=2003` or `genres=in=(sci-fi,action),director=="Christopher Nolan"`. Comparisons
+// are made of a selector, an operator (==, !=, <, <=, >, >= or a custom one like =gt=, =in= or =out=) and a
+// value or a parenthesized list of values, which may be double or single quoted. They are combined with ';' or
+// "and" and with ',' or "or", and may be grouped with parentheses, up to 256 levels deep. Only the structure is
+// checked, not the selectors or the values.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an RSQL expression.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid RSQL expression.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsRSQLExpression(`name=="Kill Bill";year=gt=2003`))         // true
+//	fmt.Println(IsRSQLExpression("genres=in=(sci-fi,action) or year<2000")) // true
+//	fmt.Println(IsRSQLExpression("name==;year=gt=2003"))                    // false
+//	fmt.Println(IsRSQLExpression("(name==Bill"))                            // false
+func IsRSQLExpression(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsRSQLExpression", time.Now(), &passed)
+	}
+	p := &rsqlParser{s: toString(a)}
+	if !p.parseOr() {
+		return false
+	}
+	p.skipSpaces()
+	return p.pos == len(p.s)
+}
+
+// IsODataFilterExpression checks if a given value is a syntactically valid OData $filter expression, such as
+// "Price le 200 and contains(Name, 'milk')" or "Tags/any(t: t eq 'sale')". Logical (and, or, not), comparison
+// (eq, ne, gt, ge, lt, le, has, in) and arithmetic (add, sub, mul, div, divby, mod) operators, parentheses,
+// function calls, property paths, the any and all lambda operators and the usual literals are accepted, and
+// expressions that nest them or the unary operators more than 256 levels deep are rejected. Only the structure
+// is checked, not the properties, the functions or the types of the operands.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an OData filter.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid OData filter expression.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsODataFilterExpression("Price le 200 and contains(Name, 'milk')")) // true
+//	fmt.Println(IsODataFilterExpression("Tags/any(t: t eq 'sale')"))                // true
+//	fmt.Println(IsODataFilterExpression("Price le"))                                // false
+//	fmt.Println(IsODataFilterExpression("Name eq 'milk"))                           // false
+func IsODataFilterExpression(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsODataFilterExpression", time.Now(), &passed)
+	}
+	tokens, ok := tokenizeOData(toString(a))
+	if !ok || len(tokens) == 0 {
+		return false
+	}
+	p := &odataParser{tokens: tokens}
+	return p.parseOr() && p.pos == len(p.tokens)
+}
+
+// parseOr parses constraints joined by ',' or "or".
+func (p *rsqlParser) parseOr() bool {
+	if !p.parseAnd() {
+		return false
+	}
+	for p.consumeOperator(',', "or") {
+		if !p.parseAnd() {
+			return false
+		}
+	}
+	return true
+}
+
+// parseAnd parses constraints joined by ';' or "and".
+func (p *rsqlParser) parseAnd() bool {
+	if !p.parseConstraint() {
+		return false
+	}
+	for p.consumeOperator(';', "and") {
+		if !p.parseConstraint() {
+			return false
+		}
+	}
+	return true
+}
+
+// parseConstraint parses a parenthesized group or a comparison.
+func (p *rsqlParser) parseConstraint() bool {
+	p.skipSpaces()
+	if p.consume('(') {
+		if !p.nest() {
+			return false
+		}
+		defer p.unnest()
+		if !p.parseOr() {
+			return false
+		}
+		p.skipSpaces()
+		return p.consume(')')
+	}
+
+	if p.parseUnreserved() == "" {
+		return false
+	}
+	regex := regexp.MustCompile(`^(==|!=|=[a-z]*=|<=|>=|<|>)`)
+	operator := regex.FindString(p.s[p.pos:])
+	if operator == "" {
+		return false
+	}
+	p.pos += len(operator)
+
+	if p.consume('(') {
+		for {
+			if !p.parseValue() {
+				return false
+			} else if !p.consume(',') {
+				break
+			}
+		}
+		return p.consume(')')
+	}
+	return p.parseValue()
+}
+
+// parseValue parses an unreserved or a quoted value.
+func (p *rsqlParser) parseValue() bool {
+	if p.pos < len(p.s) && (p.s[p.pos] == '"' || p.s[p.pos] == '\'') {
+		quote := p.s[p.pos]
+		for i := p.pos + 1; i < len(p.s); i++ {
+			if p.s[i] == '\\' {
+				i++
+			} else if p.s[i] == quote {
+				p.pos = i + 1
+				return true
+			}
+		}
+		return false
+	}
+	return p.parseUnreserved() != ""
+}
+
+// parseUnreserved parses and returns a run of characters that are not whitespace or reserved by RSQL.
+func (p *rsqlParser) parseUnreserved() string {
+	start := p.pos
+	for p.pos < len(p.s) && !unicode.IsSpace(rune(p.s[p.pos])) && !strings.ContainsRune(`"'();,=!~<>`, rune(p.s[p.pos])) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// consumeOperator consumes the given logical operator symbol, or its keyword surrounded by whitespace.
+func (p *rsqlParser) consumeOperator(symbol byte, keyword string) bool {
+	start := p.pos
+	p.skipSpaces()
+	if p.consume(symbol) {
+		return true
+	}
+
+	if p.pos > start && strings.HasPrefix(p.s[p.pos:], keyword) {
+		end := p.pos + len(keyword)
+		if end < len(p.s) && unicode.IsSpace(rune(p.s[end])) {
+			p.pos = end
+			return true
+		}
+	}
+	p.pos = start
+	return false
+}
+
+// nest enters a parenthesized group, failing if it is deeper than filterMaxNesting.
+func (p *rsqlParser) nest() bool {
+	p.nesting++
+	return p.nesting <= filterMaxNesting
+}
+
+// unnest leaves a parenthesized group.
+func (p *rsqlParser) unnest() {
+	p.nesting--
+}
+
+// consume consumes the given character if it is the next one.
+func (p *rsqlParser) consume(c byte) bool {
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// skipSpaces skips any whitespace at the current position.
+func (p *rsqlParser) skipSpaces() {
+	for p.pos < len(p.s) && unicode.IsSpace(rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+// tokenizeOData splits an OData $filter expression into identifiers, literals and symbols, failing on unknown
+// characters and unterminated strings.
+func tokenizeOData(s string) ([]odataToken, bool) {
+	identifier := regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*`)
+	number := regexp.MustCompile(`^[0-9][0-9A-Za-z.:+-]*`)
+
+	var tokens []odataToken
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case strings.IndexByte("(),/:-", c) >= 0:
+			tokens = append(tokens, odataToken{kind: odataSymbol, value: string(c)})
+			i++
+		case c == '\'':
+			end, ok := odataStringEnd(s, i)
+			if !ok {
+				return nil, false
+			}
+			tokens = append(tokens, odataToken{kind: odataLiteral, value: s[i:end]})
+			i = end
+		case number.MatchString(s[i:]):
+			value := number.FindString(s[i:])
+			tokens = append(tokens, odataToken{kind: odataLiteral, value: value})
+			i += len(value)
+		case identifier.MatchString(s[i:]):
+			value := identifier.FindString(s[i:])
+			i += len(value)
+			if i < len(s) && s[i] == '\'' {
+				end, ok := odataStringEnd(s, i)
+				if !ok {
+					return nil, false
+				}
+				tokens = append(tokens, odataToken{kind: odataLiteral, value: value + s[i:end]})
+				i = end
+			} else {
+				tokens = append(tokens, odataToken{kind: odataIdentifier, value: value})
+			}
+		default:
+			return nil, false
+		}
+	}
+	return tokens, true
+}
+
+// odataStringEnd returns the position after the string literal that starts at 'start', where quotes are escaped
+// by doubling them.
+func odataStringEnd(s string, start int) (int, bool) {
+	for i := start + 1; i < len(s); i++ {
+		if s[i] == '\'' {
+			if i+1 < len(s) && s[i+1] == '\'' {
+				i++
+				continue
+			}
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// parseOr parses expressions joined by "or".
+func (p *odataParser) parseOr() bool {
+	if !p.nest() {
+		return false
+	}
+	defer p.unnest()
+	if !p.parseAnd() {
+		return false
+	}
+	for p.consumeKeyword("or") {
+		if !p.parseAnd() {
+			return false
+		}
+	}
+	return true
+}
+
+// parseAnd parses expressions joined by "and".
+func (p *odataParser) parseAnd() bool {
+	if !p.parseNot() {
+		return false
+	}
+	for p.consumeKeyword("and") {
+		if !p.parseNot() {
+			return false
+		}
+	}
+	return true
+}
+
+// parseNot parses an optionally negated comparison.
+func (p *odataParser) parseNot() bool {
+	if p.consumeKeyword("not") {
+		if !p.nest() {
+			return false
+		}
+		defer p.unnest()
+		return p.parseNot()
+	}
+	return p.parseComparison()
+}
+
+// parseComparison parses an arithmetic expression optionally compared with another one.
+func (p *odataParser) parseComparison() bool {
+	if !p.parseArithmetic() {
+		return false
+	}
+	for _, operator := range []string{"eq", "ne", "gt", "ge", "lt", "le", "has", "in"} {
+		if p.consumeKeyword(operator) {
+			return p.parseArithmetic()
+		}
+	}
+	return true
+}
+
+// parseArithmetic parses operands joined by arithmetic operators.
+func (p *odataParser) parseArithmetic() bool {
+	if !p.parseUnary() {
+		return false
+	}
+	for {
+		matched := false
+		for _, operator := range []string{"add", "sub", "mul", "div", "divby", "mod"} {
+			if p.consumeKeyword(operator) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return true
+		} else if !p.parseUnary() {
+			return false
+		}
+	}
+}
+
+// parseUnary parses an optionally negated operand.
+func (p *odataParser) parseUnary() bool {
+	if p.consumeSymbol("-") {
+		if !p.nest() {
+			return false
+		}
+		defer p.unnest()
+		return p.parseUnary()
+	}
+	return p.parsePrimary()
+}
+
+// parsePrimary parses a parenthesized expression or list, a literal, a function call or a property path.
+func (p *odataParser) parsePrimary() bool {
+	if p.pos >= len(p.tokens) {
+		return false
+	}
+
+	token := p.tokens[p.pos]
+	switch {
+	case token.kind == odataSymbol && token.value == "(":
+		p.pos++
+		return p.parseList() && p.consumeSymbol(")")
+	case token.kind == odataLiteral:
+		p.pos++
+		return true
+	case token.kind == odataIdentifier && isODataOperator(token.value):
+		return false
+	case token.kind == odataIdentifier:
+		p.pos++
+		if p.consumeSymbol("(") {
+			return (p.consumeSymbol(")")) || (p.parseList() && p.consumeSymbol(")"))
+		}
+		return p.parsePath()
+	}
+	return false
+}
+
+// parsePath parses the rest of a property path, including the any and all lambda operators.
+func (p *odataParser) parsePath() bool {
+	for p.consumeSymbol("/") {
+		if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != odataIdentifier {
+			return false
+		}
+		segment := p.tokens[p.pos].value
+		p.pos++
+		if (segment == "any" || segment == "all") && p.consumeSymbol("(") {
+			if p.consumeSymbol(")") {
+				return segment == "any"
+			}
+			if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != odataIdentifier {
+				return false
+			}
+			p.pos++
+			return p.consumeSymbol(":") && p.parseOr() && p.consumeSymbol(")")
+		}
+	}
+	return true
+}
+
+// parseList parses one or more expressions separated by commas.
+func (p *odataParser) parseList() bool {
+	for {
+		if !p.parseOr() {
+			return false
+		} else if !p.consumeSymbol(",") {
+			return true
+		}
+	}
+}
+
+// nest enters a nested expression or unary operator, failing if it is deeper than filterMaxNesting.
+func (p *odataParser) nest() bool {
+	p.nesting++
+	return p.nesting <= filterMaxNesting
+}
+
+// unnest leaves a nested expression or unary operator.
+func (p *odataParser) unnest() {
+	p.nesting--
+}
+
+// consumeKeyword consumes the given keyword if it is the next token.
+func (p *odataParser) consumeKeyword(keyword string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].kind == odataIdentifier && p.tokens[p.pos].value == keyword {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// consumeSymbol consumes the given symbol if it is the next token.
+func (p *odataParser) consumeSymbol(symbol string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].kind == odataSymbol && p.tokens[p.pos].value == symbol {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// isODataOperator checks whether the identifier is a reserved OData operator keyword.
+func isODataOperator(identifier string) bool {
+	switch identifier {
+	case "and", "or", "not", "eq", "ne", "gt", "ge", "lt", "le", "has", "in", "add", "sub", "mul", "div", "divby",
+		"mod":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsRSQLExpression(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: `name==Bill`, want: true},
+		{name: "AndOr", arg: `name=="Kill Bill";year=gt=2003`, want: true},
+		{name: "InList", arg: `genres=in=(sci-fi,action),director=='Christopher Nolan'`, want: true},
+		{name: "Keywords", arg: "genres=in=(sci-fi,action) or year<2000 and rating>=4", want: true},
+		{name: "Groups", arg: `(a==1,b!=2);c=out=(3,4)`, want: true},
+		{name: "EscapedQuote", arg: `name=="O\"Brien"`, want: true},
+		{name: "MissingValue", arg: "name==;year=gt=2003", want: false},
+		{name: "MissingOperator", arg: "name", want: false},
+		{name: "UnbalancedGroup", arg: "(name==Bill", want: false},
+		{name: "TrailingAnd", arg: "name==Bill;", want: false},
+		{name: "UnterminatedQuote", arg: `name=="Bill`, want: false},
+		{name: "EmptyList", arg: "genres=in=()", want: false},
+		{name: "BadOperator", arg: "name=GT=1", want: false},
+		{name: "NestedGroups", arg: strings.Repeat("(", 100) + "a==1" + strings.Repeat(")", 100), want: true},
+		{name: "DeepGroups", arg: strings.Repeat("(", 300) + "a==1" + strings.Repeat(")", 300), want: false},
+		{name: "DeepUnbalancedGroups", arg: strings.Repeat("(", 2000000), want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsRSQLExpression(tc.arg); got != tc.want {
+				t.Errorf("IsRSQLExpression(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsODataFilterExpression(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Comparison", arg: "Price le 200", want: true},
+		{name: "Function", arg: "Price le 200 and contains(Name, 'milk')", want: true},
+		{name: "Not", arg: "not endswith(Name, 'x') or Price gt 3.5", want: true},
+		{name: "Arithmetic", arg: "Price add 5 gt -10", want: true},
+		{name: "Path", arg: "Address/City eq 'Redmond'", want: true},
+		{name: "Lambda", arg: "Tags/any(t: t eq 'sale')", want: true},
+		{name: "EmptyAny", arg: "Tags/any()", want: true},
+		{name: "InList", arg: "Status in ('Open', 'Closed')", want: true},
+		{name: "EscapedQuote", arg: "Name eq 'O''Brien'", want: true},
+		{name: "Date", arg: "CreatedAt ge 2024-01-01T00:00:00Z and Deleted eq null", want: true},
+		{name: "NoArgsFunction", arg: "Date lt now()", want: true},
+		{name: "MissingOperand", arg: "Price le", want: false},
+		{name: "UnterminatedString", arg: "Name eq 'milk", want: false},
+		{name: "DoubleOperator", arg: "Price eq eq 1", want: false},
+		{name: "Unbalanced", arg: "(Price gt 1", want: false},
+		{name: "EmptyAll", arg: "Tags/all()", want: false},
+		{name: "UnknownChar", arg: "Price == 1", want: false},
+		{name: "NestedGroups", arg: strings.Repeat("(", 100) + "Price gt 1" + strings.Repeat(")", 100), want: true},
+		{name: "DeepGroups", arg: strings.Repeat("(", 300) + "Price gt 1" + strings.Repeat(")", 300), want: false},
+		{name: "DeepUnbalancedGroups", arg: strings.Repeat("(", 2000000), want: false},
+		{name: "DeepNot", arg: strings.Repeat("not ", 100000) + "Deleted", want: false},
+		{name: "DeepNegation", arg: "Price gt " + strings.Repeat("-", 100000) + "1", want: false},
+		{name: "DeepCalls", arg: strings.Repeat("f(", 100000), want: false},
+		{name: "DeepLambdas", arg: strings.Repeat("Tags/any(t: ", 100000), want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsODataFilterExpression(tc.arg); got != tc.want {
+				t.Errorf("IsODataFilterExpression(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
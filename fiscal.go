@@ -0,0 +1,147 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"strconv"
+	"time"
+)
+
+// IsNFeAccessKey checks whether the given value is a valid access key of a Brazilian electronic invoice (NF-e,
+// model 55). It uses the removeNonDigits function, so keys printed in groups, as on the DANFE, are accepted.
+// See isFiscalAccessKey for the rules applied to the 44 digits.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an NF-e access key.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid NF-e access key.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsNFeAccessKey("35240612345678000195550010000012341123456783"))           // true
+//	fmt.Println(IsNFeAccessKey("3524 0612 3456 7800 0195 5500 1000 0012 3411 2345 6783")) // true
+//	fmt.Println(IsNFeAccessKey("35240612345678000195550010000012341123456784"))           // false
+//	fmt.Println(IsNFeAccessKey("35240612345678000195650010000012341123456786"))           // false (NFC-e)
+func IsNFeAccessKey(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNFeAccessKey", time.Now(), &passed)
+	}
+	return isFiscalAccessKey(a, "55")
+}
+
+// IsNFCeKey checks whether the given value is a valid access key of a Brazilian consumer electronic invoice
+// (NFC-e, model 65). It uses the removeNonDigits function, so keys printed in groups are accepted. See
+// isFiscalAccessKey for the rules applied to the 44 digits.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an NFC-e access key.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid NFC-e access key.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsNFCeKey("35240612345678000195650010000012341123456786")) // true
+//	fmt.Println(IsNFCeKey("35240612345678000195550010000012341123456783")) // false (NF-e)
+func IsNFCeKey(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNFCeKey", time.Now(), &passed)
+	}
+	return isFiscalAccessKey(a, "65")
+}
+
+// IsCTeKey checks whether the given value is a valid access key of a Brazilian electronic bill of lading (CT-e,
+// model 57, or CT-e OS, model 67). It uses the removeNonDigits function, so keys printed in groups are accepted.
+// See isFiscalAccessKey for the rules applied to the 44 digits.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a CT-e access key.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid CT-e access key.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsCTeKey("35240612345678000195570010000012341123456780")) // true
+//	fmt.Println(IsCTeKey("35240612345678000195550010000012341123456783")) // false (NF-e)
+func IsCTeKey(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsCTeKey", time.Now(), &passed)
+	}
+	return isFiscalAccessKey(a, "57", "67")
+}
+
+// isFiscalAccessKey checks whether the digits of the given value form a 44-digit access key of one of the given
+// fiscal document models. The key is laid out as cUF (2), AAMM (4), CNPJ/CPF (14), model (2), series (3),
+// number (9), emission type (1), numeric code (8) and check digit (1). The state code must be an IBGE code, the
+// issue month must be between January 2006, when electronic invoices started, and the current month, the emission
+// type must be between 1 and 9 and the check digit must match the modulo 11 calculation with weights 2 to 9.
+func isFiscalAccessKey(a any, models ...string) bool {
+	s := removeNonDigits(toString(a))
+	if len(s) != 44 || !contains(models, s[20:22]) {
+		return false
+	}
+
+	if !isBrazilStateIBGECode(s[0:2]) {
+		return false
+	}
+
+	year, _ := strconv.Atoi(s[2:4])
+	month, _ := strconv.Atoi(s[4:6])
+	now := timeNow()
+	if month < 1 || month > 12 || year < 6 || 2000+year > now.Year() ||
+		(2000+year == now.Year() && month > int(now.Month())) {
+		return false
+	} else if s[34] == '0' {
+		return false
+	}
+
+	sum, weight := 0, 2
+	for i := 42; i >= 0; i-- {
+		sum += int(s[i]-'0') * weight
+		if weight++; weight > 9 {
+			weight = 2
+		}
+	}
+	verifier := 11 - sum%11
+	if verifier >= 10 {
+		verifier = 0
+	}
+	return verifier == int(s[43]-'0')
+}
@@ -0,0 +1,90 @@
+package checker
+
+import "testing"
+
+func TestIsNFeAccessKey(t *testing.T) {
+	key := "35240612345678000195550010000012341123456783"
+	testCases := []baseCase{
+		{name: "Valid", arg: key, want: true},
+		{name: "Pointer", arg: &key, want: true},
+		{name: "Grouped", arg: "3524 0612 3456 7800 0195 5500 1000 0012 3411 2345 6783", want: true},
+		{name: "OtherState", arg: "41230112345678000195550010000000011000000014", want: true},
+		{name: "WrongCheckDigit", arg: "35240612345678000195550010000012341123456784", want: false},
+		{name: "NFCeModel", arg: "35240612345678000195650010000012341123456786", want: false},
+		{name: "UnknownState", arg: "99240612345678000195550010000012341123456780", want: false},
+		{name: "InvalidMonth", arg: "35241312345678000195550010000012341123456785", want: false},
+		{name: "FutureDate", arg: "53991212345678000195550010000000011000000010", want: false},
+		{name: "BeforeNFe", arg: "35050612345678000195550010000012341123456789", want: false},
+		{name: "InvalidEmissionType", arg: "35240612345678000195550010000012340123456785", want: false},
+		{name: "Short", arg: "3524061234567800019555001000001234112345678", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsNFeAccessKey(tc.arg); got != tc.want {
+				t.Errorf("IsNFeAccessKey(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsNFCeKey(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Valid", arg: "35240612345678000195650010000012341123456786", want: true},
+		{name: "NFeModel", arg: "35240612345678000195550010000012341123456783", want: false},
+		{name: "WrongCheckDigit", arg: "35240612345678000195650010000012341123456787", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsNFCeKey(tc.arg); got != tc.want {
+				t.Errorf("IsNFCeKey(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsCTeKey(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Valid", arg: "35240612345678000195570010000012341123456780", want: true},
+		{name: "ServiceOrder", arg: "35240612345678000195670010000012341123456783", want: true},
+		{name: "NFeModel", arg: "35240612345678000195550010000012341123456783", want: false},
+		{name: "WrongCheckDigit", arg: "35240612345678000195570010000012341123456781", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsCTeKey(tc.arg); got != tc.want {
+				t.Errorf("IsCTeKey(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,523 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// graphqlToken is a token of a GraphQL document.
+type graphqlToken struct {
+	kind  byte
+	value string
+}
+
+// graphqlSelection is a field, inline fragment or fragment spread of a parsed GraphQL selection set.
+type graphqlSelection struct {
+	field    bool
+	spread   string
+	children []*graphqlSelection
+}
+
+// graphqlParser is a recursive descent parser of executable GraphQL documents that only keeps their selections.
+type graphqlParser struct {
+	tokens     []graphqlToken
+	pos        int
+	operations [][]*graphqlSelection
+	fragments  map[string][]*graphqlSelection
+	anonymous  bool
+	names      map[string]bool
+	maxDepth   int
+	fieldDepth int
+	nesting    int
+	depths     map[string]int
+}
+
+// graphqlMaxNesting is the maximum number of selection sets, list and object values and list types that may be
+// nested in a GraphQL document, which bounds the recursion of the parser.
+const graphqlMaxNesting = 256
+
+const (
+	graphqlName        = 'n'
+	graphqlValue       = 'v'
+	graphqlPunctuation = 'p'
+)
+
+// IsGraphQLDocument checks if a given value is a syntactically valid executable GraphQL document, that is, one
+// or more query, mutation or subscription operations (including the "{ ... }" shorthand) and fragment
+// definitions. An anonymous operation must be the only operation of the document and operation names must be
+// unique. Type system definitions are not accepted, and the document is not validated against any schema.
+// Documents that nest selection sets, values or types more than 256 levels deep are rejected.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a GraphQL document.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid GraphQL document.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsGraphQLDocument("query GetUser($id: ID!) { user(id: $id) { name } }")) // true
+//	fmt.Println(IsGraphQLDocument("{ user { name } }"))                                  // true
+//	fmt.Println(IsGraphQLDocument("query { user { name }"))                              // false
+//	fmt.Println(IsGraphQLDocument("type User { name: String }"))                         // false
+func IsGraphQLDocument(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsGraphQLDocument", time.Now(), &passed)
+	}
+	_, ok := parseGraphQL(toString(a), -1)
+	return ok
+}
+
+// IsGraphQLOperationName checks if a given value is a valid GraphQL operation name, that is, a GraphQL name
+// made of letters, digits and underscores that does not start with a digit.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an operation name.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid GraphQL operation name.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsGraphQLOperationName("GetUser"))  // true
+//	fmt.Println(IsGraphQLOperationName("_private")) // true
+//	fmt.Println(IsGraphQLOperationName("1stQuery")) // false
+//	fmt.Println(IsGraphQLOperationName("get-user")) // false
+func IsGraphQLOperationName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsGraphQLOperationName", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+	return regex.MatchString(toString(a))
+}
+
+// HasMaxQueryDepth checks if a given value is a valid GraphQL document (see IsGraphQLDocument) whose operations
+// do not nest fields deeper than the given maximum. Each field with a selection set adds a level, so
+// "{ user { name } }" has a depth of 2, while inline fragments and fragment spreads add none and are expanded
+// in place. Documents that spread undefined or cyclic fragments are rejected, as are fragment definitions that
+// are deeper than the maximum on their own. Parsing stops as soon as a definition exceeds the maximum.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a GraphQL document.
+//   - max: The maximum allowed depth.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid GraphQL document within the maximum depth.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(HasMaxQueryDepth("{ user { name } }", 2))                         // true
+//	fmt.Println(HasMaxQueryDepth("{ user { friends { friends { name } } } }", 3)) // false
+//	fmt.Println(HasMaxQueryDepth("{ user { ...Info } }", 5))                      // false
+func HasMaxQueryDepth(a any, max int) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("HasMaxQueryDepth", time.Now(), &passed)
+	}
+	p, ok := parseGraphQL(toString(a), max)
+	if !ok {
+		return false
+	}
+	for _, operation := range p.operations {
+		depth, ok := p.depth(operation, map[string]bool{})
+		if !ok || depth > max {
+			return false
+		}
+	}
+	return true
+}
+
+// parseGraphQL tokenizes and parses a GraphQL document, returning the parser that holds its operations and
+// fragments. It fails as soon as a field is nested deeper than maxDepth within its definition, unless maxDepth
+// is negative.
+func parseGraphQL(s string, maxDepth int) (*graphqlParser, bool) {
+	tokens, ok := tokenizeGraphQL(s)
+	if !ok || len(tokens) == 0 {
+		return nil, false
+	}
+
+	p := &graphqlParser{tokens: tokens, fragments: map[string][]*graphqlSelection{}, names: map[string]bool{},
+		maxDepth: maxDepth, depths: map[string]int{}}
+	for p.pos < len(p.tokens) {
+		if !p.parseDefinition() {
+			return nil, false
+		}
+	}
+	if len(p.operations) == 0 || (p.anonymous && len(p.operations) > 1) {
+		return nil, false
+	}
+	return p, true
+}
+
+// tokenizeGraphQL splits a GraphQL document into names, values and punctuators, skipping whitespace, commas and
+// comments.
+func tokenizeGraphQL(s string) ([]graphqlToken, bool) {
+	name := regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*`)
+	number := regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?`)
+	str := regexp.MustCompile(`^"([^"\\\n]|\\.)*"`)
+
+	var tokens []graphqlToken
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case c == '#':
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(s[i:], "..."):
+			tokens = append(tokens, graphqlToken{kind: graphqlPunctuation, value: "..."})
+			i += 3
+		case strings.IndexByte("!$&():=@[]{}|", c) >= 0:
+			tokens = append(tokens, graphqlToken{kind: graphqlPunctuation, value: string(c)})
+			i++
+		case strings.HasPrefix(s[i:], `"""`):
+			end := strings.Index(s[i+3:], `"""`)
+			for end >= 0 && s[i+3+end-1] == '\\' {
+				next := strings.Index(s[i+3+end+3:], `"""`)
+				if next < 0 {
+					end = -1
+				} else {
+					end += 3 + next
+				}
+			}
+			if end < 0 {
+				return nil, false
+			}
+			tokens = append(tokens, graphqlToken{kind: graphqlValue, value: s[i : i+6+end]})
+			i += 6 + end
+		case c == '"':
+			value := str.FindString(s[i:])
+			if value == "" {
+				return nil, false
+			}
+			tokens = append(tokens, graphqlToken{kind: graphqlValue, value: value})
+			i += len(value)
+		case number.MatchString(s[i:]):
+			value := number.FindString(s[i:])
+			tokens = append(tokens, graphqlToken{kind: graphqlValue, value: value})
+			i += len(value)
+		case name.MatchString(s[i:]):
+			value := name.FindString(s[i:])
+			tokens = append(tokens, graphqlToken{kind: graphqlName, value: value})
+			i += len(value)
+		default:
+			return nil, false
+		}
+	}
+	return tokens, true
+}
+
+// parseDefinition parses an operation or a fragment definition.
+func (p *graphqlParser) parseDefinition() bool {
+	if p.peek(graphqlPunctuation, "{") {
+		selections, ok := p.parseSelectionSet()
+		p.operations = append(p.operations, selections)
+		p.anonymous = true
+		return ok
+	}
+
+	if p.consume(graphqlName, "fragment") {
+		name, ok := p.parseName()
+		if !ok || name == "on" || p.fragments[name] != nil || !p.consume(graphqlName, "on") {
+			return false
+		} else if _, ok = p.parseName(); !ok || !p.parseDirectives() {
+			return false
+		}
+		selections, ok := p.parseSelectionSet()
+		p.fragments[name] = selections
+		return ok
+	}
+
+	if !p.consume(graphqlName, "query") && !p.consume(graphqlName, "mutation") &&
+		!p.consume(graphqlName, "subscription") {
+		return false
+	}
+	if p.peek(graphqlName, "") {
+		name, _ := p.parseName()
+		if p.names[name] {
+			return false
+		}
+		p.names[name] = true
+	} else {
+		p.anonymous = true
+	}
+	if p.peek(graphqlPunctuation, "(") && !p.parseVariableDefinitions() {
+		return false
+	} else if !p.parseDirectives() {
+		return false
+	}
+	selections, ok := p.parseSelectionSet()
+	p.operations = append(p.operations, selections)
+	return ok
+}
+
+// parseVariableDefinitions parses a parenthesized list of variable definitions.
+func (p *graphqlParser) parseVariableDefinitions() bool {
+	p.pos++
+	for {
+		if !p.consume(graphqlPunctuation, "$") {
+			return false
+		} else if _, ok := p.parseName(); !ok || !p.consume(graphqlPunctuation, ":") || !p.parseType() {
+			return false
+		} else if p.consume(graphqlPunctuation, "=") && !p.parseValue(true) {
+			return false
+		} else if !p.parseDirectives() {
+			return false
+		}
+		if p.consume(graphqlPunctuation, ")") {
+			return true
+		}
+	}
+}
+
+// parseType parses a named, list or non-null type reference.
+func (p *graphqlParser) parseType() bool {
+	if p.consume(graphqlPunctuation, "[") {
+		if !p.nest() {
+			return false
+		}
+		defer p.unnest()
+		if !p.parseType() || !p.consume(graphqlPunctuation, "]") {
+			return false
+		}
+	} else if _, ok := p.parseName(); !ok {
+		return false
+	}
+	p.consume(graphqlPunctuation, "!")
+	return true
+}
+
+// parseSelectionSet parses a non-empty selection set enclosed in braces.
+func (p *graphqlParser) parseSelectionSet() ([]*graphqlSelection, bool) {
+	if !p.consume(graphqlPunctuation, "{") || !p.nest() {
+		return nil, false
+	}
+	defer p.unnest()
+
+	var selections []*graphqlSelection
+	for !p.consume(graphqlPunctuation, "}") {
+		selection, ok := p.parseSelection()
+		if !ok {
+			return nil, false
+		}
+		selections = append(selections, selection)
+	}
+	return selections, len(selections) > 0
+}
+
+// parseSelection parses a field, an inline fragment or a fragment spread.
+func (p *graphqlParser) parseSelection() (*graphqlSelection, bool) {
+	selection := &graphqlSelection{}
+	var ok bool
+
+	if p.consume(graphqlPunctuation, "...") {
+		if p.peek(graphqlName, "") && !p.peek(graphqlName, "on") {
+			selection.spread, _ = p.parseName()
+			return selection, p.parseDirectives()
+		}
+		if p.consume(graphqlName, "on") {
+			if _, ok = p.parseName(); !ok {
+				return nil, false
+			}
+		}
+		if !p.parseDirectives() {
+			return nil, false
+		}
+		selection.children, ok = p.parseSelectionSet()
+		return selection, ok
+	}
+
+	selection.field = true
+	if p.maxDepth >= 0 && p.fieldDepth >= p.maxDepth {
+		return nil, false
+	} else if _, ok = p.parseName(); !ok {
+		return nil, false
+	}
+	if p.consume(graphqlPunctuation, ":") {
+		if _, ok = p.parseName(); !ok {
+			return nil, false
+		}
+	}
+	if p.peek(graphqlPunctuation, "(") && !p.parseArguments() {
+		return nil, false
+	} else if !p.parseDirectives() {
+		return nil, false
+	}
+	if p.peek(graphqlPunctuation, "{") {
+		p.fieldDepth++
+		selection.children, ok = p.parseSelectionSet()
+		p.fieldDepth--
+		return selection, ok
+	}
+	return selection, true
+}
+
+// parseArguments parses a non-empty parenthesized list of arguments.
+func (p *graphqlParser) parseArguments() bool {
+	p.pos++
+	for {
+		if _, ok := p.parseName(); !ok || !p.consume(graphqlPunctuation, ":") || !p.parseValue(false) {
+			return false
+		}
+		if p.consume(graphqlPunctuation, ")") {
+			return true
+		}
+	}
+}
+
+// parseDirectives parses any directives at the current position.
+func (p *graphqlParser) parseDirectives() bool {
+	for p.consume(graphqlPunctuation, "@") {
+		if _, ok := p.parseName(); !ok {
+			return false
+		} else if p.peek(graphqlPunctuation, "(") && !p.parseArguments() {
+			return false
+		}
+	}
+	return true
+}
+
+// parseValue parses a variable, scalar, enum, list or object value; variables are not allowed in constants.
+func (p *graphqlParser) parseValue(constant bool) bool {
+	switch {
+	case p.consume(graphqlPunctuation, "$"):
+		_, ok := p.parseName()
+		return ok && !constant
+	case p.peek(graphqlValue, ""), p.peek(graphqlName, ""):
+		p.pos++
+		return true
+	case p.consume(graphqlPunctuation, "["):
+		if !p.nest() {
+			return false
+		}
+		defer p.unnest()
+		for !p.consume(graphqlPunctuation, "]") {
+			if !p.parseValue(constant) {
+				return false
+			}
+		}
+		return true
+	case p.consume(graphqlPunctuation, "{"):
+		if !p.nest() {
+			return false
+		}
+		defer p.unnest()
+		for !p.consume(graphqlPunctuation, "}") {
+			if _, ok := p.parseName(); !ok || !p.consume(graphqlPunctuation, ":") || !p.parseValue(constant) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// nest enters a nested selection set, value or type, failing if it is deeper than graphqlMaxNesting.
+func (p *graphqlParser) nest() bool {
+	p.nesting++
+	return p.nesting <= graphqlMaxNesting
+}
+
+// unnest leaves a nested selection set, value or type.
+func (p *graphqlParser) unnest() {
+	p.nesting--
+}
+
+// parseName consumes and returns the next token if it is a name.
+func (p *graphqlParser) parseName() (string, bool) {
+	if !p.peek(graphqlName, "") {
+		return "", false
+	}
+	p.pos++
+	return p.tokens[p.pos-1].value, true
+}
+
+// consume consumes the next token if it has the given kind and value.
+func (p *graphqlParser) consume(kind byte, value string) bool {
+	if p.peek(kind, value) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// peek checks whether the next token has the given kind and, if not empty, the given value.
+func (p *graphqlParser) peek(kind byte, value string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == kind && (value == "" || p.tokens[p.pos].value == value)
+}
+
+// depth returns the field depth of the selections, expanding fragment spreads and failing on undefined or
+// cyclic fragments. The depth of each fragment is computed once and memoized, so that fragments spreading
+// each other several times are not expanded again on every spread.
+func (p *graphqlParser) depth(selections []*graphqlSelection, visiting map[string]bool) (int, bool) {
+	max := 0
+	for _, selection := range selections {
+		var depth int
+		if selection.spread == "" {
+			var ok bool
+			if depth, ok = p.depth(selection.children, visiting); !ok {
+				return 0, false
+			}
+		} else if memoized, exists := p.depths[selection.spread]; exists {
+			depth = memoized
+		} else {
+			fragment, exists := p.fragments[selection.spread]
+			if !exists || visiting[selection.spread] {
+				return 0, false
+			}
+			visiting[selection.spread] = true
+			var ok bool
+			if depth, ok = p.depth(fragment, visiting); !ok {
+				return 0, false
+			}
+			delete(visiting, selection.spread)
+			p.depths[selection.spread] = depth
+		}
+		if selection.field {
+			depth++
+		}
+		if depth > max {
+			max = depth
+		}
+	}
+	return max, true
+}
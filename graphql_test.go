@@ -0,0 +1,129 @@
+package checker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestIsGraphQLDocument(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Shorthand", arg: "{ user { name } }", want: true},
+		{name: "NamedQuery", arg: "query GetUser($id: ID!) { user(id: $id) { name } }", want: true},
+		{name: "Mutation", arg: `mutation Save($input: [UserInput!]! = [{name: "a"}]) @log { save(input: $input) { id } }`, want: true},
+		{name: "Fragments", arg: "query Q { user { ...Info ... on Admin { level } } } fragment Info on User { name email }", want: true},
+		{name: "AliasAndComments", arg: "# comment\nquery { me: user(id: 1, active: true, tag: ENUM) { name } }", want: true},
+		{name: "BlockString", arg: `{ search(text: """a "quoted" text""") { id } }`, want: true},
+		{name: "MultipleNamed", arg: "query A { a } query B { b }", want: true},
+		{name: "Unbalanced", arg: "query { user { name }", want: false},
+		{name: "TypeDefinition", arg: "type User { name: String }", want: false},
+		{name: "EmptySelection", arg: "{ }", want: false},
+		{name: "DuplicateName", arg: "query A { a } query A { b }", want: false},
+		{name: "AnonymousWithOther", arg: "{ a } query B { b }", want: false},
+		{name: "OnlyFragment", arg: "fragment Info on User { name }", want: false},
+		{name: "VariableInDefault", arg: "query ($a: Int = $b) { a }", want: false},
+		{name: "UnterminatedString", arg: `{ a(b: "c) }`, want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "DeepSelections", arg: strings.Repeat("{ a ", 300) + strings.Repeat("}", 300), want: false},
+		{name: "DeepInlineFragments", arg: "{" + strings.Repeat(" ... { a", 100000), want: false},
+		{name: "DeepListValue", arg: "{ a(b: " + strings.Repeat("[", 100000) + ") }", want: false},
+		{name: "DeepListType", arg: "query ($a: " + strings.Repeat("[", 100000) + "Int) { a }", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsGraphQLDocument(tc.arg); got != tc.want {
+				t.Errorf("IsGraphQLDocument(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsGraphQLOperationName(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: "GetUser", want: true},
+		{name: "Underscore", arg: "_private", want: true},
+		{name: "Digits", arg: "query2", want: true},
+		{name: "LeadingDigit", arg: "1stQuery", want: false},
+		{name: "Hyphen", arg: "get-user", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsGraphQLOperationName(tc.arg); got != tc.want {
+				t.Errorf("IsGraphQLOperationName(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHasMaxQueryDepth(t *testing.T) {
+	testCases := []struct {
+		name  string
+		arg   any
+		max   int
+		want  bool
+		panic bool
+	}{
+		{name: "WithinMax", arg: "{ user { name } }", max: 2, want: true},
+		{name: "AboveMax", arg: "{ user { friends { friends { name } } } }", max: 3, want: false},
+		{name: "EqualToMax", arg: "{ user { friends { friends { name } } } }", max: 4, want: true},
+		{name: "InlineFragment", arg: "{ node { ... on User { name } } }", max: 2, want: true},
+		{name: "FragmentExpanded", arg: "{ user { ...F } } fragment F on User { friends { name } }", max: 2, want: false},
+		{name: "UndefinedFragment", arg: "{ user { ...Info } }", max: 5, want: false},
+		{name: "CyclicFragment", arg: "{ ...A } fragment A on Q { ...B } fragment B on Q { ...A }", max: 5, want: false},
+		{name: "EveryOperation", arg: "query A { a } query B { b { c } }", max: 1, want: false},
+		{name: "Invalid", arg: "{ user {", max: 5, want: false},
+		{name: "DeepFragment", arg: "{ a } fragment F on Q { b { c { d } } }", max: 2, want: false},
+		{name: "DeepSelections", arg: strings.Repeat("{ a ", 100000) + strings.Repeat("}", 100000), max: 10, want: false},
+		{name: "RepeatedSpreads", arg: repeatedSpreads(40), max: 45, want: true},
+		{name: "RepeatedSpreadsAboveMax", arg: repeatedSpreads(40), max: 40, want: false},
+		{name: "Nil", arg: nil, max: 5, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := HasMaxQueryDepth(tc.arg, tc.max); got != tc.want {
+				t.Errorf("HasMaxQueryDepth(%v, %v) = %v, want %v", tc.arg, tc.max, got, tc.want)
+			}
+		})
+	}
+}
+
+// repeatedSpreads builds a document whose fragments F0 to Fn-1 each spread the next one twice, nesting one field
+// per fragment, so that expanding every spread takes 2^n steps while the document has a depth of n+1.
+func repeatedSpreads(n int) string {
+	var b strings.Builder
+	b.WriteString("{ ...F0 }")
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, " fragment F%d on Q { a { ...F%d } b { ...F%d } }", i, i+1, i+1)
+	}
+	fmt.Fprintf(&b, " fragment F%d on Q { c }", n)
+	return b.String()
+}
@@ -0,0 +1,226 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"sync"
+	"time"
+)
+
+// holidayRegistry stores the state/municipal holidays registered through RegisterHolidays, keyed by region
+// and then by the date in the "2006-01-02" layout.
+var holidayRegistry = struct {
+	sync.RWMutex
+	regions map[string]map[string]bool
+}{regions: map[string]map[string]bool{}}
+
+// IsBrazilianHoliday checks whether the given value falls on a Brazilian national holiday. It uses the toDate
+// function to convert the value and compares it against the fixed national holidays and the movable holidays
+// computed from the Easter date of the same year (Carnival Monday and Tuesday, Good Friday and Corpus Christi).
+// Carnival and Corpus Christi are considered because banks do not settle payments on these days.
+//
+// Parameters:
+//   - a: Any value to be converted into a date and checked against the national holiday calendar.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the date is a Brazilian national holiday.
+//
+// Panic:
+//   - The function will panic if the provided value cannot be converted to a time.Time through the toDate function.
+//
+// Example:
+//
+//	fmt.Println(IsBrazilianHoliday("2024-12-25")) // true
+//	fmt.Println(IsBrazilianHoliday("2024-03-29")) // true (Good Friday)
+//	fmt.Println(IsBrazilianHoliday("2024-03-28")) // false
+func IsBrazilianHoliday(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBrazilianHoliday", time.Now(), &passed)
+	}
+	return isBrazilianHoliday(a)
+}
+
+// isBrazilianHoliday is the unobserved implementation of IsBrazilianHoliday.
+func isBrazilianHoliday(a any) bool {
+	date := toDate(a)
+
+	switch date.Month() {
+	case time.January:
+		if date.Day() == 1 {
+			return true
+		}
+	case time.April:
+		if date.Day() == 21 {
+			return true
+		}
+	case time.May:
+		if date.Day() == 1 {
+			return true
+		}
+	case time.September:
+		if date.Day() == 7 {
+			return true
+		}
+	case time.October:
+		if date.Day() == 12 {
+			return true
+		}
+	case time.November:
+		// Black Consciousness Day became a national holiday in 2024 (Law 14.759/2023).
+		if date.Day() == 2 || date.Day() == 15 || (date.Day() == 20 && date.Year() >= 2024) {
+			return true
+		}
+	case time.December:
+		if date.Day() == 25 {
+			return true
+		}
+	}
+
+	easter := easterDate(date.Year(), date.Location())
+	for _, offset := range []int{-48, -47, -2, 60} {
+		if date.Equal(easter.AddDate(0, 0, offset)) {
+			return true
+		}
+	}
+	return false
+}
+
+// RegisterHolidays registers additional holidays, such as state or municipal ones, under the given region.
+// The registered dates are considered by IsRegionalHoliday and IsBusinessDay when the region is informed.
+// Only the date components of each time.Time are kept. It is safe to call RegisterHolidays concurrently
+// with the holiday checkers.
+//
+// Parameters:
+//   - region: The identifier of the region, for example "SP" or "SP-São Paulo".
+//   - dates: The holiday dates to be registered under the region.
+//
+// Example:
+//
+//	RegisterHolidays("SP", []time.Time{time.Date(2024, time.July, 9, 0, 0, 0, 0, time.UTC)})
+//	fmt.Println(IsRegionalHoliday("SP", "2024-07-09")) // true
+func RegisterHolidays(region string, dates []time.Time) {
+	holidayRegistry.Lock()
+	defer holidayRegistry.Unlock()
+
+	registered, ok := holidayRegistry.regions[region]
+	if !ok {
+		registered = map[string]bool{}
+		holidayRegistry.regions[region] = registered
+	}
+	for _, date := range dates {
+		registered[date.Format(time.DateOnly)] = true
+	}
+}
+
+// IsRegionalHoliday checks whether the given value falls on a holiday registered for the region
+// through RegisterHolidays. National holidays are not considered, use IsBrazilianHoliday for them.
+//
+// Parameters:
+//   - region: The identifier of the region used when registering the holidays.
+//   - a: Any value to be converted into a date and checked against the region holidays.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the date is a holiday registered for the region.
+//
+// Panic:
+//   - The function will panic if the provided value cannot be converted to a time.Time through the toDate function.
+//
+// Example:
+//
+//	RegisterHolidays("SP", []time.Time{time.Date(2024, time.July, 9, 0, 0, 0, 0, time.UTC)})
+//	fmt.Println(IsRegionalHoliday("SP", "2024-07-09")) // true
+//	fmt.Println(IsRegionalHoliday("RJ", "2024-07-09")) // false
+func IsRegionalHoliday(region string, a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsRegionalHoliday", time.Now(), &passed)
+	}
+	return isRegionalHoliday(region, a)
+}
+
+// isRegionalHoliday is the unobserved implementation of IsRegionalHoliday.
+func isRegionalHoliday(region string, a any) bool {
+	key := toDate(a).Format(time.DateOnly)
+
+	holidayRegistry.RLock()
+	defer holidayRegistry.RUnlock()
+
+	return holidayRegistry.regions[region][key]
+}
+
+// IsBusinessDay checks whether the given value falls on a business day, that is, a day from Monday to Friday that
+// is not a Brazilian national holiday nor a holiday registered for any of the provided regions.
+//
+// Parameters:
+//   - a: Any value to be converted into a date and checked.
+//   - regions: Optional regions whose registered holidays should also be considered.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the date is a business day.
+//
+// Panic:
+//   - The function will panic if the provided value cannot be converted to a time.Time through the toDate function.
+//
+// Example:
+//
+//	RegisterHolidays("SP", []time.Time{time.Date(2024, time.July, 9, 0, 0, 0, 0, time.UTC)})
+//	fmt.Println(IsBusinessDay("2024-07-08"))       // true
+//	fmt.Println(IsBusinessDay("2024-07-09", "SP")) // false
+//	fmt.Println(IsBusinessDay("2024-07-13"))       // false (Saturday)
+//	fmt.Println(IsBusinessDay("2024-12-25"))       // false
+func IsBusinessDay(a any, regions ...string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBusinessDay", time.Now(), &passed)
+	}
+	date := toDate(a)
+	if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday || isBrazilianHoliday(date) {
+		return false
+	}
+	for _, region := range regions {
+		if isRegionalHoliday(region, date) {
+			return false
+		}
+	}
+	return true
+}
+
+// easterDate calculates the Easter Sunday of the given year in the Gregorian calendar using the
+// anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+//
+// Returns: The Easter Sunday at midnight in the given location.
+func easterDate(year int, loc *time.Location) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+}
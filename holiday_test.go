@@ -0,0 +1,121 @@
+package checker
+
+import (
+	"testing"
+	"time"
+)
+
+type holidayCase struct {
+	name    string
+	region  string
+	regions []string
+	arg     any
+	want    bool
+	panic   bool
+}
+
+func TestIsBrazilianHoliday(t *testing.T) {
+	testCases := []baseCase{
+		{name: "NewYear", arg: "2024-01-01", want: true},
+		{name: "Tiradentes", arg: "2024-04-21", want: true},
+		{name: "LabourDay", arg: "2024-05-01", want: true},
+		{name: "Independence", arg: "2024-09-07", want: true},
+		{name: "OurLadyAparecida", arg: "2024-10-12", want: true},
+		{name: "AllSouls", arg: "2024-11-02", want: true},
+		{name: "Republic", arg: "2024-11-15", want: true},
+		{name: "BlackConsciousness2024", arg: "2024-11-20", want: true},
+		{name: "BlackConsciousness2023", arg: "2023-11-20", want: false},
+		{name: "Christmas", arg: "2024-12-25", want: true},
+		{name: "CarnivalMonday2024", arg: "2024-02-12", want: true},
+		{name: "CarnivalTuesday2024", arg: "2024-02-13", want: true},
+		{name: "AshWednesday2024", arg: "2024-02-14", want: false},
+		{name: "GoodFriday2024", arg: "2024-03-29", want: true},
+		{name: "GoodFriday2025", arg: "2025-04-18", want: true},
+		{name: "CorpusChristi2024", arg: "2024-05-30", want: true},
+		{name: "CorpusChristi2025", arg: "2025-06-19", want: true},
+		{name: "RegularDay", arg: "2024-03-28", want: false},
+		{name: "TimeWithHours", arg: time.Date(2024, time.December, 25, 18, 30, 0, 0, time.UTC), want: true},
+		{name: "InvalidDate", arg: "not a date", panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsBrazilianHoliday(tc.arg); got != tc.want {
+				t.Errorf("IsBrazilianHoliday(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsRegionalHoliday(t *testing.T) {
+	RegisterHolidays("test-regional", []time.Time{
+		time.Date(2024, time.July, 9, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, time.January, 25, 0, 0, 0, 0, time.UTC),
+	})
+
+	testCases := []holidayCase{
+		{name: "RegisteredDate", region: "test-regional", arg: "2024-07-09", want: true},
+		{name: "AnotherRegisteredDate", region: "test-regional", arg: "2024-01-25", want: true},
+		{name: "NotRegisteredDate", region: "test-regional", arg: "2024-07-10", want: false},
+		{name: "UnknownRegion", region: "unknown", arg: "2024-07-09", want: false},
+		{name: "NationalHoliday", region: "test-regional", arg: "2024-12-25", want: false},
+		{name: "InvalidDate", region: "test-regional", arg: "invalid", panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsRegionalHoliday(tc.region, tc.arg); got != tc.want {
+				t.Errorf("IsRegionalHoliday(%v, %v) = %v, want %v", tc.region, tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	RegisterHolidays("test-business", []time.Time{time.Date(2024, time.July, 9, 0, 0, 0, 0, time.UTC)})
+
+	testCases := []holidayCase{
+		{name: "Monday", arg: "2024-07-08", want: true},
+		{name: "Friday", arg: "2024-07-12", want: true},
+		{name: "Saturday", arg: "2024-07-13", want: false},
+		{name: "Sunday", arg: "2024-07-14", want: false},
+		{name: "NationalHoliday", arg: "2024-12-25", want: false},
+		{name: "CarnivalTuesday", arg: "2024-02-13", want: false},
+		{name: "RegionalHolidayWithoutRegion", arg: "2024-07-09", want: true},
+		{name: "RegionalHolidayWithRegion", arg: "2024-07-09", regions: []string{"test-business"}, want: false},
+		{name: "RegionalHolidayOtherRegion", arg: "2024-07-09", regions: []string{"other"}, want: true},
+		{name: "InvalidDate", arg: "invalid", panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsBusinessDay(tc.arg, tc.regions...); got != tc.want {
+				t.Errorf("IsBusinessDay(%v, %v) = %v, want %v", tc.arg, tc.regions, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// icuParser is a recursive descent parser of ICU MessageFormat patterns that only checks their structure.
+type icuParser struct {
+	s   string
+	pos int
+}
+
+// IsICUMessageFormat checks if a given value is a syntactically valid ICU MessageFormat pattern, such as
+// "Hello {name}, you have {count, plural, =0 {no messages} one {# message} other {# messages}}". Arguments may
+// be simple ({name}), formatted ({price, number, currency}) or plural, selectordinal and select arguments,
+// whose options must include "other", use valid selectors and hold valid nested messages. Braces must be
+// balanced, except inside apostrophe-quoted text such as "'{literal}'".
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an ICU message.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid ICU MessageFormat pattern.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsICUMessageFormat("{count, plural, one {# item} other {# items}}")) // true
+//	fmt.Println(IsICUMessageFormat("{gender, select, female {She} other {They}}"))   // true
+//	fmt.Println(IsICUMessageFormat("{count, plural, one {# item}}"))                 // false
+//	fmt.Println(IsICUMessageFormat("Hello {name"))                                   // false
+func IsICUMessageFormat(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsICUMessageFormat", time.Now(), &passed)
+	}
+	p := &icuParser{s: toString(a)}
+	return p.parseMessage() && p.pos == len(p.s)
+}
+
+// IsTranslationKey checks if a given value has the shape of a translation key, that is, two or more segments
+// separated by dots, such as "module.section.key", where each segment is made of letters, digits, underscores
+// or hyphens and the first one starts with a letter. Keys longer than 255 characters are rejected.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a translation key.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid translation key.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsTranslationKey("checkout.payment.card_declined")) // true
+//	fmt.Println(IsTranslationKey("errors.404"))                     // true
+//	fmt.Println(IsTranslationKey("checkout"))                       // false
+//	fmt.Println(IsTranslationKey("checkout..title"))                // false
+func IsTranslationKey(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsTranslationKey", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*(\.[A-Za-z0-9_-]+)+$`)
+	return len(s) <= 255 && regex.MatchString(s)
+}
+
+// parseMessage parses text and arguments until the end of the pattern or a closing brace, which is left for the
+// caller.
+func (p *icuParser) parseMessage() bool {
+	for p.pos < len(p.s) {
+		switch c := p.s[p.pos]; {
+		case c == '}':
+			return true
+		case c == '{':
+			if !p.parseArgument() {
+				return false
+			}
+		case c == '\'':
+			if !p.skipQuoted() {
+				return false
+			}
+		default:
+			p.pos++
+		}
+	}
+	return true
+}
+
+// skipQuoted skips an apostrophe, which escapes itself when doubled and starts quoted literal text when followed
+// by a special character.
+func (p *icuParser) skipQuoted() bool {
+	p.pos++
+	if p.pos >= len(p.s) || !strings.ContainsRune("'{}#|", rune(p.s[p.pos])) {
+		return true
+	} else if p.s[p.pos] == '\'' {
+		p.pos++
+		return true
+	}
+
+	for ; p.pos < len(p.s); p.pos++ {
+		if p.s[p.pos] == '\'' {
+			if p.pos+1 < len(p.s) && p.s[p.pos+1] == '\'' {
+				p.pos++
+				continue
+			}
+			p.pos++
+			return true
+		}
+	}
+	return false
+}
+
+// parseArgument parses a simple, formatted, plural, selectordinal or select argument enclosed in braces.
+func (p *icuParser) parseArgument() bool {
+	p.pos++
+	if p.parseWord() == "" {
+		return false
+	}
+	p.skipSpaces()
+	if p.consume('}') {
+		return true
+	} else if !p.consume(',') {
+		return false
+	}
+
+	p.skipSpaces()
+	argumentType := p.parseWord()
+	p.skipSpaces()
+	switch argumentType {
+	case "number", "date", "time", "spellout", "ordinal", "duration":
+		if p.consume(',') {
+			start := p.pos
+			for p.pos < len(p.s) && p.s[p.pos] != '{' && p.s[p.pos] != '}' {
+				p.pos++
+			}
+			if strings.TrimSpace(p.s[start:p.pos]) == "" {
+				return false
+			}
+		}
+		return p.consume('}')
+	case "plural", "selectordinal", "select":
+		return p.consume(',') && p.parseOptions(argumentType != "select")
+	}
+	return false
+}
+
+// parseOptions parses the options of a plural or select argument up to its closing brace, requiring an "other"
+// option and, for plural arguments, an optional offset and valid plural selectors.
+func (p *icuParser) parseOptions(plural bool) bool {
+	p.skipSpaces()
+	if plural && strings.HasPrefix(p.s[p.pos:], "offset:") {
+		p.pos += len("offset:")
+		p.skipSpaces()
+		if !p.parseDigits() {
+			return false
+		}
+	}
+
+	var selectors []string
+	for {
+		p.skipSpaces()
+		if p.consume('}') {
+			return slices.Contains(selectors, "other")
+		}
+
+		var selector string
+		if plural && p.consume('=') {
+			if !p.parseDigits() {
+				return false
+			}
+			selector = "="
+		} else {
+			selector = p.parseWord()
+			if selector == "" {
+				return false
+			} else if plural && !slices.Contains([]string{"zero", "one", "two", "few", "many", "other"}, selector) {
+				return false
+			}
+		}
+		selectors = append(selectors, selector)
+
+		p.skipSpaces()
+		if !p.consume('{') || !p.parseMessage() || !p.consume('}') {
+			return false
+		}
+	}
+}
+
+// parseWord parses and returns a run of letters, digits, underscores and hyphens.
+func (p *icuParser) parseWord() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			break
+		}
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// parseDigits parses a non-empty run of digits.
+func (p *icuParser) parseDigits() bool {
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+// consume consumes the given character if it is the next one.
+func (p *icuParser) consume(c byte) bool {
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// skipSpaces skips any whitespace at the current position.
+func (p *icuParser) skipSpaces() {
+	for p.pos < len(p.s) && strings.ContainsRune(" \t\r\n", rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
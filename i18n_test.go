@@ -0,0 +1,80 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsICUMessageFormat(t *testing.T) {
+	testCases := []baseCase{
+		{name: "PlainText", arg: "Hello world", want: true},
+		{name: "Simple", arg: "Hello {name}", want: true},
+		{name: "Plural", arg: "You have {count, plural, =0 {no messages} one {# message} other {# messages}}", want: true},
+		{name: "PluralOffset", arg: "{guests, plural, offset:1 =0 {nobody} one {{host}} other {{host} and # others}}", want: true},
+		{name: "SelectOrdinal", arg: "{place, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}", want: true},
+		{name: "Select", arg: "{gender, select, female {She} male {He} other {They}}", want: true},
+		{name: "Nested", arg: "{gender, select, female {{count, plural, one {her item} other {her items}}} other {items}}", want: true},
+		{name: "Number", arg: "Price: {price, number, currency}", want: true},
+		{name: "Date", arg: "Today is {today, date}", want: true},
+		{name: "QuotedBraces", arg: "Use '{braces}' literally, it''s fine", want: true},
+		{name: "MissingOther", arg: "{count, plural, one {# item}}", want: false},
+		{name: "InvalidPluralKeyword", arg: "{count, plural, single {# item} other {# items}}", want: false},
+		{name: "UnknownType", arg: "{count, money}", want: false},
+		{name: "EmptyStyle", arg: "{price, number, }", want: false},
+		{name: "Unclosed", arg: "Hello {name", want: false},
+		{name: "StrayClose", arg: "Hello name}", want: false},
+		{name: "EmptyArgument", arg: "Hello {}", want: false},
+		{name: "UnterminatedQuote", arg: "Hello '{name}", want: false},
+		{name: "Empty", arg: "", want: true},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsICUMessageFormat(tc.arg); got != tc.want {
+				t.Errorf("IsICUMessageFormat(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsTranslationKey(t *testing.T) {
+	testCases := []baseCase{
+		{name: "ThreeSegments", arg: "checkout.payment.card_declined", want: true},
+		{name: "TwoSegments", arg: "errors.404", want: true},
+		{name: "CamelCase", arg: "home.welcomeTitle", want: true},
+		{name: "Hyphen", arg: "home.sign-in", want: true},
+		{name: "SingleSegment", arg: "checkout", want: false},
+		{name: "EmptySegment", arg: "checkout..title", want: false},
+		{name: "TrailingDot", arg: "checkout.title.", want: false},
+		{name: "LeadingDigit", arg: "1home.title", want: false},
+		{name: "Spaces", arg: "home.welcome title", want: false},
+		{name: "TooLong", arg: "a." + strings.Repeat("b", 255), want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsTranslationKey(tc.arg); got != tc.want {
+				t.Errorf("IsTranslationKey(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"bytes"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"strings"
+)
+
+// ImageConstraints describes the limits an image must respect in ImageSatisfies. Zero values disable the
+// corresponding limit, and an empty Formats accepts every registered image format.
+type ImageConstraints struct {
+	// MaxWidth is the maximum width in pixels.
+	MaxWidth int
+	// MaxHeight is the maximum height in pixels.
+	MaxHeight int
+	// MaxBytes is the maximum size of the encoded content.
+	MaxBytes int
+	// Formats lists the accepted format names as reported by image.DecodeConfig, such as "png", "jpeg" or "gif".
+	Formats []string
+}
+
+// ImageSatisfies checks whether the given bytes hold an image that respects the given constraints. Only the image
+// header is decoded with image.DecodeConfig, so the pixels are never loaded into memory. The PNG, JPEG and GIF
+// decoders are registered by this package, other formats can be enabled by importing their decoder packages.
+//
+// Parameters:
+//   - b: The encoded image content.
+//   - opts: The constraints the image must respect.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the image respects all constraints.
+//   - error: An error if the image header cannot be decoded, in which case the boolean is false.
+//
+// Example:
+//
+//	content, _ := os.ReadFile("avatar.png") // 200x200 PNG
+//	opts := ImageConstraints{MaxWidth: 512, MaxHeight: 512, MaxBytes: 1 << 20, Formats: []string{"png", "jpeg"}}
+//	fmt.Println(ImageSatisfies(content, opts))                            // true <nil>
+//	fmt.Println(ImageSatisfies(content, ImageConstraints{MaxWidth: 100})) // false <nil>
+//	fmt.Println(ImageSatisfies([]byte("not an image"), opts))             // false image: unknown format
+func ImageSatisfies(b []byte, opts ImageConstraints) (bool, error) {
+	if opts.MaxBytes > 0 && len(b) > opts.MaxBytes {
+		return false, nil
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil {
+		return false, err
+	}
+
+	if opts.MaxWidth > 0 && config.Width > opts.MaxWidth {
+		return false, nil
+	} else if opts.MaxHeight > 0 && config.Height > opts.MaxHeight {
+		return false, nil
+	} else if len(opts.Formats) == 0 {
+		return true, nil
+	}
+
+	for _, accepted := range opts.Formats {
+		if strings.EqualFold(accepted, format) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
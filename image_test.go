@@ -0,0 +1,61 @@
+package checker
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"testing"
+)
+
+func TestImageSatisfies(t *testing.T) {
+	pngImage := buildImage(t, "png", 200, 100)
+	gifImage := buildImage(t, "gif", 50, 50)
+
+	tests := []struct {
+		name    string
+		arg     []byte
+		opts    ImageConstraints
+		want    bool
+		wantErr bool
+	}{
+		{name: "NoConstraints", arg: pngImage, opts: ImageConstraints{}, want: true},
+		{name: "WithinLimits", arg: pngImage, opts: ImageConstraints{MaxWidth: 200, MaxHeight: 100, MaxBytes: len(pngImage)}, want: true},
+		{name: "TooWide", arg: pngImage, opts: ImageConstraints{MaxWidth: 199}, want: false},
+		{name: "TooTall", arg: pngImage, opts: ImageConstraints{MaxHeight: 99}, want: false},
+		{name: "TooLarge", arg: pngImage, opts: ImageConstraints{MaxBytes: len(pngImage) - 1}, want: false},
+		{name: "AcceptedFormat", arg: gifImage, opts: ImageConstraints{Formats: []string{"png", "GIF"}}, want: true},
+		{name: "RejectedFormat", arg: gifImage, opts: ImageConstraints{Formats: []string{"png", "jpeg"}}, want: false},
+		{name: "Truncated", arg: pngImage[:10], opts: ImageConstraints{}, want: false, wantErr: true},
+		{name: "NotAnImage", arg: []byte("not an image"), opts: ImageConstraints{}, want: false, wantErr: true},
+		{name: "Nil", arg: nil, opts: ImageConstraints{}, want: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ImageSatisfies(tt.arg, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ImageSatisfies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ImageSatisfies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func buildImage(t *testing.T, format string, width, height int) []byte {
+	var buffer bytes.Buffer
+	img := image.NewPaletted(image.Rect(0, 0, width, height), []color.Color{color.Black, color.White})
+
+	var err error
+	if format == "gif" {
+		err = gif.Encode(&buffer, img, nil)
+	} else {
+		err = png.Encode(&buffer, img)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
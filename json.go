@@ -0,0 +1,799 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// JSONOptions configures how IsMapWithOptions and IsSliceWithOptions treat the JSON literal "null". Zero values
+// keep the behavior of IsMap and IsSlice, which accept "null" because json.Unmarshal stores it as a nil map or
+// slice without an error.
+type JSONOptions struct {
+	// RejectNull makes the literal "null" fail the check, so only an actual object or array passes.
+	RejectNull bool
+}
+
+// IsMapWithOptions checks if a given value is a JSON object, like IsMap, treating the literal "null" as
+// configured by opts.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being unmarshalled.
+//   - opts: The options controlling whether "null" is accepted.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a JSON object under the given options.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsMapWithOptions(`{"key":"value"}`, JSONOptions{RejectNull: true})) // true
+//	fmt.Println(IsMapWithOptions("null", JSONOptions{}))                            // true
+//	fmt.Println(IsMapWithOptions("null", JSONOptions{RejectNull: true}))            // false
+func IsMapWithOptions(a any, opts JSONOptions) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMapWithOptions", time.Now(), &passed)
+	}
+	object, ok := unmarshalJSONObject(a)
+	return ok && (object != nil || !opts.RejectNull)
+}
+
+// IsSliceWithOptions checks if a given value is a JSON array, like IsSlice, treating the literal "null" as
+// configured by opts.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being unmarshalled.
+//   - opts: The options controlling whether "null" is accepted.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a JSON array under the given options.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsSliceWithOptions(`[1, 2, 3]`, JSONOptions{RejectNull: true})) // true
+//	fmt.Println(IsSliceWithOptions("null", JSONOptions{}))                      // true
+//	fmt.Println(IsSliceWithOptions("null", JSONOptions{RejectNull: true}))      // false
+func IsSliceWithOptions(a any, opts JSONOptions) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSliceWithOptions", time.Now(), &passed)
+	}
+	array, ok := unmarshalJSONArray(a)
+	return ok && (array != nil || !opts.RejectNull)
+}
+
+// IsJSONNull checks if a given value is the JSON literal "null", optionally surrounded by whitespace.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being unmarshalled.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is JSON null.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsJSONNull("null"))    // true
+//	fmt.Println(IsJSONNull(" null\n")) // true
+//	fmt.Println(IsJSONNull(`"null"`))  // false
+//	fmt.Println(IsJSONNull("{}"))      // false
+func IsJSONNull(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsJSONNull", time.Now(), &passed)
+	}
+	var value any
+	return json.Unmarshal(toBytes(a), &value) == nil && value == nil
+}
+
+// IsEmptyJSONObject checks if a given value is a JSON object without any members, such as "{}".
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being unmarshalled.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an empty JSON object.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsEmptyJSONObject("{}"))              // true
+//	fmt.Println(IsEmptyJSONObject("{ }"))             // true
+//	fmt.Println(IsEmptyJSONObject(`{"key":"value"}`)) // false
+//	fmt.Println(IsEmptyJSONObject("null"))            // false
+func IsEmptyJSONObject(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsEmptyJSONObject", time.Now(), &passed)
+	}
+	object, ok := unmarshalJSONObject(a)
+	return ok && object != nil && len(object) == 0
+}
+
+// IsEmptyJSONArray checks if a given value is a JSON array without any elements, such as "[]".
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being unmarshalled.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an empty JSON array.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsEmptyJSONArray("[]"))   // true
+//	fmt.Println(IsEmptyJSONArray("[ ]"))  // true
+//	fmt.Println(IsEmptyJSONArray("[1]"))  // false
+//	fmt.Println(IsEmptyJSONArray("null")) // false
+func IsEmptyJSONArray(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsEmptyJSONArray", time.Now(), &passed)
+	}
+	array, ok := unmarshalJSONArray(a)
+	return ok && array != nil && len(array) == 0
+}
+
+// IsNonEmptyJSON checks if a given value is a JSON object with at least one member or a JSON array with at least
+// one element. Unlike IsJSON, it rejects "null", "{}" and "[]".
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being unmarshalled.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a non-empty JSON object or array.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsNonEmptyJSON(`{"key":"value"}`)) // true
+//	fmt.Println(IsNonEmptyJSON("[1]"))             // true
+//	fmt.Println(IsNonEmptyJSON("{}"))              // false
+//	fmt.Println(IsNonEmptyJSON("null"))            // false
+func IsNonEmptyJSON(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNonEmptyJSON", time.Now(), &passed)
+	}
+	if object, ok := unmarshalJSONObject(a); ok {
+		return len(object) > 0
+	}
+	array, ok := unmarshalJSONArray(a)
+	return ok && len(array) > 0
+}
+
+// IsJSONValue checks if a given value is any valid JSON text as defined by RFC 8259, including top-level scalars
+// such as strings, numbers, booleans and null. Unlike IsJSON, it is not limited to objects and arrays.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being validated.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is valid JSON.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsJSONValue(`{"key":"value"}`)) // true
+//	fmt.Println(IsJSONValue(`"text"`))          // true
+//	fmt.Println(IsJSONValue("1e400"))           // true
+//	fmt.Println(IsJSONValue("text"))            // false
+func IsJSONValue(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsJSONValue", time.Now(), &passed)
+	}
+	return json.Valid(toBytes(a))
+}
+
+// IsJSONString checks if a given value is a JSON string, that is, text enclosed in double quotes with valid
+// escapes, such as `"text"`.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being validated.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a JSON string.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsJSONString(`"text"`))        // true
+//	fmt.Println(IsJSONString(`"line\nbreak"`)) // true
+//	fmt.Println(IsJSONString("text"))          // false
+//	fmt.Println(IsJSONString("null"))          // false
+func IsJSONString(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsJSONString", time.Now(), &passed)
+	}
+	return jsonValueKind(a) == '"'
+}
+
+// IsJSONNumber checks if a given value is a JSON number, such as "42", "-1.5" or "6.02e23". Numbers beyond the
+// range of float64 are still valid JSON and pass, while forms JSON does not allow, such as "+1", "01", ".5" or
+// "NaN", fail.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being validated.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a JSON number.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsJSONNumber("42"))   // true
+//	fmt.Println(IsJSONNumber(-1.5))   // true
+//	fmt.Println(IsJSONNumber("01"))   // false
+//	fmt.Println(IsJSONNumber(`"42"`)) // false
+func IsJSONNumber(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsJSONNumber", time.Now(), &passed)
+	}
+	kind := jsonValueKind(a)
+	return kind == '-' || (kind >= '0' && kind <= '9')
+}
+
+// IsJSONBoolean checks if a given value is one of the JSON literals "true" or "false".
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being validated.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a JSON boolean.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsJSONBoolean("true"))   // true
+//	fmt.Println(IsJSONBoolean(false))    // true
+//	fmt.Println(IsJSONBoolean("True"))   // false
+//	fmt.Println(IsJSONBoolean(`"true"`)) // false
+func IsJSONBoolean(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsJSONBoolean", time.Now(), &passed)
+	}
+	kind := jsonValueKind(a)
+	return kind == 't' || kind == 'f'
+}
+
+// HasMaxDepth checks if a given value is valid JSON (see IsJSONValue) whose objects and arrays do not nest deeper
+// than the given maximum. Each object or array adds a level, so a scalar has a depth of 0, "[1]" a depth of 1 and
+// `{"a": [1]}` a depth of 2. The document is walked token by token and rejected as soon as the maximum is
+// exceeded, so deeply nested payloads are not fully decoded.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being decoded.
+//   - d: The maximum allowed depth.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is valid JSON within the maximum depth.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(HasMaxDepth(`{"a": [1]}`, 2))             // true
+//	fmt.Println(HasMaxDepth(`{"a": {"b": {"c": 1}}}`, 2)) // false
+//	fmt.Println(HasMaxDepth(`"text"`, 0))                 // true
+//	fmt.Println(HasMaxDepth(`{"a": [1}`, 5))              // false
+func HasMaxDepth(a any, d int) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("HasMaxDepth", time.Now(), &passed)
+	}
+	return walkJSON(toBytes(a), func(_ json.Token, depth int, _ bool) bool {
+		return depth <= d
+	})
+}
+
+// HasMaxTotalKeys checks if a given value is valid JSON (see IsJSONValue) whose objects have, summed across every
+// level, at most n keys. The document is walked token by token and rejected as soon as the maximum is exceeded.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being decoded.
+//   - n: The maximum allowed number of keys.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is valid JSON within the maximum number of keys.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(HasMaxTotalKeys(`{"a": 1, "b": {"c": 2}}`, 3)) // true
+//	fmt.Println(HasMaxTotalKeys(`[{"a": 1}, {"a": 2}]`, 1))    // false
+//	fmt.Println(HasMaxTotalKeys(`[1, 2, 3]`, 0))               // true
+func HasMaxTotalKeys(a any, n int) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("HasMaxTotalKeys", time.Now(), &passed)
+	}
+	keys := 0
+	return walkJSON(toBytes(a), func(_ json.Token, _ int, key bool) bool {
+		if key {
+			keys++
+		}
+		return keys <= n
+	})
+}
+
+// HasMaxStringValueLength checks if a given value is valid JSON (see IsJSONValue) whose string values have at
+// most n characters each. Object keys are not string values and are not limited. The document is walked token by
+// token and rejected as soon as a longer string is found.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being decoded.
+//   - n: The maximum allowed length, in characters, of each string value.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is valid JSON within the maximum string length.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(HasMaxStringValueLength(`{"name": "Maria"}`, 5))        // true
+//	fmt.Println(HasMaxStringValueLength(`["short", "much longer"]`, 5)) // false
+//	fmt.Println(HasMaxStringValueLength(`{"a_long_key": 1}`, 1))        // true
+func HasMaxStringValueLength(a any, n int) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("HasMaxStringValueLength", time.Now(), &passed)
+	}
+	return walkJSON(toBytes(a), func(token json.Token, _ int, key bool) bool {
+		s, ok := token.(string)
+		return key || !ok || utf8.RuneCountInString(s) <= n
+	})
+}
+
+// walkJSON walks the JSON text in b token by token, calling visit with each token, the depth of the containers
+// open after it is read and whether it is an object key. It returns false as soon as visit does, or when b is not
+// a single valid JSON text.
+func walkJSON(b []byte, visit func(token json.Token, depth int, key bool) bool) bool {
+	type container struct {
+		object    bool
+		expectKey bool
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var stack []*container
+	complete := false
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return complete
+		} else if err != nil || complete {
+			return false
+		}
+
+		var parent *container
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1]
+		}
+		key := parent != nil && parent.object && parent.expectKey
+
+		switch token {
+		case json.Delim('}'), json.Delim(']'):
+			stack, key = stack[:len(stack)-1], false
+		default:
+			if parent != nil && parent.object {
+				parent.expectKey = !key
+			}
+			if token == json.Delim('{') || token == json.Delim('[') {
+				stack = append(stack, &container{object: token == json.Delim('{'), expectKey: true})
+			}
+		}
+		complete = len(stack) == 0
+
+		if !visit(token, len(stack), key) {
+			return false
+		}
+	}
+}
+
+// KeysMatchConvention checks if every key of a given JSON document, at any depth and including the keys of
+// objects nested in arrays, follows the given naming convention. Maps and structs are checked as the JSON they
+// are encoded to, so struct fields are checked by their JSON tag names. A valid document without any object
+// passes, while invalid JSON fails.
+//
+// The conventions accept ASCII letters and digits only, starting with a letter:
+//   - NamingConventionSnakeCase: lowercase words separated by "_", such as "created_at".
+//   - NamingConventionCamelCase: a lowercase first letter and no separator, such as "createdAt".
+//   - NamingConventionPascalCase: an uppercase first letter and no separator, such as "CreatedAt".
+//   - NamingConventionKebabCase: lowercase words separated by "-", such as "created-at".
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being decoded.
+//   - conv: The naming convention every key must follow.
+//
+// Returns:
+//   - bool: A boolean value indicating whether all the keys follow the naming convention.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//   - The function will panic if conv is not one of the NamingConvention constants.
+//
+// Example:
+//
+//	fmt.Println(KeysMatchConvention(`{"user_id": [{"unit_price": 2}]}`, NamingConventionSnakeCase)) // true
+//	fmt.Println(KeysMatchConvention(`{"userId": [{"unit_price": 2}]}`, NamingConventionCamelCase))  // false
+//	fmt.Println(KeysMatchConvention(map[string]int{"max-age": 60}, NamingConventionKebabCase))      // true
+func KeysMatchConvention(a any, conv NamingConvention) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("KeysMatchConvention", time.Now(), &passed)
+	}
+	regex := namingConventionRegex(conv, true)
+	return walkJSON(toBytes(a), func(token json.Token, _ int, key bool) bool {
+		return !key || regex.MatchString(token.(string))
+	})
+}
+
+// EqualsCanonicalJSON checks if two given values are the same JSON document once canonicalized: insignificant
+// whitespace and the order of object keys are ignored, and numbers are compared by their exact value, so "1",
+// "1.0" and "1e0" are equal. Arrays are compared element by element in order, and both values must be valid JSON.
+// It suits idempotency checks and webhook replay detection, where byte equality is too strict.
+//
+// Parameters:
+//   - a: The first value to be compared. It is converted to bytes before being decoded.
+//   - b: The second value to be compared. It is converted to bytes before being decoded.
+//
+// Returns:
+//   - bool: A boolean value indicating whether both values are valid JSON with the same canonical form.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(EqualsCanonicalJSON(`{"a": 1, "b": [true]}`, `{"b":[true],"a":1.0}`)) // true
+//	fmt.Println(EqualsCanonicalJSON(`[1, 2]`, `[2, 1]`))                              // false
+//	fmt.Println(EqualsCanonicalJSON(`{"price": 1e2}`, map[string]int{"price": 100}))  // true
+//	fmt.Println(EqualsCanonicalJSON(`{"a": 1}`, `{"a": 1`))                           // false
+func EqualsCanonicalJSON(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("EqualsCanonicalJSON", time.Now(), &passed)
+	}
+	x, ok := decodeJSONNumbers(toBytes(a))
+	if !ok {
+		return false
+	}
+	y, ok := decodeJSONNumbers(toBytes(b))
+	return ok && canonicalJSONEquals(x, y)
+}
+
+// decodeJSONNumbers decodes a single JSON text, keeping its numbers as json.Number so that they can be compared
+// exactly.
+func decodeJSONNumbers(b []byte) (any, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false
+	}
+	_, err := decoder.Token()
+	return value, err == io.EOF
+}
+
+// canonicalJSONEquals compares two values decoded by decodeJSONNumbers, ignoring the order of object keys and
+// comparing numbers by their exact value.
+func canonicalJSONEquals(x, y any) bool {
+	switch x := x.(type) {
+	case map[string]any:
+		y, ok := y.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for key, value := range x {
+			other, ok := y[key]
+			if !ok || !canonicalJSONEquals(value, other) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		y, ok := y.([]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !canonicalJSONEquals(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		y, ok := y.(json.Number)
+		if !ok {
+			return false
+		}
+		return normalizeJSONNumber(x) == normalizeJSONNumber(y)
+	default:
+		return x == y
+	}
+}
+
+// normalizeJSONNumber rewrites a valid JSON number as its significant digits and a power of ten, such as "1e2"
+// for "100", "1.00E2" or "10e1", so that numbers of equal value have the same form without being parsed into a
+// float, which would lose precision, or a big number, which could grow without bound with large exponents.
+func normalizeJSONNumber(n json.Number) string {
+	mantissa, exponent, _ := strings.Cut(strings.ToLower(n.String()), "e")
+	exp, err := strconv.Atoi(strings.TrimPrefix(exponent, "+"))
+	if exponent != "" && err != nil {
+		return n.String()
+	}
+
+	sign := ""
+	if strings.HasPrefix(mantissa, "-") {
+		sign, mantissa = "-", mantissa[1:]
+	}
+	integer, fraction, _ := strings.Cut(mantissa, ".")
+	exp -= len(fraction)
+
+	digits := strings.TrimLeft(integer+fraction, "0")
+	if digits == "" {
+		return "0"
+	}
+	trimmed := strings.TrimRight(digits, "0")
+	exp += len(digits) - len(trimmed)
+	return sign + trimmed + "e" + strconv.Itoa(exp)
+}
+
+// UnmarshalsInto checks if a given value is a JSON document that binds to T without surprises: it decodes into T
+// with no type mismatch, no unknown field at any depth and no trailing data. Fields of T missing from the value
+// are left at their zero value, as the json package does, and do not make the check fail. It lets a gateway
+// reject a payload before handing it to code expecting a T. Use CanUnmarshalInto to know why the check fails.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being decoded.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value binds to T.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	type User struct {
+//		Name  string `json:"name"`
+//		Email string `json:"email,omitempty"`
+//	}
+//
+//	fmt.Println(UnmarshalsInto[User](`{"name":"Maria"}`))              // true
+//	fmt.Println(UnmarshalsInto[User](`{"name":"Maria","admin":true}`)) // false
+//	fmt.Println(UnmarshalsInto[User](`{"email":"maria@example.com"}`)) // true
+//	fmt.Println(UnmarshalsInto[User](`{"name":42}`))                   // false
+func UnmarshalsInto[T any](a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("UnmarshalsInto", time.Now(), &passed)
+	}
+	return unmarshalInto[T](toBytes(a)) == nil
+}
+
+// CanUnmarshalInto checks if a given value binds to T by the same rules as UnmarshalsInto, explaining why when it
+// does not. When T is a struct and the value has unknown top-level fields, they are all reported together as
+// Errors, with one FieldError per field, followed by the fields of T that are missing from the value and not
+// tagged "omitempty", as a hint of what the value was meant to carry. Other failures, such as a type mismatch,
+// are reported with the error of the json package. Missing fields alone never make the check fail.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being decoded.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value binds to T.
+//   - error: The reason why the value does not bind, Errors listing the unknown and missing fields, or nil.
+//
+// Example:
+//
+//	type User struct {
+//		Name  string `json:"name"`
+//		Email string `json:"email,omitempty"`
+//	}
+//
+//	fmt.Println(CanUnmarshalInto[User](`{"name":"Maria"}`)) // true <nil>
+//	fmt.Println(CanUnmarshalInto[User](`{"admin":true}`))   // false admin: is unknown; name: is missing
+//	fmt.Println(CanUnmarshalInto[User](nil))                // false error getting a string: value is nil
+func CanUnmarshalInto[T any](a any) (bool, error) {
+	s, err := toStringWithErr(a)
+	if err != nil {
+		return false, err
+	}
+	err = unmarshalInto[T]([]byte(s))
+	return err == nil, err
+}
+
+// unmarshalInto decodes b into a new T, rejecting any unknown field, type mismatch or trailing data. When T is a
+// struct, b is an object and the decoding fails with unknown top-level fields, these are reported along with the
+// missing ones instead of the error of the json package.
+func unmarshalInto[T any](b []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+
+	var target T
+	if err := decoder.Decode(&target); err != nil {
+		targetType := reflect.TypeOf((*T)(nil)).Elem()
+		for targetType.Kind() == reflect.Pointer {
+			targetType = targetType.Elem()
+		}
+		if object, ok := unmarshalJSONObject(b); ok && object != nil && targetType.Kind() == reflect.Struct {
+			if errs, unknown := checkJSONFields(object, jsonFields(targetType)); unknown {
+				return errs
+			}
+		}
+		return err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return errors.New("json: unexpected data after top-level value")
+	}
+	return nil
+}
+
+// jsonField is a struct field as seen by the json package, under the key it is encoded with.
+type jsonField struct {
+	name     string
+	required bool
+}
+
+// jsonFields lists the fields the json package decodes into a struct type, following its tag rules: fields tagged
+// "-" and unexported fields are skipped, and the fields of untagged embedded structs are promoted. A field is
+// required unless it is tagged "omitempty", which only matters to the hints of CanUnmarshalInto.
+func jsonFields(structType reflect.Type) []jsonField {
+	var fields []jsonField
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			fields = append(fields, jsonFields(fieldType)...)
+			continue
+		} else if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, jsonField{
+			name:     name,
+			required: !contains(strings.Split(options, ","), "omitempty"),
+		})
+	}
+	return fields
+}
+
+// checkJSONFields reports the keys of object that match no field, in sorted order, followed by the required
+// fields that match no key, and whether any key is unknown. Keys are matched case-insensitively, as the json
+// package does.
+func checkJSONFields(object map[string]json.RawMessage, fields []jsonField) (Errors, bool) {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs Errors
+	for _, key := range keys {
+		errs.Add(key, containsOnSlice(fields, func(_ int, field jsonField) bool {
+			return strings.EqualFold(field.name, key)
+		}), "is unknown")
+	}
+	unknown := errs.HasAny()
+	for _, field := range fields {
+		errs.Add(field.name, !field.required || containsOnSlice(keys, func(_ int, key string) bool {
+			return strings.EqualFold(field.name, key)
+		}), "is missing")
+	}
+	return errs, unknown
+}
+
+// jsonValueKind returns the first byte of a valid JSON text, which tells its type apart: '{', '[', '"', 't', 'f',
+// 'n', '-' or a digit. It returns 0 when the value is not valid JSON.
+func jsonValueKind(a any) byte {
+	b := bytes.Trim(toBytes(a), " \t\r\n")
+	if !json.Valid(b) {
+		return 0
+	}
+	return b[0]
+}
+
+// unmarshalJSONObject unmarshals a value as a JSON object, returning a nil map for the literal "null".
+func unmarshalJSONObject(a any) (map[string]json.RawMessage, bool) {
+	var object map[string]json.RawMessage
+	err := json.Unmarshal(toBytes(a), &object)
+	return object, err == nil
+}
+
+// unmarshalJSONArray unmarshals a value as a JSON array, returning a nil slice for the literal "null".
+func unmarshalJSONArray(a any) ([]json.RawMessage, bool) {
+	var array []json.RawMessage
+	err := json.Unmarshal(toBytes(a), &array)
+	return array, err == nil
+}
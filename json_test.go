@@ -0,0 +1,586 @@
+package checker
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type jsonOptionsCase struct {
+	name  string
+	arg   any
+	opts  JSONOptions
+	want  bool
+	panic bool
+}
+
+func runJSONOptionsCases(t *testing.T, name string, fn func(any, JSONOptions) bool, tests []jsonOptionsCase) {
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := fn(tc.arg, tc.opts); got != tc.want {
+				t.Errorf("%s(%v, %+v) = %v, want %v", name, tc.arg, tc.opts, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsMapWithOptions(t *testing.T) {
+	runJSONOptionsCases(t, "IsMapWithOptions", IsMapWithOptions, []jsonOptionsCase{
+		{name: "Object", arg: `{"key":"value"}`, want: true},
+		{name: "ObjectRejectNull", arg: `{"key":"value"}`, opts: JSONOptions{RejectNull: true}, want: true},
+		{name: "EmptyRejectNull", arg: `{}`, opts: JSONOptions{RejectNull: true}, want: true},
+		{name: "Null", arg: "null", want: true},
+		{name: "NullRejectNull", arg: " null ", opts: JSONOptions{RejectNull: true}, want: false},
+		{name: "Array", arg: `[1]`, want: false},
+		{name: "NotJSON", arg: "hello", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	})
+}
+
+func TestIsSliceWithOptions(t *testing.T) {
+	runJSONOptionsCases(t, "IsSliceWithOptions", IsSliceWithOptions, []jsonOptionsCase{
+		{name: "Array", arg: `[1, 2, 3]`, want: true},
+		{name: "ArrayRejectNull", arg: `[1, 2, 3]`, opts: JSONOptions{RejectNull: true}, want: true},
+		{name: "EmptyRejectNull", arg: `[]`, opts: JSONOptions{RejectNull: true}, want: true},
+		{name: "Null", arg: "null", want: true},
+		{name: "NullRejectNull", arg: "null", opts: JSONOptions{RejectNull: true}, want: false},
+		{name: "Object", arg: `{"key":"value"}`, want: false},
+		{name: "Nil", arg: nil, panic: true},
+	})
+}
+
+func TestIsJSONNull(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Null", arg: "null", want: true},
+		{name: "Whitespace", arg: " null\n", want: true},
+		{name: "Bytes", arg: []byte("null"), want: true},
+		{name: "QuotedNull", arg: `"null"`, want: false},
+		{name: "EmptyObject", arg: "{}", want: false},
+		{name: "False", arg: "false", want: false},
+		{name: "NotJSON", arg: "nil", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsJSONNull(tc.arg); got != tc.want {
+				t.Errorf("IsJSONNull(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsEmptyJSONObject(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Empty", arg: "{}", want: true},
+		{name: "Whitespace", arg: "{ \n }", want: true},
+		{name: "Bytes", arg: []byte("{}"), want: true},
+		{name: "WithMembers", arg: `{"key":"value"}`, want: false},
+		{name: "EmptyArray", arg: "[]", want: false},
+		{name: "Null", arg: "null", want: false},
+		{name: "NotJSON", arg: "hello", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsEmptyJSONObject(tc.arg); got != tc.want {
+				t.Errorf("IsEmptyJSONObject(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsEmptyJSONArray(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Empty", arg: "[]", want: true},
+		{name: "Whitespace", arg: "[ ]", want: true},
+		{name: "WithElements", arg: "[1]", want: false},
+		{name: "EmptyObject", arg: "{}", want: false},
+		{name: "Null", arg: "null", want: false},
+		{name: "NotJSON", arg: "hello", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsEmptyJSONArray(tc.arg); got != tc.want {
+				t.Errorf("IsEmptyJSONArray(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsNonEmptyJSON(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Object", arg: `{"key":"value"}`, want: true},
+		{name: "Array", arg: "[1]", want: true},
+		{name: "ArrayOfNull", arg: "[null]", want: true},
+		{name: "EmptyObject", arg: "{}", want: false},
+		{name: "EmptyArray", arg: "[]", want: false},
+		{name: "Null", arg: "null", want: false},
+		{name: "Number", arg: "42", want: false},
+		{name: "NotJSON", arg: "hello", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsNonEmptyJSON(tc.arg); got != tc.want {
+				t.Errorf("IsNonEmptyJSON(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsJSONValue(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Object", arg: `{"key":"value"}`, want: true},
+		{name: "Array", arg: "[1, 2]", want: true},
+		{name: "String", arg: `"text"`, want: true},
+		{name: "Number", arg: "42", want: true},
+		{name: "HugeNumber", arg: "1e400", want: true},
+		{name: "Boolean", arg: "true", want: true},
+		{name: "Null", arg: "null", want: true},
+		{name: "Whitespace", arg: " 42 \n", want: true},
+		{name: "Int", arg: 42, want: true},
+		{name: "Bare", arg: "text", want: false},
+		{name: "Trailing", arg: "{} {}", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsJSONValue(tc.arg); got != tc.want {
+				t.Errorf("IsJSONValue(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsJSONString(t *testing.T) {
+	testCases := []baseCase{
+		{name: "String", arg: `"text"`, want: true},
+		{name: "Escaped", arg: `"line\nbreak ç"`, want: true},
+		{name: "EmptyString", arg: `""`, want: true},
+		{name: "Whitespace", arg: " \"text\" ", want: true},
+		{name: "Unquoted", arg: "text", want: false},
+		{name: "BadEscape", arg: `"\x"`, want: false},
+		{name: "Unterminated", arg: `"text`, want: false},
+		{name: "Null", arg: "null", want: false},
+		{name: "Number", arg: "42", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsJSONString(tc.arg); got != tc.want {
+				t.Errorf("IsJSONString(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsJSONNumber(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Integer", arg: "42", want: true},
+		{name: "Negative", arg: "-1.5", want: true},
+		{name: "Exponent", arg: "6.02e23", want: true},
+		{name: "HugeNumber", arg: "1e400", want: true},
+		{name: "Int", arg: 42, want: true},
+		{name: "Float", arg: -1.5, want: true},
+		{name: "Plus", arg: "+1", want: false},
+		{name: "LeadingZero", arg: "01", want: false},
+		{name: "LeadingDot", arg: ".5", want: false},
+		{name: "NaN", arg: "NaN", want: false},
+		{name: "NonJSONSpace", arg: "\u00a042", want: false},
+		{name: "Quoted", arg: `"42"`, want: false},
+		{name: "Null", arg: "null", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsJSONNumber(tc.arg); got != tc.want {
+				t.Errorf("IsJSONNumber(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsJSONBoolean(t *testing.T) {
+	testCases := []baseCase{
+		{name: "True", arg: "true", want: true},
+		{name: "False", arg: "false", want: true},
+		{name: "Bool", arg: false, want: true},
+		{name: "Capitalized", arg: "True", want: false},
+		{name: "Quoted", arg: `"true"`, want: false},
+		{name: "Null", arg: "null", want: false},
+		{name: "Number", arg: "1", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsJSONBoolean(tc.arg); got != tc.want {
+				t.Errorf("IsJSONBoolean(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+type unmarshalAudit struct {
+	CreatedBy string `json:"created_by"`
+}
+
+type unmarshalUser struct {
+	unmarshalAudit
+	Name     string            `json:"name"`
+	Email    string            `json:"email,omitempty"`
+	Age      int               `json:"age,omitempty"`
+	Address  *unmarshalAddress `json:"address,omitempty"`
+	Password string            `json:"-"`
+	internal string
+}
+
+type unmarshalAddress struct {
+	City string `json:"city"`
+}
+
+func TestUnmarshalsInto(t *testing.T) {
+	tests := []baseCase{
+		{name: "Required", arg: `{"created_by":"admin","name":"Maria"}`, want: true},
+		{name: "Complete", arg: `{"created_by":"admin","name":"Maria","email":"maria@example.com","age":30,` +
+			`"address":{"city":"Recife"}}`, want: true},
+		{name: "CaseInsensitive", arg: `{"Created_By":"admin","NAME":"Maria"}`, want: true},
+		{name: "Bytes", arg: []byte(`{"created_by":"admin","name":"Maria"}`), want: true},
+		{name: "Unknown", arg: `{"created_by":"admin","name":"Maria","admin":true}`, want: false},
+		{name: "Skipped", arg: `{"created_by":"admin","name":"Maria","Password":"secret"}`, want: false},
+		{name: "Missing", arg: `{"created_by":"admin","email":"maria@example.com"}`, want: true},
+		{name: "MissingPromoted", arg: `{"name":"Maria"}`, want: true},
+		{name: "Empty", arg: `{}`, want: true},
+		{name: "NestedUnknown", arg: `{"created_by":"admin","name":"Maria","address":{"zip":"50000"}}`, want: false},
+		{name: "TypeMismatch", arg: `{"created_by":"admin","name":42}`, want: false},
+		{name: "Trailing", arg: `{"created_by":"admin","name":"Maria"} {}`, want: false},
+		{name: "Array", arg: `[1, 2]`, want: false},
+		{name: "NotJSON", arg: "hello", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := UnmarshalsInto[unmarshalUser](tc.arg); got != tc.want {
+				t.Errorf("UnmarshalsInto(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalsIntoNonStruct(t *testing.T) {
+	if !UnmarshalsInto[[]int](`[1, 2]`) {
+		t.Errorf("UnmarshalsInto[[]int]() = false, want true")
+	}
+	if !UnmarshalsInto[map[string]int](`{"any":1}`) {
+		t.Errorf("UnmarshalsInto[map[string]int]() = false, want true")
+	}
+	if !UnmarshalsInto[*unmarshalAddress](`{"city":"Recife"}`) {
+		t.Errorf("UnmarshalsInto[*unmarshalAddress]() = false, want true")
+	}
+	if UnmarshalsInto[*unmarshalAddress](`{"zip":"50000"}`) {
+		t.Errorf("UnmarshalsInto[*unmarshalAddress]() = true, want false")
+	}
+}
+
+func TestCanUnmarshalInto(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     any
+		want    bool
+		wantErr string
+	}{
+		{name: "Valid", arg: `{"created_by":"admin","name":"Maria"}`, want: true},
+		{name: "Missing", arg: `{"name":"Maria"}`, want: true},
+		{name: "UnknownWithoutMissing", arg: `{"created_by":"admin","name":"Maria","admin":true}`,
+			wantErr: "admin: is unknown"},
+		{name: "UnknownAndMissing", arg: `{"zip":"50000","admin":true}`,
+			wantErr: "admin: is unknown; zip: is unknown; created_by: is missing; name: is missing"},
+		{name: "TypeMismatch", arg: `{"created_by":"admin","name":42}`,
+			wantErr: "json: cannot unmarshal number into Go struct field unmarshalUser.name of type string"},
+		{name: "NestedUnknown", arg: `{"created_by":"admin","name":"Maria","address":{"zip":"50000"}}`,
+			wantErr: `json: unknown field "zip"`},
+		{name: "Trailing", arg: `{"created_by":"admin","name":"Maria"} {}`,
+			wantErr: "json: unexpected data after top-level value"},
+		{name: "Nil", arg: nil, wantErr: "error getting a string: value is nil"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CanUnmarshalInto[unmarshalUser](tc.arg)
+			if got != tc.want {
+				t.Errorf("CanUnmarshalInto(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+			if (err == nil && tc.wantErr != "") || (err != nil && err.Error() != tc.wantErr) {
+				t.Errorf("CanUnmarshalInto(%v) error = %v, want %q", tc.arg, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestCanUnmarshalIntoFieldErrors(t *testing.T) {
+	_, err := CanUnmarshalInto[unmarshalUser](`{"admin":true}`)
+
+	var errs Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("CanUnmarshalInto() error = %T, want Errors", err)
+	}
+	want := Errors{{Field: "admin", Message: "is unknown"}, {Field: "created_by", Message: "is missing"},
+		{Field: "name", Message: "is missing"}}
+	if !reflect.DeepEqual(errs, want) {
+		t.Errorf("CanUnmarshalInto() errors = %v, want %v", errs, want)
+	}
+}
+
+type jsonLimitCase struct {
+	name  string
+	arg   any
+	limit int
+	want  bool
+	panic bool
+}
+
+func runJSONLimitCases(t *testing.T, name string, fn func(any, int) bool, tests []jsonLimitCase) {
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := fn(tc.arg, tc.limit); got != tc.want {
+				t.Errorf("%s(%v, %v) = %v, want %v", name, tc.arg, tc.limit, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHasMaxDepth(t *testing.T) {
+	runJSONLimitCases(t, "HasMaxDepth", HasMaxDepth, []jsonLimitCase{
+		{name: "Scalar", arg: `"text"`, limit: 0, want: true},
+		{name: "Array", arg: `[1]`, limit: 1, want: true},
+		{name: "ArrayOverLimit", arg: `[1]`, limit: 0, want: false},
+		{name: "Nested", arg: `{"a": [1]}`, limit: 2, want: true},
+		{name: "NestedOverLimit", arg: `{"a": {"b": {"c": 1}}}`, limit: 2, want: false},
+		{name: "Siblings", arg: `[[1], [2], {"a": 3}]`, limit: 2, want: true},
+		{name: "Deep", arg: strings.Repeat("[", 10000) + strings.Repeat("]", 10000), limit: 64, want: false},
+		{name: "DeepUnterminated", arg: strings.Repeat("[", 10000), limit: 64, want: false},
+		{name: "Bytes", arg: []byte(`{"a": 1}`), limit: 1, want: true},
+		{name: "Malformed", arg: `{"a": [1}`, limit: 5, want: false},
+		{name: "Trailing", arg: `{} {}`, limit: 5, want: false},
+		{name: "Empty", arg: "", limit: 5, want: false},
+		{name: "Nil", arg: nil, limit: 5, panic: true},
+	})
+}
+
+func TestHasMaxTotalKeys(t *testing.T) {
+	runJSONLimitCases(t, "HasMaxTotalKeys", HasMaxTotalKeys, []jsonLimitCase{
+		{name: "Nested", arg: `{"a": 1, "b": {"c": 2}}`, limit: 3, want: true},
+		{name: "NestedOverLimit", arg: `{"a": 1, "b": {"c": 2}}`, limit: 2, want: false},
+		{name: "AcrossArray", arg: `[{"a": 1}, {"a": 2}]`, limit: 1, want: false},
+		{name: "StringValuesNotKeys", arg: `{"a": "b", "c": ["d", "e"]}`, limit: 2, want: true},
+		{name: "ObjectValues", arg: `{"a": {}, "b": {"c": {}}, "d": 1}`, limit: 4, want: true},
+		{name: "ObjectValuesOverLimit", arg: `{"a": {}, "b": {"c": {}}, "d": 1}`, limit: 3, want: false},
+		{name: "Array", arg: `[1, 2, 3]`, limit: 0, want: true},
+		{name: "EmptyObject", arg: `{}`, limit: 0, want: true},
+		{name: "Malformed", arg: `{"a" 1}`, limit: 5, want: false},
+		{name: "Nil", arg: nil, limit: 5, panic: true},
+	})
+}
+
+func TestHasMaxStringValueLength(t *testing.T) {
+	runJSONLimitCases(t, "HasMaxStringValueLength", HasMaxStringValueLength, []jsonLimitCase{
+		{name: "Object", arg: `{"name": "Maria"}`, limit: 5, want: true},
+		{name: "ArrayOverLimit", arg: `["short", "much longer"]`, limit: 5, want: false},
+		{name: "KeysNotLimited", arg: `{"a_long_key": 1}`, limit: 1, want: true},
+		{name: "ValueAfterNestedObject", arg: `{"a": {"b": "c"}, "d": "long"}`, limit: 3, want: false},
+		{name: "KeyAfterNestedObject", arg: `{"a": {"b": "c"}, "long_key": "d"}`, limit: 1, want: true},
+		{name: "Runes", arg: `"ção"`, limit: 3, want: true},
+		{name: "Escapes", arg: `"ç\n"`, limit: 2, want: true},
+		{name: "TopLevelString", arg: `"text"`, limit: 3, want: false},
+		{name: "Malformed", arg: `["text"`, limit: 10, want: false},
+		{name: "Nil", arg: nil, limit: 5, panic: true},
+	})
+}
+
+type conventionCase struct {
+	name  string
+	arg   any
+	conv  NamingConvention
+	want  bool
+	panic bool
+}
+
+func TestKeysMatchConvention(t *testing.T) {
+	type snakeDTO struct {
+		UserID    int `json:"user_id"`
+		CreatedAt int `json:"created_at"`
+	}
+	type untaggedDTO struct {
+		UserID int
+	}
+	tests := []conventionCase{
+		{name: "Snake", arg: `{"user_id": 1, "items": [{"unit_price": 2}]}`, conv: NamingConventionSnakeCase, want: true},
+		{name: "SnakeWithDigits", arg: `{"address_2": "x", "v1": 1}`, conv: NamingConventionSnakeCase, want: true},
+		{name: "SnakeNested", arg: `{"user": {"firstName": "Maria"}}`, conv: NamingConventionSnakeCase, want: false},
+		{name: "SnakeDoubleSeparator", arg: `{"user__id": 1}`, conv: NamingConventionSnakeCase, want: false},
+		{name: "SnakeTrailingSeparator", arg: `{"user_": 1}`, conv: NamingConventionSnakeCase, want: false},
+		{name: "Camel", arg: `{"userId": 1, "items": [{"unitPrice": 2}]}`, conv: NamingConventionCamelCase, want: true},
+		{name: "CamelInArray", arg: `[{"userId": 1}, {"unit_price": 2}]`, conv: NamingConventionCamelCase, want: false},
+		{name: "CamelUppercaseFirst", arg: `{"UserId": 1}`, conv: NamingConventionCamelCase, want: false},
+		{name: "Pascal", arg: `{"UserId": 1}`, conv: NamingConventionPascalCase, want: true},
+		{name: "PascalLowercaseFirst", arg: `{"userId": 1}`, conv: NamingConventionPascalCase, want: false},
+		{name: "Kebab", arg: map[string]int{"max-age": 60}, conv: NamingConventionKebabCase, want: true},
+		{name: "KebabSnake", arg: map[string]int{"max_age": 60}, conv: NamingConventionKebabCase, want: false},
+		{name: "ValuesIgnored", arg: `{"name": "Not Snake Case"}`, conv: NamingConventionSnakeCase, want: true},
+		{name: "Struct", arg: snakeDTO{}, conv: NamingConventionSnakeCase, want: true},
+		{name: "UntaggedStruct", arg: untaggedDTO{}, conv: NamingConventionSnakeCase, want: false},
+		{name: "NoObject", arg: `[1, "two"]`, conv: NamingConventionCamelCase, want: true},
+		{name: "EmptyKey", arg: `{"": 1}`, conv: NamingConventionCamelCase, want: false},
+		{name: "NonASCII", arg: `{"ação": 1}`, conv: NamingConventionSnakeCase, want: false},
+		{name: "NotJSON", arg: "user_id", conv: NamingConventionSnakeCase, want: false},
+		{name: "InvalidConvention", arg: `{}`, conv: NamingConvention("SCREAMING_CASE"), panic: true},
+		{name: "Nil", arg: nil, conv: NamingConventionSnakeCase, panic: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := KeysMatchConvention(tc.arg, tc.conv); got != tc.want {
+				t.Errorf("KeysMatchConvention(%v, %v) = %v, want %v", tc.arg, tc.conv, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEqualsCanonicalJSON(t *testing.T) {
+	tests := []equalsCase{
+		{name: "Identical", a: `{"a": 1}`, b: `{"a": 1}`, want: true},
+		{name: "KeyOrderAndWhitespace", a: `{"a": 1, "b": [true]}`, b: "{\n\t\"b\":[true],\"a\":1\n}", want: true},
+		{name: "NumberForms", a: `[1, 100, 0.5, -2]`, b: `[1.0, 1e2, 5E-1, -2.000]`, want: true},
+		{name: "Zeros", a: `[0, -0]`, b: `[0.0, 0e10]`, want: true},
+		{name: "PreciseNumbers", a: `[12345678901234567890]`, b: `[12345678901234567891]`, want: false},
+		{name: "HugeExponent", a: `[1e999999999999]`, b: `[1e999999999999]`, want: true},
+		{name: "Nested", a: `{"a": {"b": [1, {"c": null}]}}`, b: `{"a":{"b":[1.0,{"c":null}]}}`, want: true},
+		{name: "Map", a: `{"price": 1e2}`, b: map[string]int{"price": 100}, want: true},
+		{name: "Bytes", a: []byte(`[true]`), b: `[ true ]`, want: true},
+		{name: "Scalars", a: `"text"`, b: ` "text" `, want: true},
+		{name: "ArrayOrder", a: `[1, 2]`, b: `[2, 1]`, want: false},
+		{name: "MissingKey", a: `{"a": 1, "b": 2}`, b: `{"a": 1}`, want: false},
+		{name: "DifferentValue", a: `{"a": 1}`, b: `{"a": 2}`, want: false},
+		{name: "NumberVersusString", a: `{"a": 1}`, b: `{"a": "1"}`, want: false},
+		{name: "NullVersusMissing", a: `{"a": null}`, b: `{}`, want: false},
+		{name: "StringEscapes", a: `"\u00e7"`, b: `"ç"`, want: true},
+		{name: "InvalidFirst", a: `{"a": 1`, b: `{"a": 1}`, want: false},
+		{name: "InvalidSecond", a: `{"a": 1}`, b: `{"a": 1} {}`, want: false},
+		{name: "Nil", a: nil, b: `{}`, panic: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := EqualsCanonicalJSON(tc.a, tc.b); got != tc.want {
+				t.Errorf("EqualsCanonicalJSON(%v, %v) = %v, want %v", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"time"
+)
+
+// IsDNS1123Label checks if a given value is a DNS label as defined by RFC 1123 and enforced by Kubernetes. The
+// label must have at most 63 characters, contain only lowercase alphanumeric characters or '-', and start and end
+// with an alphanumeric character.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid DNS-1123 label.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsDNS1123Label("my-app"))  // true
+//	fmt.Println(IsDNS1123Label("My-App"))  // false
+//	fmt.Println(IsDNS1123Label("-my-app")) // false
+//	fmt.Println(IsDNS1123Label("my.app"))  // false
+func IsDNS1123Label(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsDNS1123Label", time.Now(), &passed)
+	}
+	return isDNS1123Label(a)
+}
+
+// isDNS1123Label is the unobserved implementation of IsDNS1123Label.
+func isDNS1123Label(a any) bool {
+	s := toString(a)
+	regex := regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	return len(s) <= 63 && regex.MatchString(s)
+}
+
+// IsDNS1123Subdomain checks if a given value is a DNS subdomain as defined by RFC 1123 and enforced by Kubernetes.
+// The subdomain must have at most 253 characters and be made of one or more labels separated by '.', each label
+// containing only lowercase alphanumeric characters or '-' and starting and ending with an alphanumeric character.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid DNS-1123 subdomain.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsDNS1123Subdomain("api.example.com")) // true
+//	fmt.Println(IsDNS1123Subdomain("my-app"))          // true
+//	fmt.Println(IsDNS1123Subdomain("api..example"))    // false
+//	fmt.Println(IsDNS1123Subdomain("api_example"))     // false
+func IsDNS1123Subdomain(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsDNS1123Subdomain", time.Now(), &passed)
+	}
+	return isDNS1123Subdomain(a)
+}
+
+// isDNS1123Subdomain is the unobserved implementation of IsDNS1123Subdomain.
+func isDNS1123Subdomain(a any) bool {
+	s := toString(a)
+	regex := regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	return len(s) <= 253 && regex.MatchString(s)
+}
+
+// IsK8sResourceName checks if a given value can be used as the name of most Kubernetes resources, such as pods,
+// deployments, services or config maps. It uses the IsDNS1123Subdomain function, which is the rule applied by the
+// Kubernetes API server to these names.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid Kubernetes resource name.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsK8sResourceName("web-frontend-v2")) // true
+//	fmt.Println(IsK8sResourceName("Web_Frontend"))    // false
+func IsK8sResourceName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsK8sResourceName", time.Now(), &passed)
+	}
+	return isDNS1123Subdomain(a)
+}
+
+// IsK8sNamespaceName checks if a given value can be used as the name of a Kubernetes namespace. It uses the
+// IsDNS1123Label function, since namespace names cannot contain dots and are limited to 63 characters.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a valid Kubernetes namespace name.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsK8sNamespaceName("payments-staging")) // true
+//	fmt.Println(IsK8sNamespaceName("payments.staging")) // false
+func IsK8sNamespaceName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsK8sNamespaceName", time.Now(), &passed)
+	}
+	return isDNS1123Label(a)
+}
+
+// IsSemverTag checks if a given value is a container image tag holding a semantic version (SemVer 2.0), with an
+// optional "v" prefix, such as "1.4.2", "v2.0.0" or "1.0.0-rc.1". Since image tags cannot contain '+', build
+// metadata is not accepted, and the tag must have at most 128 characters.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value is a semantic version image tag.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsSemverTag("v1.4.2"))       // true
+//	fmt.Println(IsSemverTag("1.0.0-rc.1"))   // true
+//	fmt.Println(IsSemverTag("1.0"))          // false
+//	fmt.Println(IsSemverTag("01.0.0"))       // false
+//	fmt.Println(IsSemverTag("1.0.0+build1")) // false
+//	fmt.Println(IsSemverTag("latest"))       // false
+func IsSemverTag(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSemverTag", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+		`(-((0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(\.(0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?$`)
+	return len(s) <= 128 && regex.MatchString(s)
+}
@@ -0,0 +1,153 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsDNS1123Label(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: "my-app", want: true},
+		{name: "SingleCharacter", arg: "a", want: true},
+		{name: "Numeric", arg: 123, want: true},
+		{name: "MaxLength", arg: strings.Repeat("a", 63), want: true},
+		{name: "TooLong", arg: strings.Repeat("a", 64), want: false},
+		{name: "UpperCase", arg: "My-App", want: false},
+		{name: "LeadingHyphen", arg: "-my-app", want: false},
+		{name: "TrailingHyphen", arg: "my-app-", want: false},
+		{name: "Dot", arg: "my.app", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsDNS1123Label(tc.arg); got != tc.want {
+				t.Errorf("IsDNS1123Label(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsDNS1123Subdomain(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Domain", arg: "api.example.com", want: true},
+		{name: "SingleLabel", arg: "my-app", want: true},
+		{name: "MaxLength", arg: strings.Repeat("a.", 126) + "a", want: true},
+		{name: "TooLong", arg: strings.Repeat("a.", 127) + "a", want: false},
+		{name: "EmptyLabel", arg: "api..example", want: false},
+		{name: "TrailingDot", arg: "api.example.", want: false},
+		{name: "LabelEndingWithHyphen", arg: "api-.example", want: false},
+		{name: "Underscore", arg: "api_example", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsDNS1123Subdomain(tc.arg); got != tc.want {
+				t.Errorf("IsDNS1123Subdomain(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsK8sResourceName(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Deployment", arg: "web-frontend-v2", want: true},
+		{name: "Dotted", arg: "config.v1", want: true},
+		{name: "Invalid", arg: "Web_Frontend", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsK8sResourceName(tc.arg); got != tc.want {
+				t.Errorf("IsK8sResourceName(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsK8sNamespaceName(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Namespace", arg: "payments-staging", want: true},
+		{name: "Dotted", arg: "payments.staging", want: false},
+		{name: "UpperCase", arg: "Payments", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsK8sNamespaceName(tc.arg); got != tc.want {
+				t.Errorf("IsK8sNamespaceName(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsSemverTag(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Plain", arg: "1.4.2", want: true},
+		{name: "Prefixed", arg: "v2.0.0", want: true},
+		{name: "PreRelease", arg: "1.0.0-rc.1", want: true},
+		{name: "PreReleaseAlphanumeric", arg: "1.0.0-alpha-1.0a", want: true},
+		{name: "MissingPatch", arg: "1.0", want: false},
+		{name: "LeadingZero", arg: "01.0.0", want: false},
+		{name: "PreReleaseLeadingZero", arg: "1.0.0-rc.01", want: false},
+		{name: "BuildMetadata", arg: "1.0.0+build1", want: false},
+		{name: "UpperCasePrefix", arg: "V1.0.0", want: false},
+		{name: "Latest", arg: "latest", want: false},
+		{name: "TooLong", arg: "1.0.0-" + strings.Repeat("a", 123), want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsSemverTag(tc.arg); got != tc.want {
+				t.Errorf("IsSemverTag(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"strings"
+	"sync"
+)
+
+// lazy returns a function that builds a lookup table on its first call and returns the same table on every
+// later call, so that tables such as the common passwords or the disposable email domains cost nothing to
+// programs that never use them. The build function runs at most once, even when the first calls are
+// concurrent, and every caller waits for it to finish. The table it returns is shared by all the goroutines of
+// the program and must never be modified: tables that can change at run time, such as the Rule registry, are
+// guarded by a sync.RWMutex instead.
+func lazy[T any](build func() T) func() T {
+	return sync.OnceValue(build)
+}
+
+// lazySet returns a lazy set of the lines of list, one entry per line, normalized by fold. Blank lines are
+// skipped.
+func lazySet(list string, fold func(string) string) func() map[string]struct{} {
+	return lazy(func() map[string]struct{} {
+		set := map[string]struct{}{}
+		for _, line := range strings.Split(list, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				set[fold(line)] = struct{}{}
+			}
+		}
+		return set
+	})
+}
@@ -0,0 +1,134 @@
+package checker
+
+import (
+	"os"
+	"os/exec"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	var builds atomic.Int32
+	table := lazy(func() map[string]int {
+		builds.Add(1)
+		return map[string]int{"a": 1}
+	})
+
+	var wg sync.WaitGroup
+	results := make([]map[string]int, 64)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = table()
+		}(i)
+	}
+	wg.Wait()
+
+	if got := builds.Load(); got != 1 {
+		t.Errorf("lazy() built the table %d times, want 1", got)
+	}
+	for i, result := range results {
+		if reflect.ValueOf(result).UnsafePointer() != reflect.ValueOf(results[0]).UnsafePointer() {
+			t.Fatalf("lazy() returned a different table to caller %d", i)
+		}
+	}
+}
+
+func TestLazyPanic(t *testing.T) {
+	var builds atomic.Int32
+	table := lazy(func() int {
+		builds.Add(1)
+		panic("build failed")
+	})
+
+	for i := 0; i < 2; i++ {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("The code did not panic")
+				}
+			}()
+			table()
+		}()
+	}
+	if got := builds.Load(); got != 1 {
+		t.Errorf("lazy() ran a failing build %d times, want 1", got)
+	}
+}
+
+func TestLazySet(t *testing.T) {
+	set := lazySet("Gmail.com\n\n  outlook.com  \r\nGMAIL.COM\n", func(s string) string { return s + "!" })()
+	want := map[string]struct{}{"Gmail.com!": {}, "outlook.com!": {}, "GMAIL.COM!": {}}
+	if !reflect.DeepEqual(set, want) {
+		t.Errorf("lazySet() = %v, want %v", set, want)
+	}
+}
+
+// TestConcurrentFirstUse runs every registered Rule, and the checkers backed by lazy tables that take more
+// arguments, from many goroutines released at once and checks that they all agree. The other tests build the lazy
+// tables as they go, so it re-executes the test binary to run alone in a fresh process, where every table is built
+// for the first time by the concurrent calls. Run it with the race detector, as in "go test -race", for the child
+// to report the races of the first use.
+func TestConcurrentFirstUse(t *testing.T) {
+	if os.Getenv("CHECKER_FIRST_USE_CHILD") == "" {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestConcurrentFirstUse$", "-test.count=1")
+		cmd.Env = append(os.Environ(), "CHECKER_FIRST_USE_CHILD=1")
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("TestConcurrentFirstUse failed in a fresh process: %v\n%s", err, output)
+		}
+		return
+	}
+
+	args := []any{"test@mailinator.com", "jane@acme.com", "192.168.0.1", "P@ssw0rd", "pаypal.com", "2024-01-01",
+		"12101721007", "snake_case", 42, 19.99, nil}
+	checks := map[string]func(any) bool{
+		"IsValidAmountForCurrency": func(a any) bool { return IsValidAmountForCurrency(a, "BHD") },
+		"IsEmailFromDomain":        func(a any) bool { return IsEmailFromDomain(a, "acme.com") },
+		"ContainsUserInfo":         func(a any) bool { return ContainsUserInfo(a, "Jane Doe") },
+	}
+	for _, name := range RuleNames() {
+		rule, _ := RuleByName(name)
+		checks[name] = rule
+	}
+
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const goroutines = 8
+	results := make([]map[string][]bool, goroutines)
+	for _, name := range names {
+		guarded := Guard(checks[name])
+		start := make(chan struct{})
+		var wg sync.WaitGroup
+		for g := range results {
+			if results[g] == nil {
+				results[g] = map[string][]bool{}
+			}
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				<-start
+				for _, arg := range args {
+					results[g][name] = append(results[g][name], guarded(arg))
+				}
+			}(g)
+		}
+		close(start)
+		wg.Wait()
+	}
+
+	for g := 1; g < goroutines; g++ {
+		for name, want := range results[0] {
+			if got := results[g][name]; !reflect.DeepEqual(got, want) {
+				t.Errorf("%s results differ between goroutines: %v and %v", name, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,312 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ruleRegistry stores the Rules known by RuleByName, keyed by name. It starts with every exported checker of the
+// package that fits the Rule signature, under the snake_case form of its name, and with the "is_positive" Rule of
+// policies, and grows through RegisterRule.
+var ruleRegistry = struct {
+	sync.RWMutex
+	rules map[string]Rule
+}{rules: map[string]Rule{
+	"contains_confusables":             ContainsConfusables,
+	"contains_ddl":                     ContainsDDL,
+	"contains_honorific":               ContainsHonorific,
+	"contains_initial":                 ContainsInitial,
+	"contains_mention":                 ContainsMention,
+	"contains_mixed_scripts":           ContainsMixedScripts,
+	"contains_raw_html_in_markdown":    ContainsRawHTMLInMarkdown,
+	"decodes_to_json":                  DecodesToJSON,
+	"decodes_to_utf8":                  DecodesToUTF8,
+	"has_balanced_braces":              HasBalancedBraces,
+	"has_safe_markdown_links":          HasSafeMarkdownLinks,
+	"has_surname":                      HasSurname,
+	"is_after_now":                     IsAfterNow,
+	"is_after_today":                   IsAfterToday,
+	"is_alpha":                         IsAlpha,
+	"is_alpha_space":                   IsAlphaSpace,
+	"is_android_device_id":             IsAndroidDeviceID,
+	"is_arithmetic_expression":         IsArithmeticExpression,
+	"is_array_type":                    IsArrayType,
+	"is_aws_arn":                       IsAWSARN,
+	"is_azure_resource_id":             IsAzureResourceID,
+	"is_base64":                        IsBase64,
+	"is_base64_raw_std":                IsBase64RawStd,
+	"is_base64_raw_url":                IsBase64RawURL,
+	"is_base64_url":                    IsBase64URL,
+	"is_bearer":                        IsBearer,
+	"is_before_now":                    IsBeforeNow,
+	"is_before_today":                  IsBeforeToday,
+	"is_big_int":                       IsBigInt,
+	"is_bool":                          IsBool,
+	"is_bool_type":                     IsBoolType,
+	"is_brazil_landline":               IsBrazilLandline,
+	"is_brazil_mobile":                 IsBrazilMobile,
+	"is_brazil_municipality_ibge_code": IsBrazilMunicipalityIBGECode,
+	"is_brazil_uf":                     IsBrazilUF,
+	"is_brazilian_holiday":             IsBrazilianHoliday,
+	"is_byte_unit":                     IsByteUnit,
+	"is_bytes_type":                    IsBytesType,
+	"is_camel_case":                    IsCamelCase,
+	"is_cep":                           IsCEP,
+	"is_chan_type":                     IsChanType,
+	"is_cipher_suite_name":             IsCipherSuiteName,
+	"is_cnpj":                          IsCNPJ,
+	"is_common_password":               IsCommonPassword,
+	"is_complement":                    IsComplement,
+	"is_corporate_email":               IsCorporateEmail,
+	"is_cpf":                           IsCPF,
+	"is_cpf_or_cnpj":                   IsCPFOrCNPJ,
+	"is_cte_key":                       IsCTeKey,
+	"is_curve_name":                    IsCurveName,
+	"is_decimal_string":                IsDecimalString,
+	"is_disposable_email":              IsDisposableEmail,
+	"is_dns1123_label":                 IsDNS1123Label,
+	"is_dns1123_subdomain":             IsDNS1123Subdomain,
+	"is_docker_image_reference":        IsDockerImageReference,
+	"is_duration":                      IsDuration,
+	"is_duration_type":                 IsDurationType,
+	"is_email":                         IsEmail,
+	"is_emoji_shortcode":               IsEmojiShortcode,
+	"is_empty":                         IsEmpty,
+	"is_empty_json_array":              IsEmptyJSONArray,
+	"is_empty_json_object":             IsEmptyJSONObject,
+	"is_empty_strict":                  IsEmptyStrict,
+	"is_enum_valid":                    IsEnumValid,
+	"is_error_type":                    IsErrorType,
+	"is_float":                         IsFloat,
+	"is_float32_type":                  IsFloat32Type,
+	"is_float64_type":                  IsFloat64Type,
+	"is_free_email":                    IsFreeEmail,
+	"is_full_name":                     IsFullName,
+	"is_func_type":                     IsFuncType,
+	"is_gcp_project_id":                IsGCPProjectID,
+	"is_graphql_document":              IsGraphQLDocument,
+	"is_graphql_operation_name":        IsGraphQLOperationName,
+	"is_hashtag":                       IsHashtag,
+	"is_header_value_safe":             IsHeaderValueSafe,
+	"is_hex_color":                     IsHexColor,
+	"is_hidden_file_name":              IsHiddenFileName,
+	"is_house_number":                  IsHouseNumber,
+	"is_http_method":                   IsHTTPMethod,
+	"is_icu_message_format":            IsICUMessageFormat,
+	"is_idempotency_key":               IsIdempotencyKey,
+	"is_image_digest":                  IsImageDigest,
+	"is_image_tag":                     IsImageTag,
+	"is_int":                           IsInt,
+	"is_int16_type":                    IsInt16Type,
+	"is_int32_type":                    IsInt32Type,
+	"is_int64_string":                  IsInt64String,
+	"is_int64_type":                    IsInt64Type,
+	"is_int8_type":                     IsInt8Type,
+	"is_int_type":                      IsIntType,
+	"is_ios_device_id":                 IsIOSDeviceID,
+	"is_iso_week":                      IsISOWeek,
+	"is_ispb":                          IsISPB,
+	"is_json":                          IsJSON,
+	"is_json_boolean":                  IsJSONBoolean,
+	"is_json_merge_patch":              IsJSONMergePatch,
+	"is_json_null":                     IsJSONNull,
+	"is_json_number":                   IsJSONNumber,
+	"is_json_patch":                    IsJSONPatch,
+	"is_json_string":                   IsJSONString,
+	"is_json_value":                    IsJSONValue,
+	"is_k8s_namespace_name":            IsK8sNamespaceName,
+	"is_k8s_resource_name":             IsK8sResourceName,
+	"is_kafka_topic_name":              IsKafkaTopicName,
+	"is_kebab_case":                    IsKebabCase,
+	"is_map":                           IsMap,
+	"is_map_type":                      IsMapType,
+	"is_marital_status":                IsMaritalStatus,
+	"is_markdown":                      IsMarkdown,
+	"is_mobile_device_id":              IsMobileDeviceID,
+	"is_mobile_platform":               IsMobilePlatform,
+	"is_mongo_uri":                     IsMongoURI,
+	"is_monotonic_increasing":          IsMonotonicIncreasing,
+	"is_mysql_dsn":                     IsMySQLDSN,
+	"is_nfce_key":                      IsNFCeKey,
+	"is_nfe_access_key":                IsNFeAccessKey,
+	"is_nil":                           IsNil,
+	"is_nil_or_empty":                  IsNilOrEmpty,
+	"is_non_empty_json":                IsNonEmptyJSON,
+	"is_not_email":                     IsNotEmail,
+	"is_not_empty":                     IsNotEmpty,
+	"is_not_empty_strict":              IsNotEmptyStrict,
+	"is_not_full_name":                 IsNotFullName,
+	"is_not_json":                      IsNotJSON,
+	"is_not_nil_or_empty":              IsNotNilOrEmpty,
+	"is_not_numeric":                   IsNotNumeric,
+	"is_numeric":                       IsNumeric,
+	"is_numeric_space":                 IsNumericSpace,
+	"is_odata_filter_expression":       IsODataFilterExpression,
+	"is_opaque_cursor":                 IsOpaqueCursor,
+	"is_ordinal_string":                IsOrdinalString,
+	"is_pascal_case":                   IsPascalCase,
+	"is_person_name":                   IsPersonName,
+	"is_plausible_birth_date":          IsPlausibleBirthDate,
+	"is_pointer_type":                  IsPointerType,
+	"is_positive":                      isPositive,
+	"is_postgres_dsn":                  IsPostgresDSN,
+	"is_private_ip":                    IsPrivateIP,
+	"is_quarter":                       IsQuarter,
+	"is_random_looking":                IsRandomLooking,
+	"is_redis_url":                     IsRedisURL,
+	"is_request_id_header_safe":        IsRequestIDHeaderSafe,
+	"is_roman_numeral":                 IsRomanNumeral,
+	"is_rsql_expression":               IsRSQLExpression,
+	"is_s3_bucket_name":                IsS3BucketName,
+	"is_scientific_notation":           IsScientificNotation,
+	"is_select_only_statement":         IsSelectOnlyStatement,
+	"is_semver_tag":                    IsSemverTag,
+	"is_signed_numeric":                IsSignedNumeric,
+	"is_single_sql_statement":          IsSingleSQLStatement,
+	"is_slice":                         IsSlice,
+	"is_slice_of_maps":                 IsSliceOfMaps,
+	"is_slice_or_array_type":           IsSliceOrArrayType,
+	"is_slice_type":                    IsSliceType,
+	"is_snake_case":                    IsSnakeCase,
+	"is_span_id":                       IsSpanID,
+	"is_sqs_queue_name":                IsSQSQueueName,
+	"is_street_line":                   IsStreetLine,
+	"is_strict_numeric":                IsStrictNumeric,
+	"is_strictly_increasing":           IsStrictlyIncreasing,
+	"is_string_type":                   IsStringType,
+	"is_struct_type":                   IsStructType,
+	"is_time":                          IsTime,
+	"is_time_type":                     IsTimeType,
+	"is_tls_version":                   IsTLSVersion,
+	"is_today":                         IsToday,
+	"is_totp_secret":                   IsTOTPSecret,
+	"is_trace_id":                      IsTraceID,
+	"is_trace_parent":                  IsTraceParent,
+	"is_translation_key":               IsTranslationKey,
+	"is_uint16_type":                   IsUint16Type,
+	"is_uint32_type":                   IsUint32Type,
+	"is_uint64_string":                 IsUint64String,
+	"is_uint64_type":                   IsUint64Type,
+	"is_uint8_type":                    IsUint8Type,
+	"is_uint_type":                     IsUintType,
+	"is_url":                           IsURL,
+	"is_url_path":                      IsURLPath,
+	"is_url_safe":                      IsURLSafe,
+	"is_valid_ddd":                     IsValidDDD,
+	"is_valid_file_name":               IsValidFileName,
+	"is_valid_ip":                      IsValidIP,
+	"is_valid_page":                    IsValidPage,
+	"is_versioned_api_path":            IsVersionedAPIPath,
+	"is_whatsapp_number":               IsWhatsAppNumber,
+	"is_year_month":                    IsYearMonth,
+	"is_zero_time":                     IsZeroTime,
+	"needs_url_encoding":               NeedsURLEncoding,
+	"non_nil":                          NonNil,
+}}
+
+// RuleByName returns the Rule registered under the given name, so that checks can be referenced declaratively,
+// such as from YAML or JSON policies. Every exported checker that fits the Rule signature is available by default
+// under the snake_case form of its name, such as "is_cpf" for IsCPF or "is_not_empty" for IsNotEmpty, and more
+// can be added with RegisterRule. The "is_positive" Rule, which checks that a value is a number greater than zero,
+// as in the "amount: [is_positive, max=10000]" policy, is also available. Names are case-sensitive. Checkers
+// taking more arguments, or living in other packages such as netcheck, must be registered by the caller, usually
+// bound to their arguments in a closure.
+//
+// Parameters:
+//   - name: The name of the Rule, such as "is_email".
+//
+// Returns:
+//   - Rule: The Rule registered under the name, or nil if there is none.
+//   - bool: A boolean value indicating whether a Rule is registered under the name.
+//
+// Example:
+//
+//	rule, ok := RuleByName("is_cpf")
+//	fmt.Println(ok, rule("12101721007")) // true true
+//	_, ok = RuleByName("IsCPF")
+//	fmt.Println(ok) // false
+func RuleByName(name string) (Rule, bool) {
+	ruleRegistry.RLock()
+	defer ruleRegistry.RUnlock()
+
+	rule, ok := ruleRegistry.rules[name]
+	return rule, ok
+}
+
+// RegisterRule registers a Rule under the given name, replacing the Rule already registered under it, including
+// the built-in ones. It is safe to call RegisterRule concurrently with RuleByName.
+//
+// Parameters:
+//   - name: The name the Rule is looked up by, such as "is_corporate_email".
+//   - rule: The Rule to be registered.
+//
+// Panic:
+//   - The function will panic if the name is empty or the rule is nil.
+//
+// Example:
+//
+//	RegisterRule("is_short_text", func(a any) bool { return len(fmt.Sprint(a)) <= 10 })
+//	rule, _ := RuleByName("is_short_text")
+//	fmt.Println(rule("hello")) // true
+func RegisterRule(name string, rule Rule) {
+	if name == "" || rule == nil {
+		panic(fmt.Sprintf("Invalid rule registration for name: %s", name))
+	}
+
+	ruleRegistry.Lock()
+	defer ruleRegistry.Unlock()
+
+	ruleRegistry.rules[name] = rule
+}
+
+// RuleNames returns the names of all the registered Rules in alphabetical order, which lets configuration
+// loaders validate policies and list the available checks.
+//
+// Returns:
+//   - []string: The sorted names of the registered Rules.
+//
+// Example:
+//
+//	names := RuleNames()
+//	fmt.Println(Contains(names, "is_email")) // true
+func RuleNames() []string {
+	ruleRegistry.RLock()
+	defer ruleRegistry.RUnlock()
+
+	names := make([]string, 0, len(ruleRegistry.rules))
+	for name := range ruleRegistry.rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isPositive checks whether the value is a number, or a numeric string, greater than zero. It backs the
+// "is_positive" Rule and fails on values that cannot be converted into a float instead of panicking.
+func isPositive(a any) bool {
+	f, err := toFloatWithErr(a)
+	return err == nil && f > 0
+}
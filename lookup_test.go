@@ -0,0 +1,147 @@
+package checker
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRuleByName(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   string
+		arg    any
+		want   bool
+		wantOk bool
+	}{
+		{name: "CPF", rule: "is_cpf", arg: "12101721007", want: true, wantOk: true},
+		{name: "InvalidCPF", rule: "is_cpf", arg: "12345678900", want: false, wantOk: true},
+		{name: "Email", rule: "is_email", arg: "test@example.com", want: true, wantOk: true},
+		{name: "Acronym", rule: "is_cpf_or_cnpj", arg: "12101721007", want: true, wantOk: true},
+		{name: "Negated", rule: "is_not_empty", arg: "", want: false, wantOk: true},
+		{name: "FunctionName", rule: "IsCPF", wantOk: false},
+		{name: "Unknown", rule: "is_unknown", wantOk: false},
+		{name: "Empty", rule: "", wantOk: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, ok := RuleByName(tc.rule)
+			if ok != tc.wantOk {
+				t.Fatalf("RuleByName(%q) ok = %v, want %v", tc.rule, ok, tc.wantOk)
+			}
+			if ok && rule(tc.arg) != tc.want {
+				t.Errorf("RuleByName(%q)(%v) = %v, want %v", tc.rule, tc.arg, !tc.want, tc.want)
+			}
+		})
+	}
+}
+
+func TestRegisterRule(t *testing.T) {
+	RegisterRule("is_short_text", func(a any) bool { return len(toString(a)) <= 10 })
+	rule, ok := RuleByName("is_short_text")
+	if !ok || !rule("hello") || rule("hello world!") {
+		t.Errorf("RuleByName() did not return the registered rule")
+	}
+
+	original, _ := RuleByName("is_email")
+	RegisterRule("is_email", func(a any) bool {
+		return IsEmail(a) && !strings.HasSuffix(toString(a), "@gmail.com")
+	})
+	if rule, _ := RuleByName("is_email"); rule("test@gmail.com") {
+		t.Errorf("RegisterRule() did not replace the built-in rule")
+	}
+	RegisterRule("is_email", original)
+
+	for _, tc := range []struct {
+		name string
+		rule Rule
+	}{{name: "", rule: IsEmail}, {name: "is_nil_rule", rule: nil}} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("RegisterRule(%q) did not panic", tc.name)
+				}
+			}()
+			RegisterRule(tc.name, tc.rule)
+		}()
+	}
+}
+
+func TestRegisterRuleConcurrently(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterRule("is_concurrent", IsNotEmpty)
+		}()
+		go func() {
+			defer wg.Done()
+			RuleByName("is_concurrent")
+			RuleNames()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRuleNames(t *testing.T) {
+	names := RuleNames()
+	if !Contains(names, "is_email") || !Contains(names, "non_nil") {
+		t.Errorf("RuleNames() is missing built-in rules")
+	}
+	for i := 1; i < len(names); i++ {
+		if names[i-1] >= names[i] {
+			t.Fatalf("RuleNames() is not sorted: %q before %q", names[i-1], names[i])
+		}
+	}
+}
+
+// TestRuleRegistryCoversCheckers makes sure every exported checker with the Rule signature is registered, so new
+// checkers are not forgotten.
+func TestRuleRegistryCoversCheckers(t *testing.T) {
+	registered := map[string]bool{}
+	for _, name := range RuleNames() {
+		rule, _ := RuleByName(name)
+		function := runtime.FuncForPC(reflect.ValueOf(rule).Pointer())
+		registered[strings.TrimPrefix(function.Name(), "github.com/tech4works/checker.")] = true
+	}
+
+	paths, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || fn.Type.TypeParams != nil || !fn.Name.IsExported() || !isRuleSignature(fn) {
+				continue
+			}
+			if !registered[fn.Name.Name] {
+				t.Errorf("%s fits the Rule signature but is not registered", fn.Name.Name)
+			}
+		}
+	}
+}
+
+func isRuleSignature(fn *ast.FuncDecl) bool {
+	params, results := fn.Type.Params.List, fn.Type.Results
+	if len(params) != 1 || len(params[0].Names) != 1 || results == nil || len(results.List) != 1 {
+		return false
+	}
+	param, ok := params[0].Type.(*ast.Ident)
+	result, okResult := results.List[0].Type.(*ast.Ident)
+	return ok && okResult && param.Name == "any" && result.Name == "bool"
+}
@@ -0,0 +1,362 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// IsMarkdown checks if a given value is well-formed Markdown, that is, valid UTF-8 text without NUL characters
+// whose fenced code blocks (``` or ~~~) are all closed and whose inline links and images, such as
+// "[text](https://example.com)" and "![alt](image.png)", all have a closed destination. Everything else is plain
+// text in Markdown, so the check only catches the structural mistakes that change how the rest of the document
+// renders.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as Markdown.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is well-formed Markdown.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsMarkdown("# Title\n\nSee [the docs](https://example.com).")) // true
+//	fmt.Println(IsMarkdown("```go\nfmt.Println(1)\n```"))                      // true
+//	fmt.Println(IsMarkdown("```go\nfmt.Println(1)"))                           // false
+//	fmt.Println(IsMarkdown("See [the docs](https://example.com"))              // false
+func IsMarkdown(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMarkdown", time.Now(), &passed)
+	}
+	s := toString(a)
+	if !utf8.ValidString(s) || strings.ContainsRune(s, 0) {
+		return false
+	}
+	prose, ok := markdownProse(s)
+	if !ok {
+		return false
+	}
+	regex := regexp.MustCompile(`!?\[[^\]]*\]\(\s*(<[^>]*>|[^\s)]*)(\s+("[^"]*"|'[^']*'|\([^)]*\)))?\s*\)`)
+	return strings.Count(prose, "](") == len(regex.FindAllString(prose, -1))
+}
+
+// HasMaxHeadingLevel checks if a given Markdown value has no heading deeper than the given level, considering
+// both ATX headings ("### Title") and setext headings (text underlined with "=" or "-"). Headings inside fenced code
+// blocks are ignored.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as Markdown.
+//   - max: The deepest heading level allowed, from 1 to 6.
+//
+// Returns:
+//   - bool: A boolean value indicating whether every heading is at most at the given level.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(HasMaxHeadingLevel("# Title\n## Section", 2))          // true
+//	fmt.Println(HasMaxHeadingLevel("Title\n=====\n\n### Details", 2))  // false
+//	fmt.Println(HasMaxHeadingLevel("```\n#### not a heading\n```", 1)) // true
+func HasMaxHeadingLevel(a any, max int) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("HasMaxHeadingLevel", time.Now(), &passed)
+	}
+	prose, _ := markdownProse(toString(a))
+	atx := regexp.MustCompile(`^ {0,3}(#{1,6})(\s|$)`)
+	setext := regexp.MustCompile(`^ {0,3}(=+|-+)\s*$`)
+
+	previous := ""
+	for _, line := range strings.Split(prose, "\n") {
+		level := 0
+		if match := atx.FindStringSubmatch(line); match != nil {
+			level = len(match[1])
+		} else if match := setext.FindStringSubmatch(line); match != nil && strings.TrimSpace(previous) != "" &&
+			!atx.MatchString(previous) {
+			level = 1
+			if match[1][0] == '-' {
+				level = 2
+			}
+		}
+		if level > max {
+			return false
+		}
+		previous = line
+	}
+	return true
+}
+
+// ContainsRawHTMLInMarkdown checks if a given Markdown value contains raw HTML, such as "<script>", "</div>",
+// "<img src=x onerror=alert(1)>" or "<!-- comments -->", outside fenced code blocks and inline code spans.
+// Autolinks like "<https://example.com>" are not considered HTML.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as Markdown.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value contains raw HTML.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(ContainsRawHTMLInMarkdown("Hello <script>alert(1)</script>")) // true
+//	fmt.Println(ContainsRawHTMLInMarkdown("Hello <!-- hidden -->"))           // true
+//	fmt.Println(ContainsRawHTMLInMarkdown("Use `<div>` for blocks"))          // false
+//	fmt.Println(ContainsRawHTMLInMarkdown("Visit <https://example.com>"))     // false
+func ContainsRawHTMLInMarkdown(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ContainsRawHTMLInMarkdown", time.Now(), &passed)
+	}
+	prose, _ := markdownProse(toString(a))
+	regex := regexp.MustCompile(`<(/?[A-Za-z][A-Za-z0-9-]*(\s[^<>]*)?/?>|!--|![A-Za-z]|\?)`)
+	return regex.MatchString(prose)
+}
+
+// ListMarkdownLinks returns the destinations of the inline links and images, reference definitions and
+// autolinks found in a given Markdown value, in order of appearance, ignoring fenced code blocks and inline code
+// spans. Inline destinations may contain balanced parentheses, as in CommonMark. Each destination is returned as
+// a browser receives it: backslash escapes and HTML entities are decoded, as renderers do, and ASCII control
+// characters, such as tabs and line breaks, which browsers drop from URLs, are removed.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and searched for links.
+//
+// Returns:
+//   - []string: The link destinations found, or nil if there are none.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(ListMarkdownLinks("[docs](https://example.com) ![logo](/logo.png)")) // [https://example.com /logo.png]
+//	fmt.Println(ListMarkdownLinks("[go](https://w.org/Go_(game))"))                  // [https://w.org/Go_(game)]
+//	fmt.Println(ListMarkdownLinks("Visit <https://example.com>"))                    // [https://example.com]
+//	fmt.Println(ListMarkdownLinks("`[docs](https://example.com)`"))                  // []
+func ListMarkdownLinks(a any) []string {
+	prose, _ := markdownProse(toString(a))
+	regex := regexp.MustCompile(`!?\[[^\]]*\]\(` +
+		`|(?m:^ {0,3}\[[^\]]+\]:[ \t]*(?:<([^>]*)>|(\S+)))` +
+		`|<([A-Za-z][A-Za-z0-9+.-]{1,31}:[^\s<>]*)>`)
+
+	var links []string
+	next := 0
+	for _, match := range regex.FindAllStringSubmatchIndex(prose, -1) {
+		if match[0] < next {
+			continue
+		}
+		if match[2] < 0 && match[4] < 0 && match[6] < 0 {
+			destination, end := markdownInlineDestination(prose, match[1])
+			if destination != "" {
+				links = append(links, markdownLinkDestination(destination))
+			}
+			next = end
+			continue
+		}
+		for group := 2; group < len(match); group += 2 {
+			if match[group] >= 0 && match[group+1] > match[group] {
+				links = append(links, markdownLinkDestination(prose[match[group]:match[group+1]]))
+				break
+			}
+		}
+	}
+	return links
+}
+
+// HasSafeMarkdownLinks checks if every link destination of a given Markdown value (see ListMarkdownLinks) is safe
+// to render: relative URLs, fragments, mailto links and http or https URLs that pass IsURL are accepted, while
+// any other scheme, such as javascript:, data: or file:, is rejected. The scheme is read after decoding HTML
+// entities and removing ASCII whitespace and control characters, so "javascript&#58;", "&#106;avascript:" and
+// "java\tscript:" are all rejected. Whether the hosts are public is not checked; netcheck.HasPublicMarkdownLinks
+// resolves them for that.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as Markdown.
+//
+// Returns:
+//   - bool: A boolean value indicating whether every link destination is safe.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(HasSafeMarkdownLinks("[docs](https://example.com) [home](/)")) // true
+//	fmt.Println(HasSafeMarkdownLinks("[click](javascript:alert(1))"))          // false
+//	fmt.Println(HasSafeMarkdownLinks("[click](javascript&#58;alert(1))"))      // false
+//	fmt.Println(HasSafeMarkdownLinks("![x](data:image/png;base64,AAAA)"))      // false
+func HasSafeMarkdownLinks(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("HasSafeMarkdownLinks", time.Now(), &passed)
+	}
+	scheme := regexp.MustCompile(`^([A-Za-z][A-Za-z0-9+.-]*):`)
+	for _, link := range ListMarkdownLinks(a) {
+		link = strings.TrimSpace(link)
+		compact := strings.Map(func(r rune) rune {
+			if r <= ' ' || r == 0x7F {
+				return -1
+			}
+			return r
+		}, link)
+		match := scheme.FindStringSubmatch(compact)
+		switch {
+		case strings.HasPrefix(compact, "//"):
+			if !isURL("https:" + link) {
+				return false
+			}
+		case match == nil:
+			continue
+		case strings.EqualFold(match[1], "mailto"):
+			continue
+		case strings.EqualFold(match[1], "http"), strings.EqualFold(match[1], "https"):
+			if link != compact || !isURL(link) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// markdownInlineDestination parses the destination of an inline link or image starting at the given offset, right
+// after its "(", returning it with its backslash escapes and the offset where it ends. An unbracketed destination
+// ends at the first space or control character, or at the ")" that closes it, and may contain balanced
+// parentheses; a bracketed one, such as "<a b.png>", ends at the first unescaped ">".
+func markdownInlineDestination(s string, start int) (string, int) {
+	i := start
+	for i < len(s) && (s[i] == ' ' || s[i] == '\t' || s[i] == '\n') {
+		i++
+	}
+	if i < len(s) && s[i] == '<' {
+		for j := i + 1; j < len(s) && s[j] != '<'; j++ {
+			if s[j] == '\\' && j+1 < len(s) {
+				j++
+			} else if s[j] == '>' {
+				return s[i+1 : j], j + 1
+			}
+		}
+		return "", i
+	}
+
+	depth := 0
+	j := i
+	for ; j < len(s); j++ {
+		c := s[j]
+		if c <= ' ' || c == 0x7F {
+			break
+		} else if c == '\\' && j+1 < len(s) && s[j+1] > ' ' {
+			j++
+		} else if c == '(' {
+			depth++
+		} else if c == ')' {
+			if depth == 0 {
+				break
+			}
+			depth--
+		}
+	}
+	return s[i:j], j
+}
+
+// markdownLinkDestination decodes a link destination as renderers and browsers do: its backslash escapes and HTML
+// entities are decoded and its ASCII control characters are removed.
+func markdownLinkDestination(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", s[i+1]) >= 0 {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return strings.Map(func(r rune) rune {
+		if r < ' ' || r == 0x7F {
+			return -1
+		}
+		return r
+	}, html.UnescapeString(b.String()))
+}
+
+// markdownProse blanks the content of fenced code blocks and inline code spans of a Markdown text, keeping its
+// lines, and reports whether every fenced code block is closed.
+func markdownProse(s string) (string, bool) {
+	fence := regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+	span := regexp.MustCompile("(`+)")
+
+	lines := strings.Split(s, "\n")
+	opening := ""
+	for i, line := range lines {
+		if opening != "" {
+			if strings.HasPrefix(strings.TrimLeft(line, " "), opening) &&
+				strings.Trim(strings.TrimSpace(line), opening[:1]) == "" {
+				opening = ""
+			}
+			lines[i] = ""
+			continue
+		}
+		if match := fence.FindStringSubmatch(line); match != nil {
+			opening = match[1]
+			lines[i] = ""
+			continue
+		}
+
+		var b strings.Builder
+		for line != "" {
+			loc := span.FindStringIndex(line)
+			if loc == nil {
+				b.WriteString(line)
+				break
+			}
+			ticks := line[loc[0]:loc[1]]
+			end := strings.Index(line[loc[1]:], ticks)
+			if end < 0 {
+				b.WriteString(line)
+				break
+			}
+			b.WriteString(line[:loc[0]])
+			line = line[loc[1]+end+len(ticks):]
+		}
+		lines[i] = b.String()
+	}
+	return strings.Join(lines, "\n"), opening == ""
+}
@@ -0,0 +1,187 @@
+package checker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsMarkdown(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Heading", arg: "# Title\n\nSee [the docs](https://example.com).", want: true},
+		{name: "ClosedFence", arg: "```go\nfmt.Println(1)\n```", want: true},
+		{name: "TildeFence", arg: "~~~\ncode ](\n~~~", want: true},
+		{name: "Image", arg: "![logo](logo.png \"Logo\")", want: true},
+		{name: "PlainText", arg: "Just text", want: true},
+		{name: "UnclosedFence", arg: "```go\nfmt.Println(1)", want: false},
+		{name: "UnclosedLink", arg: "See [the docs](https://example.com", want: false},
+		{name: "InvalidUTF8", arg: "\xff\xfe", want: false},
+		{name: "NulChar", arg: "a\x00b", want: false},
+		{name: "Empty", arg: "", want: true},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsMarkdown(tc.arg); got != tc.want {
+				t.Errorf("IsMarkdown(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContainsRawHTMLInMarkdown(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Script", arg: "Hello <script>alert(1)</script>", want: true},
+		{name: "ClosingTag", arg: "text</div>", want: true},
+		{name: "Attributes", arg: "<img src=x onerror=alert(1)>", want: true},
+		{name: "Comment", arg: "Hello <!-- hidden -->", want: true},
+		{name: "InlineCode", arg: "Use `<div>` for blocks", want: false},
+		{name: "FencedCode", arg: "```html\n<div>x</div>\n```", want: false},
+		{name: "Autolink", arg: "Visit <https://example.com>", want: false},
+		{name: "Comparison", arg: "a < b and c > d", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := ContainsRawHTMLInMarkdown(tc.arg); got != tc.want {
+				t.Errorf("ContainsRawHTMLInMarkdown(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHasSafeMarkdownLinks(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Safe", arg: "[docs](https://example.com) [home](/) [top](#top)", want: true},
+		{name: "Mailto", arg: "[mail](mailto:a@b.com)", want: true},
+		{name: "Reference", arg: "[docs][1]\n\n[1]: https://example.com", want: true},
+		{name: "NoLinks", arg: "Just text", want: true},
+		{name: "JavaScript", arg: "[click](javascript:alert(1))", want: false},
+		{name: "UpperJavaScript", arg: "[click](JavaScript:alert(1))", want: false},
+		{name: "Data", arg: "![x](data:image/png;base64,AAAA)", want: false},
+		{name: "File", arg: "<file:///etc/passwd>", want: false},
+		{name: "ReferenceJavaScript", arg: "[x][1]\n\n[1]: javascript:alert(1)", want: false},
+		{name: "LinkInCode", arg: "`[click](javascript:alert(1))`", want: true},
+		{name: "DecimalEntityColon", arg: "[x](javascript&#58;alert(1))", want: false},
+		{name: "DecimalEntityLetter", arg: "[x](&#106;avascript:alert(1))", want: false},
+		{name: "HexEntityLetter", arg: "[x](&#x6A;avascript:alert(1))", want: false},
+		{name: "NamedEntityColon", arg: "[x](javascript&colon;alert(1))", want: false},
+		{name: "TabInBracketed", arg: "[x](<java\tscript:alert(1)>)", want: false},
+		{name: "NewlineInBracketed", arg: "[x](<java\nscript:alert(1)>)", want: false},
+		{name: "LeadingControl", arg: "[x](<\x01javascript:alert(1)>)", want: false},
+		{name: "BackslashEscape", arg: "[x](javascript\\:alert(1))", want: false},
+		{name: "ReferenceEntity", arg: "[x][1]\n\n[1]: &#106;avascript:alert(1)", want: false},
+		{name: "BalancedParentheses", arg: "[go](https://example.com/Go_(game))", want: true},
+		{name: "ParenthesizedJavaScript", arg: "[x]((javascript:alert(1)))", want: true},
+		{name: "EntityInSafeURL", arg: "[x](https://example.com/?a=1&amp;b=2)", want: true},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := HasSafeMarkdownLinks(tc.arg); got != tc.want {
+				t.Errorf("HasSafeMarkdownLinks(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHasMaxHeadingLevel(t *testing.T) {
+	testCases := []struct {
+		name  string
+		arg   any
+		max   int
+		want  bool
+		panic bool
+	}{
+		{name: "WithinMax", arg: "# Title\n## Section", max: 2, want: true},
+		{name: "AboveMax", arg: "# Title\n### Details", max: 2, want: false},
+		{name: "SetextLevelTwo", arg: "Title\n-----", max: 1, want: false},
+		{name: "SetextLevelOne", arg: "Title\n=====", max: 1, want: true},
+		{name: "ThematicBreak", arg: "Text\n\n---\n\nMore", max: 1, want: true},
+		{name: "InsideFence", arg: "```\n#### not a heading\n```", max: 1, want: true},
+		{name: "NoSpaceAfterHash", arg: "###not a heading", max: 1, want: true},
+		{name: "Hashtag", arg: "#tag", max: 1, want: true},
+		{name: "Nil", arg: nil, max: 2, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := HasMaxHeadingLevel(tc.arg, tc.max); got != tc.want {
+				t.Errorf("HasMaxHeadingLevel(%v, %v) = %v, want %v", tc.arg, tc.max, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestListMarkdownLinks(t *testing.T) {
+	testCases := []struct {
+		name  string
+		arg   any
+		want  []string
+		panic bool
+	}{
+		{name: "InlineAndImage", arg: "[docs](https://example.com) ![logo](/logo.png \"Logo\")",
+			want: []string{"https://example.com", "/logo.png"}},
+		{name: "Autolink", arg: "Visit <https://example.com>", want: []string{"https://example.com"}},
+		{name: "AngleDestination", arg: "[x](<a b.png>)", want: []string{"a b.png"}},
+		{name: "Reference", arg: "[x][1]\n\n[1]: https://example.com \"Title\"", want: []string{"https://example.com"}},
+		{name: "BalancedParentheses", arg: "[go](https://example.com/Go_(game)) [x](a(b(c))d)",
+			want: []string{"https://example.com/Go_(game)", "a(b(c))d"}},
+		{name: "UnbalancedParenthesis", arg: "([x](https://example.com))", want: []string{"https://example.com"}},
+		{name: "Entities", arg: "[x](javascript&#58;alert(1)) [y](&#x2F;home)",
+			want: []string{"javascript:alert(1)", "/home"}},
+		{name: "BackslashEscapes", arg: `[x](a\(b) [y](<c\>d>)`, want: []string{"a(b", "c>d"}},
+		{name: "ControlCharacters", arg: "[x](<java\tscript:alert(1)>)", want: []string{"javascript:alert(1)"}},
+		{name: "InCode", arg: "`[docs](https://example.com)`", want: nil},
+		{name: "None", arg: "Just text", want: nil},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := ListMarkdownLinks(tc.arg); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ListMarkdownLinks(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
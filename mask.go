@@ -0,0 +1,153 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import "strings"
+
+// MaskKeep converts the given value to a string and replaces every character with '*', except the first
+// 'keepStart' and the last 'keepEnd' characters. Characters are counted as runes. When the kept characters
+// would reveal the whole value, the entire value is masked instead.
+//
+// Parameters:
+//   - a: Any value to be masked.
+//   - keepStart: The number of leading characters kept visible. Negative values are treated as zero.
+//   - keepEnd: The number of trailing characters kept visible. Negative values are treated as zero.
+//
+// Returns:
+//   - string: The masked representation of the value.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(MaskKeep("secret-token", 2, 2)) // se********en
+//	fmt.Println(MaskKeep("abc", 2, 2))          // ***
+func MaskKeep(a any, keepStart, keepEnd int) string {
+	runes := []rune(toString(a))
+	keepStart, keepEnd = max(keepStart, 0), max(keepEnd, 0)
+	if keepStart+keepEnd >= len(runes) {
+		keepStart, keepEnd = 0, 0
+	}
+
+	for i := keepStart; i < len(runes)-keepEnd; i++ {
+		runes[i] = '*'
+	}
+	return string(runes)
+}
+
+// MaskCPF converts the given value to a string and, when it is a valid CPF according to IsCPF, returns its masked
+// display form keeping only the first three and the last two digits, e.g. "121.***.***-07".
+//
+// Parameters:
+//   - a: Any value holding a CPF, masked or not.
+//
+// Returns:
+//   - string: The masked CPF, or an empty string if the CPF is invalid.
+//   - bool: A boolean value indicating whether the value is a valid CPF.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(MaskCPF("12101721007"))    // 121.***.***-07 true
+//	fmt.Println(MaskCPF("111.111.111-11")) // "" false
+func MaskCPF(a any) (string, bool) {
+	s, ok := NormalizeCPF(a)
+	if !ok {
+		return "", false
+	}
+	return s[:3] + ".***.***-" + s[9:], true
+}
+
+// MaskEmail converts the given value to a string and, when it is a valid email according to IsEmail, returns its
+// masked display form. The domain is kept, while the local part keeps only its first and last characters
+// (only the first one for two-character local parts, none for a single character).
+//
+// Parameters:
+//   - a: Any value holding an email address.
+//
+// Returns:
+//   - string: The masked email, or an empty string if the email is invalid.
+//   - bool: A boolean value indicating whether the value is a valid email.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(MaskEmail("john.doe@example.com")) // j******e@example.com true
+//	fmt.Println(MaskEmail("not an email"))         // "" false
+func MaskEmail(a any) (string, bool) {
+	s, ok := NormalizeEmail(a)
+	if !ok {
+		return "", false
+	}
+
+	at := strings.LastIndex(s, "@")
+	local, domain := s[:at], s[at:]
+	switch len(local) {
+	case 1:
+		return MaskKeep(local, 0, 0) + domain, true
+	case 2:
+		return MaskKeep(local, 1, 0) + domain, true
+	default:
+		return MaskKeep(local, 1, 1) + domain, true
+	}
+}
+
+// MaskCreditCard converts the given value to a string and, when it holds a plausible card number (13 to 19 digits
+// passing the Luhn check, spaces and dashes allowed), returns its masked display form keeping only the last four
+// digits.
+//
+// Parameters:
+//   - a: Any value holding a credit card number.
+//
+// Returns:
+//   - string: The masked card number without separators, or an empty string if the number is invalid.
+//   - bool: A boolean value indicating whether the value is a plausible card number.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(MaskCreditCard("4111 1111 1111 1111")) // ************1111 true
+//	fmt.Println(MaskCreditCard("1234 5678"))           // "" false
+func MaskCreditCard(a any) (string, bool) {
+	s := toString(a)
+	digits := removeNonDigits(s)
+	if strings.Trim(s, "0123456789 -") != "" || len(digits) < 13 || len(digits) > 19 || !isLuhnValid(digits) {
+		return "", false
+	}
+	return MaskKeep(digits, 0, 4), true
+}
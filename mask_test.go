@@ -0,0 +1,91 @@
+package checker
+
+import "testing"
+
+func TestMaskKeep(t *testing.T) {
+	tests := []struct {
+		name      string
+		arg       any
+		keepStart int
+		keepEnd   int
+		want      string
+	}{
+		{name: "KeepBoth", arg: "secret-token", keepStart: 2, keepEnd: 2, want: "se********en"},
+		{name: "KeepStartOnly", arg: "secret", keepStart: 1, keepEnd: 0, want: "s*****"},
+		{name: "KeepEndOnly", arg: 123456789, keepStart: 0, keepEnd: 4, want: "*****6789"},
+		{name: "KeepNone", arg: "abc", keepStart: 0, keepEnd: 0, want: "***"},
+		{name: "KeepEverything", arg: "abc", keepStart: 2, keepEnd: 2, want: "***"},
+		{name: "Negative", arg: "abc", keepStart: -1, keepEnd: -5, want: "***"},
+		{name: "Unicode", arg: "joão", keepStart: 1, keepEnd: 1, want: "j**o"},
+		{name: "Empty", arg: "", keepStart: 1, keepEnd: 1, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MaskKeep(tt.arg, tt.keepStart, tt.keepEnd); got != tt.want {
+				t.Errorf("MaskKeep(%v, %v, %v) = %v, want %v", tt.arg, tt.keepStart, tt.keepEnd, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaskCPF(t *testing.T) {
+	tests := []normalizeCase{
+		{name: "Unmasked", arg: "12101721007", want: "121.***.***-07", wantValid: true},
+		{name: "Masked", arg: "121.017.210-07", want: "121.***.***-07", wantValid: true},
+		{name: "Invalid", arg: "111.111.111-11", want: "", wantValid: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			got, valid := MaskCPF(tt.arg)
+			if got != tt.want || valid != tt.wantValid {
+				t.Errorf("MaskCPF(%v) = %v, %v, want %v, %v", tt.arg, got, valid, tt.want, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestMaskEmail(t *testing.T) {
+	tests := []normalizeCase{
+		{name: "LongLocal", arg: "john.doe@example.com", want: "j******e@example.com", wantValid: true},
+		{name: "Uppercase", arg: " John@Example.com ", want: "j**n@example.com", wantValid: true},
+		{name: "TwoCharsLocal", arg: "jd@example.com", want: "j*@example.com", wantValid: true},
+		{name: "OneCharLocal", arg: "j@example.com", want: "*@example.com", wantValid: true},
+		{name: "Invalid", arg: "not an email", want: "", wantValid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, valid := MaskEmail(tt.arg)
+			if got != tt.want || valid != tt.wantValid {
+				t.Errorf("MaskEmail(%v) = %v, %v, want %v, %v", tt.arg, got, valid, tt.want, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestMaskCreditCard(t *testing.T) {
+	tests := []normalizeCase{
+		{name: "Visa", arg: "4111 1111 1111 1111", want: "************1111", wantValid: true},
+		{name: "Dashes", arg: "5555-5555-5555-4444", want: "************4444", wantValid: true},
+		{name: "Amex", arg: "378282246310005", want: "***********0005", wantValid: true},
+		{name: "InvalidLuhn", arg: "4111 1111 1111 1112", want: "", wantValid: false},
+		{name: "TooShort", arg: "1234 5678", want: "", wantValid: false},
+		{name: "Letters", arg: "4111 1111 1111 111a", want: "", wantValid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, valid := MaskCreditCard(tt.arg)
+			if got != tt.want || valid != tt.wantValid {
+				t.Errorf("MaskCreditCard(%v) = %v, %v, want %v, %v", tt.arg, got, valid, tt.want, tt.wantValid)
+			}
+		})
+	}
+}
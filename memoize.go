@@ -0,0 +1,128 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+)
+
+// memoCache is a least recently used cache of Rule results, safe for concurrent use.
+type memoCache struct {
+	sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+// memoEntry is an element of the memoCache order list.
+type memoEntry struct {
+	key    string
+	result bool
+}
+
+// Memoize wraps the given Rule into a new Rule that caches its results, which avoids re-running expensive checks,
+// such as IsCPF or IsCNPJ in batch imports, on inputs seen before. The cache keeps the results of the 'size' most
+// recently used inputs and is keyed on the type of the input and its string form, as given by the toString
+// function. Inputs that cannot be converted into a string, such as nil, are not cached and are passed straight to
+// the Rule, as are panics raised by it. The returned Rule is safe for concurrent use.
+//
+// Memoize must only wrap deterministic Rules: a Rule whose result depends on anything other than its input, such
+// as the current time, would have stale results served from the cache.
+//
+// Parameters:
+//   - rule: The Rule to be memoized.
+//   - size: The maximum number of results kept in the cache.
+//
+// Returns:
+//   - Rule: A Rule with the same result as 'rule', served from the cache on repeated inputs.
+//
+// Panic:
+//   - The function will panic if 'size' is not positive.
+//
+// Example:
+//
+//	isCNPJ := Memoize(IsCNPJ, 10_000)
+//	fmt.Println(isCNPJ("11222333000181")) // true
+//	fmt.Println(isCNPJ("11222333000181")) // true, served from the cache
+func Memoize(rule Rule, size int) Rule {
+	if size <= 0 {
+		panic(fmt.Sprintf("Error memoizing rule, size must be positive, got %d!", size))
+	}
+
+	cache := &memoCache{size: size, order: list.New(), entries: make(map[string]*list.Element, size)}
+	return func(a any) bool {
+		key, ok := memoKey(a)
+		if !ok {
+			return rule(a)
+		} else if result, found := cache.get(key); found {
+			return result
+		}
+
+		result := rule(a)
+		cache.put(key, result)
+		return result
+	}
+}
+
+// memoKey returns the cache key of the given input, or false if it cannot be converted into a string.
+func memoKey(a any) (key string, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			key, ok = "", false
+		}
+	}()
+	return fmt.Sprintf("%T:%s", a, toString(a)), true
+}
+
+// get returns the cached result of the given key and marks it as the most recently used.
+func (c *memoCache) get(key string) (bool, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	element, found := c.entries[key]
+	if !found {
+		return false, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*memoEntry).result, true
+}
+
+// put caches the result of the given key, evicting the least recently used result when the cache is full.
+func (c *memoCache) put(key string, result bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	if element, found := c.entries[key]; found {
+		element.Value.(*memoEntry).result = result
+		c.order.MoveToFront(element)
+		return
+	}
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoEntry).key)
+	}
+	c.entries[key] = c.order.PushFront(&memoEntry{key: key, result: result})
+}
@@ -0,0 +1,87 @@
+package checker
+
+import (
+	"sync"
+	"testing"
+)
+
+func countingRule(calls *int) Rule {
+	return func(a any) bool {
+		*calls++
+		return IsCNPJ(a)
+	}
+}
+
+func TestMemoize(t *testing.T) {
+	calls := 0
+	isCNPJ := Memoize(countingRule(&calls), 2)
+
+	testCases := []struct {
+		name      string
+		arg       any
+		want      bool
+		wantCalls int
+	}{
+		{name: "FirstValid", arg: "11222333000181", want: true, wantCalls: 1},
+		{name: "CachedValid", arg: "11222333000181", want: true, wantCalls: 1},
+		{name: "FirstInvalid", arg: "11222333000182", want: false, wantCalls: 2},
+		{name: "CachedInvalid", arg: "11222333000182", want: false, wantCalls: 2},
+		{name: "OtherType", arg: 11222333000181, want: true, wantCalls: 3},
+		{name: "EvictedLeastRecentlyUsed", arg: "11222333000181", want: true, wantCalls: 4},
+		{name: "KeptMostRecentlyUsed", arg: 11222333000181, want: true, wantCalls: 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isCNPJ(tc.arg); got != tc.want {
+				t.Errorf("Memoize(IsCNPJ)(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+			if calls != tc.wantCalls {
+				t.Errorf("Memoize(IsCNPJ)(%v) made the rule run %d times, want %d", tc.arg, calls, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestMemoizeUncachedInput(t *testing.T) {
+	calls := 0
+	isCNPJ := Memoize(countingRule(&calls), 10)
+
+	for i := 0; i < 2; i++ {
+		if result, panicked := Quietly(func() bool { return isCNPJ(nil) }); result || !panicked {
+			t.Errorf("Memoize(IsCNPJ)(nil) = %v, %v, want a panic", result, panicked)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Memoize(IsCNPJ)(nil) made the rule run %d times, want 2", calls)
+	}
+}
+
+func TestMemoizeInvalidSize(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	Memoize(IsCNPJ, 0)
+}
+
+func TestMemoizeConcurrent(t *testing.T) {
+	isCPF := Memoize(IsCPF, 4)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cpf, want := "12101721007", true
+			if i%2 == 1 {
+				cpf, want = "11111111111", false
+			}
+			if got := isCPF(cpf); got != want {
+				t.Errorf("Memoize(IsCPF)(%v) = %v, want %v", cpf, got, want)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,103 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// IsMetadataKey checks if a given value is a metadata key in the shape shared by gRPC metadata, S3 object tags and
+// label keys: lowercase ASCII letters, digits, '-' and '_', starting with a letter or a digit, with at most maxLen
+// characters. Uppercase keys are rejected because most of these systems lowercase or refuse them. When maxLen is
+// zero or negative, the length is not limited.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a metadata key.
+//   - maxLen: The maximum number of characters of the key, such as 63 for label keys.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a metadata key within the maximum length.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsMetadataKey("x-request-id", 63)) // true
+//	fmt.Println(IsMetadataKey("tenant_id", 0))     // true
+//	fmt.Println(IsMetadataKey("X-Request-ID", 63)) // false
+//	fmt.Println(IsMetadataKey("-request-id", 63))  // false
+//	fmt.Println(IsMetadataKey("x-request-id", 5))  // false
+func IsMetadataKey(a any, maxLen int) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMetadataKey", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+	return (maxLen <= 0 || len(s) <= maxLen) && regex.MatchString(s)
+}
+
+// IsMetadataValue checks if a given value is a metadata value accepted alongside the keys checked by
+// IsMetadataKey: printable ASCII characters, including spaces but not at either end, as transports such as HTTP/2
+// strip them, with at most maxLen characters. Control characters, such as line breaks, and non-ASCII characters
+// must be encoded before being sent. Empty values are accepted. When maxLen is zero or negative, the length is
+// not limited.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a metadata value.
+//   - maxLen: The maximum number of characters of the value, such as 256 for S3 object tags.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a metadata value within the maximum length.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsMetadataValue("Bearer abc.def", 256)) // true
+//	fmt.Println(IsMetadataValue("", 256))               // true
+//	fmt.Println(IsMetadataValue(" padded", 256))        // false
+//	fmt.Println(IsMetadataValue("line\nbreak", 256))    // false
+//	fmt.Println(IsMetadataValue("café", 256))           // false
+func IsMetadataValue(a any, maxLen int) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMetadataValue", time.Now(), &passed)
+	}
+	s := toString(a)
+	if (maxLen > 0 && len(s) > maxLen) || strings.TrimSpace(s) != s {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] > 0x7E {
+			return false
+		}
+	}
+	return true
+}
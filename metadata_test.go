@@ -0,0 +1,70 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+type metadataCase struct {
+	name   string
+	arg    any
+	maxLen int
+	want   bool
+	panic  bool
+}
+
+func runMetadataCases(t *testing.T, name string, fn func(any, int) bool, tests []metadataCase) {
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := fn(tc.arg, tc.maxLen); got != tc.want {
+				t.Errorf("%s(%v, %d) = %v, want %v", name, tc.arg, tc.maxLen, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsMetadataKey(t *testing.T) {
+	runMetadataCases(t, "IsMetadataKey", IsMetadataKey, []metadataCase{
+		{name: "Dashes", arg: "x-request-id", maxLen: 63, want: true},
+		{name: "Underscores", arg: "tenant_id", maxLen: 63, want: true},
+		{name: "Digits", arg: "b3", maxLen: 63, want: true},
+		{name: "LeadingDigit", arg: "2fa-method", maxLen: 63, want: true},
+		{name: "ExactLength", arg: strings.Repeat("a", 63), maxLen: 63, want: true},
+		{name: "Unlimited", arg: strings.Repeat("a", 1000), maxLen: 0, want: true},
+		{name: "TooLong", arg: strings.Repeat("a", 64), maxLen: 63, want: false},
+		{name: "Uppercase", arg: "X-Request-ID", maxLen: 63, want: false},
+		{name: "LeadingDash", arg: "-request-id", maxLen: 63, want: false},
+		{name: "LeadingUnderscore", arg: "_internal", maxLen: 63, want: false},
+		{name: "Dot", arg: "app.kubernetes.io", maxLen: 63, want: false},
+		{name: "Space", arg: "request id", maxLen: 63, want: false},
+		{name: "NonASCII", arg: "região", maxLen: 63, want: false},
+		{name: "Empty", arg: "", maxLen: 63, want: false},
+		{name: "Nil", arg: nil, maxLen: 63, panic: true},
+	})
+}
+
+func TestIsMetadataValue(t *testing.T) {
+	runMetadataCases(t, "IsMetadataValue", IsMetadataValue, []metadataCase{
+		{name: "Token", arg: "Bearer abc.def", maxLen: 256, want: true},
+		{name: "Symbols", arg: "a=b; c=\"d\"", maxLen: 256, want: true},
+		{name: "Empty", arg: "", maxLen: 256, want: true},
+		{name: "Number", arg: 42, maxLen: 256, want: true},
+		{name: "ExactLength", arg: strings.Repeat("v", 256), maxLen: 256, want: true},
+		{name: "Unlimited", arg: strings.Repeat("v", 5000), maxLen: -1, want: true},
+		{name: "TooLong", arg: strings.Repeat("v", 257), maxLen: 256, want: false},
+		{name: "LeadingSpace", arg: " padded", maxLen: 256, want: false},
+		{name: "TrailingSpace", arg: "padded ", maxLen: 256, want: false},
+		{name: "LineBreak", arg: "line\nbreak", maxLen: 256, want: false},
+		{name: "Tab", arg: "a\tb", maxLen: 256, want: false},
+		{name: "Delete", arg: "a\x7fb", maxLen: 256, want: false},
+		{name: "NonASCII", arg: "café", maxLen: 256, want: false},
+		{name: "Nil", arg: nil, maxLen: 256, panic: true},
+	})
+}
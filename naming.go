@@ -0,0 +1,249 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// NamingConvention represents a custom type for the naming conventions of identifiers, such as JSON keys.
+type NamingConvention string
+
+const (
+	// NamingConventionSnakeCase represents a constant of type NamingConvention that indicates "snake_case" names.
+	NamingConventionSnakeCase NamingConvention = "SNAKE_CASE"
+	// NamingConventionCamelCase represents a constant of type NamingConvention that indicates "camelCase" names.
+	NamingConventionCamelCase NamingConvention = "CAMEL_CASE"
+	// NamingConventionPascalCase represents a constant of type NamingConvention that indicates "PascalCase" names.
+	NamingConventionPascalCase NamingConvention = "PASCAL_CASE"
+	// NamingConventionKebabCase represents a constant of type NamingConvention that indicates "kebab-case" names.
+	NamingConventionKebabCase NamingConvention = "KEBAB_CASE"
+)
+
+// IsEnumValid returns whether the naming convention is one of the NamingConvention constants.
+func (n NamingConvention) IsEnumValid() bool {
+	switch n {
+	case NamingConventionSnakeCase, NamingConventionCamelCase, NamingConventionPascalCase, NamingConventionKebabCase:
+		return true
+	}
+	return false
+}
+
+// NamingOptions configures how MatchesConventionWithOptions checks names. The zero value matches
+// MatchesConvention.
+type NamingOptions struct {
+	// NoDigits rejects names containing digits, such as "utf8_name", which the conventions accept by default.
+	NoDigits bool
+}
+
+// IsSnakeCase checks if a given value is a snake_case name, made of lowercase ASCII words and digits separated by
+// single underscores and starting with a letter, such as "created_at" or "utf8_name".
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a snake_case name.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a snake_case name.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsSnakeCase("created_at"))  // true
+//	fmt.Println(IsSnakeCase("createdAt"))   // false
+//	fmt.Println(IsSnakeCase("created__at")) // false
+func IsSnakeCase(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSnakeCase", time.Now(), &passed)
+	}
+	return namingConventionRegex(NamingConventionSnakeCase, true).MatchString(toString(a))
+}
+
+// IsCamelCase checks if a given value is a camelCase name, made of ASCII letters and digits without separators
+// and starting with a lowercase letter, such as "createdAt" or "userID".
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a camelCase name.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a camelCase name.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsCamelCase("createdAt"))  // true
+//	fmt.Println(IsCamelCase("CreatedAt"))  // false
+//	fmt.Println(IsCamelCase("created_at")) // false
+func IsCamelCase(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsCamelCase", time.Now(), &passed)
+	}
+	return namingConventionRegex(NamingConventionCamelCase, true).MatchString(toString(a))
+}
+
+// IsPascalCase checks if a given value is a PascalCase name, made of ASCII letters and digits without separators
+// and starting with an uppercase letter, such as "CreatedAt" or "HTTPServer".
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a PascalCase name.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a PascalCase name.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsPascalCase("CreatedAt"))  // true
+//	fmt.Println(IsPascalCase("createdAt"))  // false
+//	fmt.Println(IsPascalCase("Created_At")) // false
+func IsPascalCase(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsPascalCase", time.Now(), &passed)
+	}
+	return namingConventionRegex(NamingConventionPascalCase, true).MatchString(toString(a))
+}
+
+// IsKebabCase checks if a given value is a kebab-case name, made of lowercase ASCII words and digits separated by
+// single hyphens and starting with a letter, such as "created-at" or "max-age".
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a kebab-case name.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a kebab-case name.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsKebabCase("created-at")) // true
+//	fmt.Println(IsKebabCase("created_at")) // false
+//	fmt.Println(IsKebabCase("-created"))   // false
+func IsKebabCase(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsKebabCase", time.Now(), &passed)
+	}
+	return namingConventionRegex(NamingConventionKebabCase, true).MatchString(toString(a))
+}
+
+// MatchesConvention checks if a given value is a name following the given naming convention, as checked by
+// IsSnakeCase, IsCamelCase, IsPascalCase or IsKebabCase, which lets the convention come from configuration.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a name.
+//   - conv: The naming convention the name must follow.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value follows the naming convention.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//   - The function will panic if conv is not one of the NamingConvention constants.
+//
+// Example:
+//
+//	fmt.Println(MatchesConvention("created_at", NamingConventionSnakeCase)) // true
+//	fmt.Println(MatchesConvention("created_at", NamingConventionKebabCase)) // false
+func MatchesConvention(a any, conv NamingConvention) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("MatchesConvention", time.Now(), &passed)
+	}
+	return matchesConventionWithOptions(a, conv, NamingOptions{})
+}
+
+// MatchesConventionWithOptions checks if a given value is a name following the given naming convention, like
+// MatchesConvention, with the strictness configured by the options, such as rejecting digits for code generators
+// that derive other identifiers from the name.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a name.
+//   - conv: The naming convention the name must follow.
+//   - opts: The options of the check.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value follows the naming convention.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//   - The function will panic if conv is not one of the NamingConvention constants.
+//
+// Example:
+//
+//	strict := NamingOptions{NoDigits: true}
+//	fmt.Println(MatchesConventionWithOptions("utf8_name", NamingConventionSnakeCase, strict))   // false
+//	fmt.Println(MatchesConventionWithOptions("user_name", NamingConventionSnakeCase, strict))   // true
+//	fmt.Println(MatchesConventionWithOptions("oauth2Token", NamingConventionCamelCase, strict)) // false
+func MatchesConventionWithOptions(a any, conv NamingConvention, opts NamingOptions) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("MatchesConventionWithOptions", time.Now(), &passed)
+	}
+	return matchesConventionWithOptions(a, conv, opts)
+}
+
+// matchesConventionWithOptions is the unobserved implementation of MatchesConventionWithOptions.
+func matchesConventionWithOptions(a any, conv NamingConvention, opts NamingOptions) bool {
+	return namingConventionRegex(conv, !opts.NoDigits).MatchString(toString(a))
+}
+
+// namingConventionRegex returns the pattern of the names following the naming convention, made of ASCII letters,
+// and digits when 'digits' is true, starting with a letter. It panics if the convention is not supported.
+func namingConventionRegex(conv NamingConvention, digits bool) *regexp.Regexp {
+	word, lower := `[a-z0-9]`, `[a-z][a-z0-9]*`
+	letters := `[a-zA-Z0-9]`
+	if !digits {
+		word, lower, letters = `[a-z]`, `[a-z]+`, `[a-zA-Z]`
+	}
+
+	switch conv {
+	case NamingConventionSnakeCase:
+		return regexp.MustCompile(`^` + lower + `(_` + word + `+)*$`)
+	case NamingConventionCamelCase:
+		return regexp.MustCompile(`^[a-z]` + letters + `*$`)
+	case NamingConventionPascalCase:
+		return regexp.MustCompile(`^[A-Z]` + letters + `*$`)
+	case NamingConventionKebabCase:
+		return regexp.MustCompile(`^` + lower + `(-` + word + `+)*$`)
+	default:
+		panic(fmt.Sprintf("Unsupported naming convention: %s", conv))
+	}
+}
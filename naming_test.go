@@ -0,0 +1,212 @@
+package checker
+
+import "testing"
+
+func TestIsSnakeCase(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: "created_at", want: true},
+		{name: "SingleWord", arg: "name", want: true},
+		{name: "Digits", arg: "utf8_name", want: true},
+		{name: "DigitWord", arg: "address_2", want: true},
+		{name: "Camel", arg: "createdAt", want: false},
+		{name: "Upper", arg: "CREATED_AT", want: false},
+		{name: "LeadingUnderscore", arg: "_created", want: false},
+		{name: "TrailingUnderscore", arg: "created_", want: false},
+		{name: "DoubleUnderscore", arg: "created__at", want: false},
+		{name: "LeadingDigit", arg: "2fa_code", want: false},
+		{name: "Hyphen", arg: "created-at", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsSnakeCase(tc.arg); got != tc.want {
+				t.Errorf("IsSnakeCase(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsCamelCase(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: "createdAt", want: true},
+		{name: "SingleWord", arg: "name", want: true},
+		{name: "Acronym", arg: "userID", want: true},
+		{name: "Digits", arg: "oauth2Token", want: true},
+		{name: "Pascal", arg: "CreatedAt", want: false},
+		{name: "Snake", arg: "created_at", want: false},
+		{name: "LeadingDigit", arg: "2fa", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsCamelCase(tc.arg); got != tc.want {
+				t.Errorf("IsCamelCase(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsPascalCase(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: "CreatedAt", want: true},
+		{name: "SingleWord", arg: "Name", want: true},
+		{name: "Acronym", arg: "HTTPServer", want: true},
+		{name: "Digits", arg: "Base64Encoder", want: true},
+		{name: "Camel", arg: "createdAt", want: false},
+		{name: "Snake", arg: "Created_At", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsPascalCase(tc.arg); got != tc.want {
+				t.Errorf("IsPascalCase(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsKebabCase(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: "created-at", want: true},
+		{name: "SingleWord", arg: "name", want: true},
+		{name: "Digits", arg: "x-b3-traceid", want: true},
+		{name: "Snake", arg: "created_at", want: false},
+		{name: "Upper", arg: "Created-At", want: false},
+		{name: "LeadingHyphen", arg: "-created", want: false},
+		{name: "DoubleHyphen", arg: "created--at", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsKebabCase(tc.arg); got != tc.want {
+				t.Errorf("IsKebabCase(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMatchesConvention(t *testing.T) {
+	testCases := []struct {
+		name  string
+		arg   any
+		conv  NamingConvention
+		want  bool
+		panic bool
+	}{
+		{name: "Snake", arg: "created_at", conv: NamingConventionSnakeCase, want: true},
+		{name: "Camel", arg: "createdAt", conv: NamingConventionCamelCase, want: true},
+		{name: "Pascal", arg: "CreatedAt", conv: NamingConventionPascalCase, want: true},
+		{name: "Kebab", arg: "created-at", conv: NamingConventionKebabCase, want: true},
+		{name: "Mismatch", arg: "created_at", conv: NamingConventionKebabCase, want: false},
+		{name: "Digits", arg: "utf8_name", conv: NamingConventionSnakeCase, want: true},
+		{name: "InvalidConvention", arg: "created_at", conv: NamingConvention("TRAIN_CASE"), panic: true},
+		{name: "Nil", arg: nil, conv: NamingConventionSnakeCase, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := MatchesConvention(tc.arg, tc.conv); got != tc.want {
+				t.Errorf("MatchesConvention(%v, %v) = %v, want %v", tc.arg, tc.conv, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMatchesConventionWithOptions(t *testing.T) {
+	strict := NamingOptions{NoDigits: true}
+	testCases := []struct {
+		name  string
+		arg   any
+		conv  NamingConvention
+		opts  NamingOptions
+		want  bool
+		panic bool
+	}{
+		{name: "ZeroValueAllowsDigits", arg: "utf8_name", conv: NamingConventionSnakeCase, want: true},
+		{name: "StrictSnake", arg: "user_name", conv: NamingConventionSnakeCase, opts: strict, want: true},
+		{name: "StrictSnakeDigits", arg: "utf8_name", conv: NamingConventionSnakeCase, opts: strict, want: false},
+		{name: "StrictSnakeDigitWord", arg: "address_2", conv: NamingConventionSnakeCase, opts: strict, want: false},
+		{name: "StrictCamel", arg: "userId", conv: NamingConventionCamelCase, opts: strict, want: true},
+		{name: "StrictCamelDigits", arg: "oauth2Token", conv: NamingConventionCamelCase, opts: strict, want: false},
+		{name: "StrictPascal", arg: "HTTPServer", conv: NamingConventionPascalCase, opts: strict, want: true},
+		{name: "StrictPascalDigits", arg: "Base64", conv: NamingConventionPascalCase, opts: strict, want: false},
+		{name: "StrictKebab", arg: "max-age", conv: NamingConventionKebabCase, opts: strict, want: true},
+		{name: "StrictKebabDigits", arg: "x-b3-id", conv: NamingConventionKebabCase, opts: strict, want: false},
+		{name: "StrictDoubleSeparator", arg: "max--age", conv: NamingConventionKebabCase, opts: strict,
+			want: false},
+		{name: "InvalidConvention", arg: "name", conv: NamingConvention(""), opts: strict, panic: true},
+		{name: "Nil", arg: nil, conv: NamingConventionSnakeCase, opts: strict, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := MatchesConventionWithOptions(tc.arg, tc.conv, tc.opts); got != tc.want {
+				t.Errorf("MatchesConventionWithOptions(%v, %v, %+v) = %v, want %v", tc.arg, tc.conv, tc.opts,
+					got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNamingConventionIsEnumValid(t *testing.T) {
+	if !IsEnumValid(NamingConventionKebabCase) {
+		t.Errorf("IsEnumValid(%v) = false, want true", NamingConventionKebabCase)
+	}
+	if IsEnumValid(NamingConvention("SCREAMING_CASE")) {
+		t.Errorf("IsEnumValid(SCREAMING_CASE) = true, want false")
+	}
+}
@@ -0,0 +1,300 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+// Package netcheck provides checkers that verify values against the network, such as resolving host names,
+// looking up MX records or requesting URLs. They are kept apart from the checker package, whose functions are
+// pure and offline, because every call here costs at least one round trip. Each function takes a context so
+// callers control deadlines and cancellation, and none of them retries, so the number of lookups made always
+// matches the number of calls.
+package netcheck
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/tech4works/checker"
+)
+
+// lookuper is the subset of net.Resolver used by this package.
+type lookuper interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// resolver performs the DNS lookups of this package.
+var resolver lookuper = net.DefaultResolver
+
+// IsResolvableHost checks if the given host name resolves to at least one address. IP literals are considered
+// resolvable without any lookup.
+//
+// Parameters:
+//   - ctx: The context bounding the DNS lookup.
+//   - a: The host name, as a string, a pointer to a string or a fmt.Stringer.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the host resolves to at least one address.
+//
+// Panic:
+//   - The function will panic if the value is nil or not of a supported type.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+//	defer cancel()
+//	fmt.Println(IsResolvableHost(ctx, "google.com"))      // true
+//	fmt.Println(IsResolvableHost(ctx, "127.0.0.1"))       // true
+//	fmt.Println(IsResolvableHost(ctx, "unknown.invalid")) // false
+func IsResolvableHost(ctx context.Context, a any) bool {
+	host := strings.TrimSpace(toString(a))
+	if host == "" {
+		return false
+	} else if net.ParseIP(host) != nil {
+		return true
+	}
+
+	addresses, err := resolver.LookupHost(ctx, host)
+	return err == nil && len(addresses) > 0
+}
+
+// HasMXRecord checks if the given domain publishes at least one MX record able to receive mail. An email address
+// is also accepted, in which case the domain after the last "@" is looked up. A null MX record (RFC 7505), which
+// declares that the domain accepts no mail, results in false.
+//
+// Parameters:
+//   - ctx: The context bounding the DNS lookup.
+//   - domain: The domain or email address, as a string, a pointer to a string or a fmt.Stringer.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the domain has a usable MX record.
+//
+// Panic:
+//   - The function will panic if the value is nil or not of a supported type.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+//	defer cancel()
+//	fmt.Println(HasMXRecord(ctx, "gmail.com"))         // true
+//	fmt.Println(HasMXRecord(ctx, "someone@gmail.com")) // true
+//	fmt.Println(HasMXRecord(ctx, "unknown.invalid"))   // false
+func HasMXRecord(ctx context.Context, domain any) bool {
+	name := strings.TrimSpace(toString(domain))
+	if i := strings.LastIndex(name, "@"); i >= 0 {
+		name = name[i+1:]
+	}
+	if name == "" {
+		return false
+	}
+
+	records, err := resolver.LookupMX(ctx, name)
+	if err != nil {
+		return false
+	}
+	for _, record := range records {
+		if record.Host != "." && record.Host != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// IsReachableURL checks if the given HTTP or HTTPS URL answers a HEAD request within the given timeout. Any HTTP
+// response counts as reachable, whatever its status code, since it proves the server is listening. Redirects are
+// not followed.
+//
+// Parameters:
+//   - ctx: The context bounding the request, combined with the timeout.
+//   - a: The URL, as a string, a pointer to a string or a fmt.Stringer.
+//   - timeout: The maximum duration of the request, zero meaning no timeout other than the context.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the URL answered with an HTTP response.
+//
+// Panic:
+//   - The function will panic if the value is nil or not of a supported type.
+//
+// Example:
+//
+//	fmt.Println(IsReachableURL(context.Background(), "https://google.com", 5*time.Second))    // true
+//	fmt.Println(IsReachableURL(context.Background(), "ftp://google.com", 5*time.Second))      // false
+//	fmt.Println(IsReachableURL(context.Background(), "https://unknown.invalid", time.Second)) // false
+func IsReachableURL(ctx context.Context, a any, timeout time.Duration) bool {
+	parsedURL, err := url.Parse(strings.TrimSpace(toString(a)))
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return false
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodHead, parsedURL.String(), nil)
+	if err != nil {
+		return false
+	}
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return false
+	}
+	_ = response.Body.Close()
+	return true
+}
+
+// IsSafeWebhookURL checks if the given URL is an HTTP or HTTPS address that only points at public hosts, guarding
+// webhook registrations against server-side request forgery. The host is resolved and every address must be
+// public: addresses matched by checker.IsPrivateIP (loopback, RFC 1918, link-local including the 169.254.169.254
+// metadata endpoint, and unique local IPv6), unspecified, multicast, "this network" (0.0.0.0/8) and shared address
+// space (100.64.0.0/10) are rejected, as well as any address inside the extra blocked CIDRs.
+//
+// The check happens at registration time, so the address must be validated again when connecting, for example
+// in the dialer of the HTTP client, to be safe against DNS rebinding.
+//
+// Parameters:
+//   - ctx: The context bounding the DNS lookup.
+//   - a: The URL, as a string, a pointer to a string or a fmt.Stringer.
+//   - blockedCIDRs: Optional extra ranges in CIDR notation that must also be rejected, such as internal networks.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the URL only resolves to public, non-blocked addresses.
+//
+// Panic:
+//   - The function will panic if the value is nil or not of a supported type, or if a blocked CIDR is invalid.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	fmt.Println(IsSafeWebhookURL(ctx, "https://hooks.example.com/notify"))              // true
+//	fmt.Println(IsSafeWebhookURL(ctx, "http://169.254.169.254/latest/meta-data"))       // false
+//	fmt.Println(IsSafeWebhookURL(ctx, "http://localhost:8080/hook"))                    // false
+//	fmt.Println(IsSafeWebhookURL(ctx, "https://93.184.216.34/hook", "93.184.216.0/24")) // false
+func IsSafeWebhookURL(ctx context.Context, a any, blockedCIDRs ...string) bool {
+	var blockedNets []*net.IPNet
+	for _, cidr := range append([]string{"0.0.0.0/8", "100.64.0.0/10"}, blockedCIDRs...) {
+		_, block, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(fmt.Sprintf("Invalid blocked CIDR: %s", cidr))
+		}
+		blockedNets = append(blockedNets, block)
+	}
+
+	parsedURL, err := url.Parse(strings.TrimSpace(toString(a)))
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Hostname() == "" {
+		return false
+	}
+
+	addresses := []string{parsedURL.Hostname()}
+	if net.ParseIP(parsedURL.Hostname()) == nil {
+		addresses, err = resolver.LookupHost(ctx, parsedURL.Hostname())
+		if err != nil || len(addresses) == 0 {
+			return false
+		}
+	}
+
+	for _, address := range addresses {
+		ip := net.ParseIP(address)
+		if ip == nil || checker.IsPrivateIP(address) || ip.IsUnspecified() || ip.IsMulticast() {
+			return false
+		}
+		for _, block := range blockedNets {
+			if block.Contains(ip) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// toString converts the supported values into a string, panicking with checker.ErrNilValue on nil or with a
+// checker.ErrUnsupportedType on any other type.
+func toString(a any) string {
+	switch v := a.(type) {
+	case nil:
+		panic(checker.ErrNilValue)
+	case string:
+		return v
+	case *string:
+		if v == nil {
+			panic(checker.ErrNilValue)
+		}
+		return *v
+	case fmt.Stringer:
+		return v.String()
+	default:
+		panic(checker.ErrUnsupportedType{Kind: reflect.TypeOf(a).Kind()})
+	}
+}
+
+// HasPublicMarkdownLinks checks if every link destination of the given Markdown text is safe to render, as
+// checked by checker.HasSafeMarkdownLinks, and every absolute http or https link only points at public hosts, as
+// checked by IsSafeWebhookURL. Relative links, fragments and mailto links are not resolved. Each absolute link
+// costs a DNS lookup, unless its host is an IP address.
+//
+// Parameters:
+//   - ctx: The context bounding the DNS lookups.
+//   - a: The Markdown text, as a string, a pointer to a string or a fmt.Stringer.
+//   - blockedCIDRs: Optional extra ranges in CIDR notation that links must not point at.
+//
+// Returns:
+//   - bool: A boolean value indicating whether every link is safe and only resolves to public, non-blocked addresses.
+//
+// Panic:
+//   - The function will panic if the value is nil or not of a supported type, or if a blocked CIDR is invalid.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	fmt.Println(HasPublicMarkdownLinks(ctx, "[docs](https://example.com/docs) [home](/)")) // true
+//	fmt.Println(HasPublicMarkdownLinks(ctx, "[admin](http://127.0.0.1:8080/admin)"))       // false
+//	fmt.Println(HasPublicMarkdownLinks(ctx, "[click](javascript:alert(1))"))               // false
+func HasPublicMarkdownLinks(ctx context.Context, a any, blockedCIDRs ...string) bool {
+	text := toString(a)
+	if !checker.HasSafeMarkdownLinks(text) {
+		return false
+	}
+
+	for _, link := range checker.ListMarkdownLinks(text) {
+		link = strings.TrimSpace(link)
+		if strings.HasPrefix(link, "//") {
+			link = "https:" + link
+		}
+		lower := strings.ToLower(link)
+		if (strings.HasPrefix(lower, "http:") || strings.HasPrefix(lower, "https:")) &&
+			!IsSafeWebhookURL(ctx, link, blockedCIDRs...) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,248 @@
+package netcheck
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type fakeResolver struct {
+	hosts   map[string][]string
+	records map[string][]*net.MX
+	lookups int
+}
+
+func (f *fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	f.lookups++
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	} else if addresses, ok := f.hosts[host]; ok {
+		return addresses, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func (f *fakeResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	f.lookups++
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	} else if records, ok := f.records[name]; ok {
+		return records, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+type netCase struct {
+	name  string
+	arg   any
+	ctx   context.Context
+	want  bool
+	panic bool
+}
+
+func useFakeResolver(t *testing.T) *fakeResolver {
+	fake := &fakeResolver{
+		hosts: map[string][]string{
+			"example.com": {"93.184.216.34"},
+			"empty.com":   {},
+			"localhost":   {"127.0.0.1", "::1"},
+			"mixed.com":   {"93.184.216.34", "10.0.0.5"},
+			"hooks.com":   {"93.184.216.34", "2606:2800:220:1::1"},
+		},
+		records: map[string][]*net.MX{
+			"example.com": {{Host: "mail.example.com.", Pref: 10}},
+			"nomail.com":  {{Host: ".", Pref: 0}},
+		},
+	}
+	previous := resolver
+	resolver = fake
+	t.Cleanup(func() { resolver = previous })
+	return fake
+}
+
+func canceledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+func runNetCases(t *testing.T, name string, fn func(ctx context.Context, a any) bool, tests []netCase) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if (r != nil) != tt.panic {
+					t.Errorf("%s() panic = %v, want panic %v", name, r, tt.panic)
+				}
+			}()
+
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			if got := fn(ctx, tt.arg); got != tt.want {
+				t.Errorf("%s() = %v, want %v", name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsResolvableHost(t *testing.T) {
+	fake := useFakeResolver(t)
+	host := "example.com"
+	tests := []netCase{
+		{name: "Resolvable", arg: "example.com", want: true},
+		{name: "Pointer", arg: &host, want: true},
+		{name: "IPv4Literal", arg: "127.0.0.1", want: true},
+		{name: "IPv6Literal", arg: "::1", want: true},
+		{name: "NoAddresses", arg: "empty.com", want: false},
+		{name: "Unknown", arg: "unknown.invalid", want: false},
+		{name: "Canceled", arg: "example.com", ctx: canceledContext(), want: false},
+		{name: "Empty", arg: "  ", want: false},
+		{name: "Nil", arg: nil, panic: true},
+		{name: "Unsupported", arg: 10, panic: true},
+	}
+	runNetCases(t, "IsResolvableHost", IsResolvableHost, tests)
+
+	fake.lookups = 0
+	IsResolvableHost(context.Background(), "10.0.0.1")
+	if fake.lookups != 0 {
+		t.Errorf("IsResolvableHost() made %d lookups for an IP literal, want 0", fake.lookups)
+	}
+}
+
+func TestHasMXRecord(t *testing.T) {
+	useFakeResolver(t)
+	tests := []netCase{
+		{name: "Domain", arg: "example.com", want: true},
+		{name: "Email", arg: "someone@example.com", want: true},
+		{name: "NullMX", arg: "nomail.com", want: false},
+		{name: "Unknown", arg: "unknown.invalid", want: false},
+		{name: "Canceled", arg: "example.com", ctx: canceledContext(), want: false},
+		{name: "EmptyDomain", arg: "someone@", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+	runNetCases(t, "HasMXRecord", HasMXRecord, tests)
+}
+
+func TestIsReachableURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		case "/redirect":
+			http.Redirect(w, r, "http://unknown.invalid/", http.StatusFound)
+			return
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	timeout := 50 * time.Millisecond
+
+	tests := []netCase{
+		{name: "Reachable", arg: server.URL, want: true},
+		{name: "Stringer", arg: serverURL, want: true},
+		{name: "NotFoundStatus", arg: server.URL + "/missing", want: true},
+		{name: "RedirectNotFollowed", arg: server.URL + "/redirect", want: true},
+		{name: "Timeout", arg: server.URL + "/slow", want: false},
+		{name: "Canceled", arg: server.URL, ctx: canceledContext(), want: false},
+		{name: "ClosedPort", arg: "http://127.0.0.1:1", want: false},
+		{name: "UnsupportedScheme", arg: "ftp://127.0.0.1", want: false},
+		{name: "MissingHost", arg: "http://", want: false},
+		{name: "Malformed", arg: "http://[::1", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+	runNetCases(t, "IsReachableURL", func(ctx context.Context, a any) bool {
+		return IsReachableURL(ctx, a, timeout)
+	}, tests)
+}
+
+func TestIsSafeWebhookURL(t *testing.T) {
+	useFakeResolver(t)
+	tests := []netCase{
+		{name: "PublicHost", arg: "https://hooks.com/notify", want: true},
+		{name: "PublicIPv4", arg: "http://93.184.216.34:8080/hook", want: true},
+		{name: "PublicIPv6", arg: "http://[2606:2800:220:1::1]/hook", want: true},
+		{name: "Metadata", arg: "http://169.254.169.254/latest/meta-data", want: false},
+		{name: "Loopback", arg: "http://127.0.0.1/hook", want: false},
+		{name: "LoopbackIPv6", arg: "http://[::1]/hook", want: false},
+		{name: "MappedLoopback", arg: "http://[::ffff:127.0.0.1]/hook", want: false},
+		{name: "Private", arg: "http://192.168.1.10/hook", want: false},
+		{name: "UniqueLocal", arg: "http://[fd00:ec2::254]/hook", want: false},
+		{name: "Unspecified", arg: "http://0.0.0.0/hook", want: false},
+		{name: "ThisNetwork", arg: "http://0.1.2.3/hook", want: false},
+		{name: "SharedAddressSpace", arg: "http://100.100.100.200/hook", want: false},
+		{name: "Multicast", arg: "http://224.0.0.1/hook", want: false},
+		{name: "ResolvesToLoopback", arg: "http://localhost:8080/hook", want: false},
+		{name: "ResolvesToPrivate", arg: "https://mixed.com/hook", want: false},
+		{name: "Unresolvable", arg: "https://unknown.invalid/hook", want: false},
+		{name: "Canceled", arg: "https://hooks.com/notify", ctx: canceledContext(), want: false},
+		{name: "UnsupportedScheme", arg: "gopher://93.184.216.34/hook", want: false},
+		{name: "MissingHost", arg: "https:///hook", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+	runNetCases(t, "IsSafeWebhookURL", func(ctx context.Context, a any) bool {
+		return IsSafeWebhookURL(ctx, a)
+	}, tests)
+}
+
+func TestIsSafeWebhookURLBlockedCIDRs(t *testing.T) {
+	useFakeResolver(t)
+	tests := []struct {
+		name    string
+		arg     string
+		blocked []string
+		want    bool
+		panic   bool
+	}{
+		{name: "NotBlocked", arg: "https://hooks.com/notify", blocked: []string{"10.10.0.0/16"}, want: true},
+		{name: "BlockedIPv4", arg: "https://93.184.216.34/hook", blocked: []string{"93.184.216.0/24"}, want: false},
+		{name: "BlockedResolvedIPv6", arg: "https://hooks.com/notify", blocked: []string{"2606:2800::/32"}, want: false},
+		{name: "InvalidCIDR", arg: "https://hooks.com/notify", blocked: []string{"10.10.0.0"}, panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if (r != nil) != tt.panic {
+					t.Errorf("IsSafeWebhookURL() panic = %v, want panic %v", r, tt.panic)
+				}
+			}()
+
+			if got := IsSafeWebhookURL(context.Background(), tt.arg, tt.blocked...); got != tt.want {
+				t.Errorf("IsSafeWebhookURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasPublicMarkdownLinks(t *testing.T) {
+	useFakeResolver(t)
+	tests := []netCase{
+		{name: "PublicLinks", arg: "[docs](https://hooks.com/docs) ![logo](http://93.184.216.34/logo.png)", want: true},
+		{name: "RelativeLinks", arg: "[home](/) [section](#intro) [mail](mailto:a@b.com)", want: true},
+		{name: "NoLinks", arg: "Just text", want: true},
+		{name: "Loopback", arg: "[admin](http://127.0.0.1:8080/admin)", want: false},
+		{name: "ResolvesToPrivate", arg: "See <https://mixed.com/page>", want: false},
+		{name: "ProtocolRelative", arg: "[x](//localhost/hook)", want: false},
+		{name: "ReferenceDefinition", arg: "[x][ref]\n\n[ref]: http://169.254.169.254/latest", want: false},
+		{name: "JavaScript", arg: "[click](javascript:alert(1))", want: false},
+		{name: "EntityJavaScript", arg: "[click](javascript&#58;alert(1))", want: false},
+		{name: "EntityLoopback", arg: "[admin](http&#58;//127.0.0.1/admin)", want: false},
+		{name: "BalancedParentheses", arg: "[x](https://hooks.com/a_(b))", want: true},
+		{name: "Nil", arg: nil, panic: true},
+	}
+	runNetCases(t, "HasPublicMarkdownLinks", func(ctx context.Context, a any) bool {
+		return HasPublicMarkdownLinks(ctx, a)
+	}, tests)
+}
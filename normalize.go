@@ -0,0 +1,152 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import "strings"
+
+// NormalizeCPF converts the given value to a string and strips its mask, returning the 11 digits of the CPF
+// (Cadastro de Pessoas Físicas - Brazilian tax ID) when it is valid according to IsCPF.
+//
+// Parameters:
+//   - a: Any value holding a CPF, masked or not.
+//
+// Returns:
+//   - string: The CPF digits without mask, or an empty string if the CPF is invalid.
+//   - bool: A boolean value indicating whether the value is a valid CPF.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(NormalizeCPF("121.017.210-07")) // 12101721007 true
+//	fmt.Println(NormalizeCPF("111.111.111-11")) // "" false
+func NormalizeCPF(a any) (string, bool) {
+	s := removeNonDigits(toString(a))
+	if !isCPF(s) {
+		return "", false
+	}
+	return s, true
+}
+
+// NormalizeCNPJ converts the given value to a string and strips its mask, returning the 14 digits of the CNPJ
+// (Cadastro Nacional da Pessoa Jurídica - Brazilian company ID) when it is valid according to IsCNPJ.
+//
+// Parameters:
+//   - a: Any value holding a CNPJ, masked or not.
+//
+// Returns:
+//   - string: The CNPJ digits without mask, or an empty string if the CNPJ is invalid.
+//   - bool: A boolean value indicating whether the value is a valid CNPJ.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(NormalizeCNPJ("47.263.759/0001-20")) // 47263759000120 true
+//	fmt.Println(NormalizeCNPJ("00.000.000/0001-00")) // "" false
+func NormalizeCNPJ(a any) (string, bool) {
+	s := removeNonDigits(toString(a))
+	if !isCNPJ(s) {
+		return "", false
+	}
+	return s, true
+}
+
+// NormalizeEmail converts the given value to a string, trims the surrounding whitespace and lowercases it,
+// returning the canonical email when it is valid according to IsEmail.
+//
+// Parameters:
+//   - a: Any value holding an email address.
+//
+// Returns:
+//   - string: The trimmed and lowercased email, or an empty string if the email is invalid.
+//   - bool: A boolean value indicating whether the value is a valid email.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(NormalizeEmail("  John.Doe@Example.COM ")) // john.doe@example.com true
+//	fmt.Println(NormalizeEmail("not an email"))            // "" false
+func NormalizeEmail(a any) (string, bool) {
+	s := strings.ToLower(strings.TrimSpace(toString(a)))
+	if !isEmail(s) {
+		return "", false
+	}
+	return s, true
+}
+
+// NormalizePhone converts the given value to a string and formats it as an E.164 phone number ("+" followed by
+// up to 15 digits). Numbers starting with "+" or with the "00" international prefix are kept in their country,
+// while any other number receives the default country code. Formatting characters such as spaces, dashes, dots
+// and parentheses are removed.
+//
+// Parameters:
+//   - a: Any value holding a phone number.
+//   - defaultCountryCode: The country calling code, with or without "+", applied to numbers without one, e.g. "55".
+//
+// Returns:
+//   - string: The phone number in E.164 format, or an empty string if it cannot be normalized.
+//   - bool: A boolean value indicating whether the value could be normalized to a plausible E.164 number.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(NormalizePhone("(11) 98765-4321", "55"))   // +5511987654321 true
+//	fmt.Println(NormalizePhone("+1 (415) 555-2671", "55")) // +14155552671 true
+//	fmt.Println(NormalizePhone("phone", "55"))             // "" false
+func NormalizePhone(a any, defaultCountryCode string) (string, bool) {
+	s := strings.TrimSpace(toString(a))
+	if strings.LastIndex(s, "+") > 0 || strings.ContainsFunc(s, func(r rune) bool {
+		return !strings.ContainsRune("0123456789+-.() ", r)
+	}) {
+		return "", false
+	}
+
+	digits := removeNonDigits(s)
+	if !strings.HasPrefix(s, "+") {
+		if strings.HasPrefix(digits, "00") {
+			digits = digits[2:]
+		} else {
+			digits = removeNonDigits(defaultCountryCode) + strings.TrimLeft(digits, "0")
+		}
+	}
+
+	if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return "", false
+	}
+	return "+" + digits, true
+}
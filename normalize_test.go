@@ -0,0 +1,98 @@
+package checker
+
+import "testing"
+
+type normalizeCase struct {
+	name      string
+	arg       any
+	want      string
+	wantValid bool
+	panic     bool
+}
+
+func TestNormalizeCPF(t *testing.T) {
+	tests := []normalizeCase{
+		{name: "Masked", arg: "121.017.210-07", want: "12101721007", wantValid: true},
+		{name: "Unmasked", arg: "12101721007", want: "12101721007", wantValid: true},
+		{name: "WithSpaces", arg: " 121 017 210 07 ", want: "12101721007", wantValid: true},
+		{name: "Numeric", arg: 12101721007, want: "12101721007", wantValid: true},
+		{name: "AllDigitsEqual", arg: "111.111.111-11", want: "", wantValid: false},
+		{name: "InvalidVerifier", arg: "121.017.210-08", want: "", wantValid: false},
+		{name: "Text", arg: "not a cpf", want: "", wantValid: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			got, valid := NormalizeCPF(tt.arg)
+			if got != tt.want || valid != tt.wantValid {
+				t.Errorf("NormalizeCPF(%v) = %v, %v, want %v, %v", tt.arg, got, valid, tt.want, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestNormalizeCNPJ(t *testing.T) {
+	tests := []normalizeCase{
+		{name: "Masked", arg: "47.263.759/0001-20", want: "47263759000120", wantValid: true},
+		{name: "Unmasked", arg: "57309623000168", want: "57309623000168", wantValid: true},
+		{name: "Invalid", arg: "00.000.000/0001-00", want: "", wantValid: false},
+		{name: "Text", arg: "invalid", want: "", wantValid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, valid := NormalizeCNPJ(tt.arg)
+			if got != tt.want || valid != tt.wantValid {
+				t.Errorf("NormalizeCNPJ(%v) = %v, %v, want %v, %v", tt.arg, got, valid, tt.want, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []normalizeCase{
+		{name: "Lowercase", arg: "John.Doe@Example.COM", want: "john.doe@example.com", wantValid: true},
+		{name: "Trim", arg: "  user@example.com\t", want: "user@example.com", wantValid: true},
+		{name: "Invalid", arg: "not an email", want: "", wantValid: false},
+		{name: "Empty", arg: "", want: "", wantValid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, valid := NormalizeEmail(tt.arg)
+			if got != tt.want || valid != tt.wantValid {
+				t.Errorf("NormalizeEmail(%v) = %v, %v, want %v, %v", tt.arg, got, valid, tt.want, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []normalizeCase{
+		{name: "NationalMasked", arg: "(11) 98765-4321", want: "+5511987654321", wantValid: true},
+		{name: "NationalWithTrunkPrefix", arg: "011 98765 4321", want: "+5511987654321", wantValid: true},
+		{name: "International", arg: "+1 (415) 555-2671", want: "+14155552671", wantValid: true},
+		{name: "InternationalPrefix", arg: "00 351 912 345 678", want: "+351912345678", wantValid: true},
+		{name: "Dots", arg: "11.98765.4321", want: "+5511987654321", wantValid: true},
+		{name: "Numeric", arg: 11987654321, want: "+5511987654321", wantValid: true},
+		{name: "TooShort", arg: "1234", want: "", wantValid: false},
+		{name: "TooLong", arg: "+1234567890123456", want: "", wantValid: false},
+		{name: "Letters", arg: "phone", want: "", wantValid: false},
+		{name: "PlusInTheMiddle", arg: "11+987654321", want: "", wantValid: false},
+		{name: "Empty", arg: "", want: "", wantValid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, valid := NormalizePhone(tt.arg, "55")
+			if got != tt.want || valid != tt.wantValid {
+				t.Errorf("NormalizePhone(%v) = %v, %v, want %v, %v", tt.arg, got, valid, tt.want, tt.wantValid)
+			}
+		})
+	}
+}
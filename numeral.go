@@ -0,0 +1,117 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// IsRomanNumeral checks if a given value is a Roman numeral in its standard subtractive form, between I (1) and
+// MMMCMXCIX (3999), such as the numbering of clauses and sections. Non-canonical forms, such as "IIII" or "IC",
+// are rejected. The comparison is case-insensitive, so "xiv" is accepted, but mixed case is not.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a Roman numeral.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a Roman numeral.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsRomanNumeral("XIV"))     // true
+//	fmt.Println(IsRomanNumeral("mcmxcix")) // true
+//	fmt.Println(IsRomanNumeral("IIII"))    // false
+//	fmt.Println(IsRomanNumeral("MMMM"))    // false
+func IsRomanNumeral(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsRomanNumeral", time.Now(), &passed)
+	}
+	s := toString(a)
+	if s != strings.ToUpper(s) && s != strings.ToLower(s) {
+		return false
+	}
+	regex := regexp.MustCompile(`^M{0,3}(CM|CD|D?C{0,3})(XC|XL|L?X{0,3})(IX|IV|V?I{0,3})$`)
+	return s != "" && regex.MatchString(strings.ToUpper(s))
+}
+
+// IsOrdinalString checks if a given value is an ordinal number written with digits, either in English, such as
+// "1st", "2nd", "3rd" or "11th", with the suffix matching the number, or in Portuguese, such as "1º" or "2ª",
+// optionally with a dot before the indicator ("1.º"). The English suffixes are compared case-insensitively.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an ordinal.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an ordinal number.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsOrdinalString("21st")) // true
+//	fmt.Println(IsOrdinalString("2ª"))   // true
+//	fmt.Println(IsOrdinalString("11st")) // false
+//	fmt.Println(IsOrdinalString("1"))    // false
+func IsOrdinalString(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsOrdinalString", time.Now(), &passed)
+	}
+	s := toString(a)
+	if regexp.MustCompile(`^[0-9]+\.?[ºª]$`).MatchString(s) {
+		return true
+	}
+
+	matches := regexp.MustCompile(`^([0-9]+)(?i:(st|nd|rd|th))$`).FindStringSubmatch(s)
+	if matches == nil {
+		return false
+	}
+	return strings.ToLower(matches[2]) == englishOrdinalSuffix(matches[1])
+}
+
+// englishOrdinalSuffix returns the English ordinal suffix of the given number: "st", "nd" and "rd" for numbers
+// ending in 1, 2 and 3, except for those ending in 11, 12 and 13, and "th" for all the others.
+func englishOrdinalSuffix(digits string) string {
+	if len(digits) > 1 && digits[len(digits)-2] == '1' {
+		return "th"
+	}
+	switch digits[len(digits)-1] {
+	case '1':
+		return "st"
+	case '2':
+		return "nd"
+	case '3':
+		return "rd"
+	default:
+		return "th"
+	}
+}
@@ -0,0 +1,74 @@
+package checker
+
+import "testing"
+
+func TestIsRomanNumeral(t *testing.T) {
+	testCases := []baseCase{
+		{name: "One", arg: "I", want: true},
+		{name: "Subtractive", arg: "XIV", want: true},
+		{name: "Max", arg: "MMMCMXCIX", want: true},
+		{name: "LowerCase", arg: "mcmxcix", want: true},
+		{name: "MixedCase", arg: "XiV", want: false},
+		{name: "NonCanonical", arg: "IIII", want: false},
+		{name: "InvalidSubtraction", arg: "IC", want: false},
+		{name: "TooLarge", arg: "MMMM", want: false},
+		{name: "Digits", arg: 14, want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsRomanNumeral(tc.arg); got != tc.want {
+				t.Errorf("IsRomanNumeral(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsOrdinalString(t *testing.T) {
+	testCases := []baseCase{
+		{name: "First", arg: "1st", want: true},
+		{name: "Second", arg: "2nd", want: true},
+		{name: "Third", arg: "3rd", want: true},
+		{name: "Fourth", arg: "4th", want: true},
+		{name: "Eleventh", arg: "11th", want: true},
+		{name: "TwentyFirst", arg: "21st", want: true},
+		{name: "HundredTwelfth", arg: "112th", want: true},
+		{name: "UpperCase", arg: "1ST", want: true},
+		{name: "Masculine", arg: "1º", want: true},
+		{name: "Feminine", arg: "2ª", want: true},
+		{name: "DotIndicator", arg: "1.º", want: true},
+		{name: "WrongSuffix", arg: "11st", want: false},
+		{name: "WrongSuffixTwo", arg: "2th", want: false},
+		{name: "NoSuffix", arg: "1", want: false},
+		{name: "DegreeSign", arg: "1°", want: false},
+		{name: "Spaced", arg: "1 st", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsOrdinalString(tc.arg); got != tc.want {
+				t.Errorf("IsOrdinalString(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
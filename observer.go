@@ -0,0 +1,73 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Observer is a callback invoked after each check with the name of the checker, such as "IsCPF", its result
+// and how long it took.
+type Observer func(name string, ok bool, d time.Duration)
+
+// currentObserver holds the Observer set by SetObserver, nil when observation is disabled.
+var currentObserver atomic.Pointer[Observer]
+
+// SetObserver sets the Observer invoked by every exported checker of the package that returns a bool, which allows
+// exporting metrics, such as counters of failed checks by rule, without wrapping every call. Checkers built on
+// top of others, such as IsCPFOrCNPJ, only report their own result, not the results of the checkers they call,
+// and a check that panics is reported as failed. The Observer must be safe for concurrent use and should return
+// quickly, as it runs synchronously at the end of each check. Passing nil disables the observation, which is the
+// default, and leaves the checkers with the cost of a single atomic load.
+//
+// Parameters:
+//   - fn: The Observer to be invoked after each check, or nil to disable the observation.
+//
+// Example:
+//
+//	SetObserver(func(name string, ok bool, d time.Duration) {
+//		fmt.Println(name, ok)
+//	})
+//	IsCPF("12101721007") // prints "IsCPF true"
+//	SetObserver(nil)
+func SetObserver(fn Observer) {
+	if fn == nil {
+		currentObserver.Store(nil)
+	} else {
+		currentObserver.Store(&fn)
+	}
+}
+
+// loadObserver returns the Observer set by SetObserver, or nil if there is none.
+func loadObserver() Observer {
+	if fn := currentObserver.Load(); fn != nil {
+		return *fn
+	}
+	return nil
+}
+
+// observe invokes the Observer with the result of the check named 'name' started at 'start'.
+func (o Observer) observe(name string, start time.Time, passed *bool) {
+	o(name, *passed, time.Since(start))
+}
@@ -0,0 +1,105 @@
+package checker
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type observation struct {
+	name string
+	ok   bool
+}
+
+func recordObservations(t *testing.T) *[]observation {
+	var mutex sync.Mutex
+	observations := &[]observation{}
+	SetObserver(func(name string, ok bool, d time.Duration) {
+		if d < 0 {
+			t.Errorf("Observer received negative duration %v for %s", d, name)
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		*observations = append(*observations, observation{name: name, ok: ok})
+	})
+	t.Cleanup(func() { SetObserver(nil) })
+	return observations
+}
+
+func TestSetObserver(t *testing.T) {
+	testCases := []struct {
+		name string
+		fn   func()
+		want []observation
+	}{
+		{
+			name: "Passed",
+			fn:   func() { IsCPF("12101721007") },
+			want: []observation{{name: "IsCPF", ok: true}},
+		},
+		{
+			name: "Failed",
+			fn:   func() { IsEmail("invalid") },
+			want: []observation{{name: "IsEmail", ok: false}},
+		},
+		{
+			name: "Nested",
+			fn:   func() { IsNotEmail("invalid") },
+			want: []observation{{name: "IsNotEmail", ok: true}},
+		},
+		{
+			name: "NestedNotEmpty",
+			fn:   func() { IsNotEmpty("a") },
+			want: []observation{{name: "IsNotEmpty", ok: true}},
+		},
+		{
+			name: "NestedWithOptions",
+			fn:   func() { IsSameEmail("user@Example.COM", "user@example.com") },
+			want: []observation{{name: "IsSameEmail", ok: true}},
+		},
+		{
+			name: "NestedInHelper",
+			fn:   func() { IsBeforeNow(time.Now().Add(-time.Hour)) },
+			want: []observation{{name: "IsBeforeNow", ok: true}},
+		},
+		{
+			name: "NestedInRuleMap",
+			fn:   func() { IsNationalID("BR", "12101721007") },
+			want: []observation{{name: "IsNationalID", ok: true}},
+		},
+		{
+			name: "Sequential",
+			fn:   func() { IsURLSafe("abc"); IsCPFOrCNPJ("12101721007") },
+			want: []observation{{name: "IsURLSafe", ok: true}, {name: "IsCPFOrCNPJ", ok: true}},
+		},
+		{
+			name: "Panic",
+			fn:   func() { Quietly(func() bool { return IsCPF(nil) }) },
+			want: []observation{{name: "IsCPF", ok: false}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			observations := recordObservations(t)
+			tc.fn()
+			if len(*observations) != len(tc.want) {
+				t.Fatalf("Observer received %v, want %v", *observations, tc.want)
+			}
+			for i, want := range tc.want {
+				if (*observations)[i] != want {
+					t.Errorf("Observer received %v, want %v", *observations, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSetObserverNil(t *testing.T) {
+	observations := recordObservations(t)
+	SetObserver(nil)
+	IsCPF("12101721007")
+	if len(*observations) != 0 {
+		t.Errorf("Observer received %v after being disabled, want nothing", *observations)
+	}
+}
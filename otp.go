@@ -0,0 +1,145 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"encoding/base32"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// IsOTPCode checks if a given value is a one-time password code, such as the HOTP and TOTP codes of authenticator
+// apps, made of exactly the given number of ASCII digits. Codes should be passed as strings, as numeric values
+// lose their leading zeros.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an OTP code.
+//   - digits: The number of digits of the code, usually 6 or 8.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an OTP code with the given number of digits.
+//
+// Panic:
+//   - The function will panic if digits is not positive.
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsOTPCode("012345", 6))   // true
+//	fmt.Println(IsOTPCode("12345678", 8)) // true
+//	fmt.Println(IsOTPCode("12345", 6))    // false
+//	fmt.Println(IsOTPCode("12a456", 6))   // false
+func IsOTPCode(a any, digits int) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsOTPCode", time.Now(), &passed)
+	}
+	if digits <= 0 {
+		panic(fmt.Sprintf("Invalid number of OTP digits: %d", digits))
+	}
+	s := toString(a)
+	return len(s) == digits && isStrictNumeric(s)
+}
+
+// IsTOTPSecret checks if a given value is a TOTP shared secret as shown by authenticator setups: a base32 string
+// (RFC 4648), case-insensitive, optionally padded with '=' and grouped with spaces, that decodes to between 10
+// bytes (80 bits, the minimum of RFC 4226) and 64 bytes.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a TOTP secret.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a TOTP secret.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsTOTPSecret("JBSWY3DPEHPK3PXP"))    // true
+//	fmt.Println(IsTOTPSecret("jbsw y3dp ehpk 3pxp")) // true
+//	fmt.Println(IsTOTPSecret("JBSWY3DP"))            // false
+//	fmt.Println(IsTOTPSecret("JBSWY3DPEHPK3PX1"))    // false
+func IsTOTPSecret(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsTOTPSecret", time.Now(), &passed)
+	}
+	s := strings.ToUpper(strings.ReplaceAll(toString(a), " ", ""))
+
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+	if strings.HasSuffix(s, "=") {
+		encoding = base32.StdEncoding
+	}
+	decoded, err := encoding.DecodeString(s)
+	return err == nil && len(decoded) >= 10 && len(decoded) <= 64
+}
+
+// IsRecoveryCode checks if a given value is a recovery code, also called backup code, that follows the given
+// format. In the format, '#' stands for a digit, 'A' for a letter, '*' for a letter or a digit, and any other
+// character must appear as is, so "****-****" accepts "a1b2-c3d4". Letters are accepted in any case.
+//
+// Parameters:
+//   - format: The format of the recovery codes.
+//   - a: Any value to be converted into a string and checked as a recovery code.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value follows the recovery code format.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsRecoveryCode("****-****", "a1b2-c3d4"))     // true
+//	fmt.Println(IsRecoveryCode("#####-#####", "12345-67890")) // true
+//	fmt.Println(IsRecoveryCode("#####-#####", "1234567890"))  // false
+//	fmt.Println(IsRecoveryCode("AAAA", "AB1C"))               // false
+func IsRecoveryCode(format string, a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsRecoveryCode", time.Now(), &passed)
+	}
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	for _, r := range format {
+		switch r {
+		case '#':
+			pattern.WriteString("[0-9]")
+		case 'A':
+			pattern.WriteString("[a-zA-Z]")
+		case '*':
+			pattern.WriteString("[a-zA-Z0-9]")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	pattern.WriteString("$")
+
+	return regexp.MustCompile(pattern.String()).MatchString(toString(a))
+}
@@ -0,0 +1,109 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsTOTPSecret(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Minimum", arg: "JBSWY3DPEHPK3PXP", want: true},
+		{name: "LowerCaseGrouped", arg: "jbsw y3dp ehpk 3pxp", want: true},
+		{name: "Padded", arg: "JBSWY3DPEHPK3PXPJBSWY3DPEE======", want: true},
+		{name: "Long", arg: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", want: true},
+		{name: "TooShort", arg: "JBSWY3DP", want: false},
+		{name: "InvalidCharacter", arg: "JBSWY3DPEHPK3PX1", want: false},
+		{name: "TooLong", arg: strings.Repeat("A", 104), want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsTOTPSecret(tc.arg); got != tc.want {
+				t.Errorf("IsTOTPSecret(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsOTPCode(t *testing.T) {
+	testCases := []struct {
+		name   string
+		arg    any
+		digits int
+		want   bool
+		panic  bool
+	}{
+		{name: "SixDigits", arg: "012345", digits: 6, want: true},
+		{name: "EightDigits", arg: "12345678", digits: 8, want: true},
+		{name: "Int", arg: 123456, digits: 6, want: true},
+		{name: "IntLosesLeadingZero", arg: 12345, digits: 6, want: false},
+		{name: "TooShort", arg: "12345", digits: 6, want: false},
+		{name: "TooLong", arg: "1234567", digits: 6, want: false},
+		{name: "Letter", arg: "12a456", digits: 6, want: false},
+		{name: "Spaced", arg: "123 456", digits: 6, want: false},
+		{name: "InvalidDigits", arg: "123456", digits: 0, panic: true},
+		{name: "Nil", arg: nil, digits: 6, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsOTPCode(tc.arg, tc.digits); got != tc.want {
+				t.Errorf("IsOTPCode(%v, %v) = %v, want %v", tc.arg, tc.digits, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsRecoveryCode(t *testing.T) {
+	testCases := []struct {
+		name   string
+		format string
+		arg    any
+		want   bool
+		panic  bool
+	}{
+		{name: "Alphanumeric", format: "****-****", arg: "a1b2-c3d4", want: true},
+		{name: "UpperCase", format: "****-****", arg: "A1B2-C3D4", want: true},
+		{name: "Digits", format: "#####-#####", arg: "12345-67890", want: true},
+		{name: "Letters", format: "AAAA", arg: "abCD", want: true},
+		{name: "LiteralPrefix", format: "RC.####", arg: "RC.1234", want: true},
+		{name: "WrongLiteral", format: "RC.####", arg: "RCX1234", want: false},
+		{name: "MissingSeparator", format: "#####-#####", arg: "1234567890", want: false},
+		{name: "DigitForLetter", format: "AAAA", arg: "AB1C", want: false},
+		{name: "TooLong", format: "****", arg: "abcde", want: false},
+		{name: "Empty", format: "****", arg: "", want: false},
+		{name: "Nil", format: "****", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsRecoveryCode(tc.format, tc.arg); got != tc.want {
+				t.Errorf("IsRecoveryCode(%v, %v) = %v, want %v", tc.format, tc.arg, got, tc.want)
+			}
+		})
+	}
+}
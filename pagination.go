@@ -0,0 +1,180 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"encoding/base64"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cursorMaxLength is the maximum number of characters accepted in an opaque pagination cursor.
+const cursorMaxLength = 1024
+
+// IsValidPage checks if a given value is a pagination page number: a positive integer, written without sign or
+// leading zeros, that fits an int.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a page number.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a page number.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsValidPage("1"))  // true
+//	fmt.Println(IsValidPage(25))   // true
+//	fmt.Println(IsValidPage("0"))  // false
+//	fmt.Println(IsValidPage("-1")) // false
+func IsValidPage(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsValidPage", time.Now(), &passed)
+	}
+	_, ok := parsePositiveInt(toString(a))
+	return ok
+}
+
+// IsPageSizeWithin checks if a given value is a pagination page size between 1 and the given maximum, written as
+// a positive integer without sign or leading zeros.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a page size.
+//   - max: The maximum page size.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a page size within the maximum.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsPageSizeWithin("50", 100))  // true
+//	fmt.Println(IsPageSizeWithin(100, 100))   // true
+//	fmt.Println(IsPageSizeWithin("500", 100)) // false
+//	fmt.Println(IsPageSizeWithin("0", 100))   // false
+func IsPageSizeWithin(a any, max int) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsPageSizeWithin", time.Now(), &passed)
+	}
+	size, ok := parsePositiveInt(toString(a))
+	return ok && size <= max
+}
+
+// IsOpaqueCursor checks if a given value is an opaque pagination cursor: a non-empty base64url string, with or
+// without padding, that can be decoded and has at most 1024 characters.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a cursor.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an opaque cursor.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsOpaqueCursor("eyJpZCI6NDJ9"))     // true
+//	fmt.Println(IsOpaqueCursor("eyJpZCI6NDJ9fQ==")) // true
+//	fmt.Println(IsOpaqueCursor("eyJpZCI6+DJ9"))     // false
+//	fmt.Println(IsOpaqueCursor(""))                 // false
+func IsOpaqueCursor(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsOpaqueCursor", time.Now(), &passed)
+	}
+	s := toString(a)
+	if s == "" || len(s) > cursorMaxLength {
+		return false
+	}
+
+	encoding := base64.RawURLEncoding
+	if strings.HasSuffix(s, "=") {
+		encoding = base64.URLEncoding
+	}
+	_, err := encoding.DecodeString(s)
+	return err == nil
+}
+
+// IsSortExpression checks if a given value is a sort expression of a list endpoint: a comma-separated list of
+// field names, each optionally prefixed by '-' for descending or '+' for ascending order, such as
+// "name,-created_at". Field names start with a letter or '_' and may contain letters, digits, '_' and '.', and
+// no field may appear twice. When allowed fields are given, every field must be one of them.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a sort expression.
+//   - allowedFields: The fields that may be sorted on, any field when empty.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a sort expression.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsSortExpression("name,-created_at"))             // true
+//	fmt.Println(IsSortExpression("-price", "name", "created_at")) // false
+//	fmt.Println(IsSortExpression("name,,id"))                     // false
+//	fmt.Println(IsSortExpression("name,-name"))                   // false
+func IsSortExpression(a any, allowedFields ...string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSortExpression", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^[-+]?([A-Za-z_][A-Za-z0-9_.]*)$`)
+
+	seen := map[string]bool{}
+	for _, term := range strings.Split(toString(a), ",") {
+		matches := regex.FindStringSubmatch(term)
+		if matches == nil || seen[matches[1]] {
+			return false
+		} else if len(allowedFields) > 0 && !slices.Contains(allowedFields, matches[1]) {
+			return false
+		}
+		seen[matches[1]] = true
+	}
+	return true
+}
+
+// parsePositiveInt parses s as a positive int written without sign or leading zeros.
+func parsePositiveInt(s string) (int, bool) {
+	if !regexp.MustCompile(`^[1-9][0-9]*$`).MatchString(s) {
+		return 0, false
+	}
+	i, err := strconv.Atoi(s)
+	return i, err == nil
+}
@@ -0,0 +1,142 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsValidPage(t *testing.T) {
+	testCases := []baseCase{
+		{name: "One", arg: "1", want: true},
+		{name: "Int", arg: 25, want: true},
+		{name: "Zero", arg: "0", want: false},
+		{name: "Negative", arg: "-1", want: false},
+		{name: "Signed", arg: "+1", want: false},
+		{name: "LeadingZero", arg: "01", want: false},
+		{name: "Decimal", arg: "1.5", want: false},
+		{name: "Overflow", arg: "99999999999999999999", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsValidPage(tc.arg); got != tc.want {
+				t.Errorf("IsValidPage(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsOpaqueCursor(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Raw", arg: "eyJpZCI6NDJ9", want: true},
+		{name: "Padded", arg: "eyJpZCI6NDJ9fQ==", want: true},
+		{name: "URLAlphabet", arg: "_-_-", want: true},
+		{name: "MaxLength", arg: strings.Repeat("A", 1024), want: true},
+		{name: "TooLong", arg: strings.Repeat("A", 1028), want: false},
+		{name: "StandardAlphabet", arg: "eyJpZCI6+DJ9", want: false},
+		{name: "BadLength", arg: "abcde", want: false},
+		{name: "BadPadding", arg: "eyJpZCI6NDJ9=", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsOpaqueCursor(tc.arg); got != tc.want {
+				t.Errorf("IsOpaqueCursor(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsPageSizeWithin(t *testing.T) {
+	testCases := []struct {
+		name  string
+		arg   any
+		max   int
+		want  bool
+		panic bool
+	}{
+		{name: "WithinMax", arg: "50", max: 100, want: true},
+		{name: "EqualToMax", arg: 100, max: 100, want: true},
+		{name: "AboveMax", arg: "500", max: 100, want: false},
+		{name: "Zero", arg: "0", max: 100, want: false},
+		{name: "Negative", arg: -10, max: 100, want: false},
+		{name: "NotNumber", arg: "all", max: 100, want: false},
+		{name: "Nil", arg: nil, max: 100, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsPageSizeWithin(tc.arg, tc.max); got != tc.want {
+				t.Errorf("IsPageSizeWithin(%v, %v) = %v, want %v", tc.arg, tc.max, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsSortExpression(t *testing.T) {
+	testCases := []struct {
+		name          string
+		arg           any
+		allowedFields []string
+		want          bool
+		panic         bool
+	}{
+		{name: "SingleField", arg: "name", want: true},
+		{name: "Descending", arg: "name,-created_at", want: true},
+		{name: "Ascending", arg: "+name", want: true},
+		{name: "NestedField", arg: "address.city", want: true},
+		{name: "Allowed", arg: "-created_at,name", allowedFields: []string{"name", "created_at"}, want: true},
+		{name: "NotAllowed", arg: "-price", allowedFields: []string{"name", "created_at"}, want: false},
+		{name: "EmptyTerm", arg: "name,,id", want: false},
+		{name: "TrailingComma", arg: "name,", want: false},
+		{name: "Duplicated", arg: "name,-name", want: false},
+		{name: "DoubleSign", arg: "--name", want: false},
+		{name: "Space", arg: "name, id", want: false},
+		{name: "LeadingDigit", arg: "1name", want: false},
+		{name: "Injection", arg: "name;DROP TABLE users", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsSortExpression(tc.arg, tc.allowedFields...); got != tc.want {
+				t.Errorf("IsSortExpression(%v, %v) = %v, want %v", tc.arg, tc.allowedFields, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	_ "embed"
+	"strings"
+	"time"
+)
+
+// commonPasswordsList is the embedded list of the few hundred most common passwords, one per line, taken from
+// the top of public breach compilations and completed with passwords common among Brazilian users.
+//
+//go:embed common_passwords.txt
+var commonPasswordsList string
+
+// commonPasswords holds the leetspeak-folded common passwords, built on first use.
+var commonPasswords = lazySet(commonPasswordsList, foldLeetspeak)
+
+// IsCommonPassword checks if a given value is one of the most common passwords found in public breach
+// compilations, such as "123456" or "qwerty", without any network call. The embedded list only holds a few
+// hundred passwords, the ones attackers try first, so a password that passes the check may still be found in
+// larger breach lists. The comparison is case-insensitive and folds the usual leetspeak substitutions, so
+// "P@ssw0rd" matches "password".
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as one of the most common passwords.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is one of the most common passwords.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsCommonPassword("123456"))                       // true
+//	fmt.Println(IsCommonPassword("P@ssw0rd"))                     // true
+//	fmt.Println(IsCommonPassword("correct-horse-battery-staple")) // false
+func IsCommonPassword(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsCommonPassword", time.Now(), &passed)
+	}
+	_, ok := commonPasswords()[foldLeetspeak(toString(a))]
+	return ok
+}
+
+// ContainsUserInfo checks if a given password contains any of the given user fields, such as the name, the
+// username or the email of the user. Each field is split into words, emails being reduced to their local part,
+// and only words with at least 3 characters are searched for. The comparison is case-insensitive and folds the
+// usual leetspeak substitutions, as in IsCommonPassword.
+//
+// Parameters:
+//   - password: Any value to be converted into a string and checked.
+//   - userFields: The user information that must not appear in the password.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the password contains any of the user fields.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(ContainsUserInfo("M4ria2024!", "Maria Silva", "maria@example.com")) // true
+//	fmt.Println(ContainsUserInfo("s1lva#secure", "Maria Silva"))                    // true
+//	fmt.Println(ContainsUserInfo("T7#kq9!vLm", "Maria Silva", "maria@example.com")) // false
+func ContainsUserInfo(password any, userFields ...string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ContainsUserInfo", time.Now(), &passed)
+	}
+	folded := foldLeetspeak(toString(password))
+	for _, field := range userFields {
+		if at := strings.LastIndex(field, "@"); at > 0 {
+			field = field[:at]
+		}
+		words := strings.FieldsFunc(field, func(r rune) bool { return strings.ContainsRune(" ._-+", r) })
+		for _, word := range words {
+			if len([]rune(word)) >= 3 && strings.Contains(folded, foldLeetspeak(word)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// foldLeetspeak lowercases s and replaces the usual leetspeak substitutions with the letters they stand for.
+func foldLeetspeak(s string) string {
+	replacer := strings.NewReplacer("0", "o", "1", "i", "!", "i", "3", "e", "4", "a", "@", "a", "5", "s", "$", "s",
+		"7", "t", "8", "b")
+	return replacer.Replace(strings.ToLower(s))
+}
@@ -0,0 +1,71 @@
+package checker
+
+import "testing"
+
+func TestIsCommonPassword(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Digits", arg: "123456", want: true},
+		{name: "Int", arg: 123456, want: true},
+		{name: "Word", arg: "password", want: true},
+		{name: "UpperCase", arg: "QWERTY", want: true},
+		{name: "Leetspeak", arg: "P@ssw0rd", want: true},
+		{name: "LeetspeakOfPlainEntry", arg: "$unsh1ne", want: true},
+		{name: "Portuguese", arg: "Senha123", want: true},
+		{name: "Passphrase", arg: "correct-horse-battery-staple", want: false},
+		{name: "Random", arg: "T7#kq9!vLm", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsCommonPassword(tc.arg); got != tc.want {
+				t.Errorf("IsCommonPassword(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContainsUserInfo(t *testing.T) {
+	testCases := []struct {
+		name       string
+		password   any
+		userFields []string
+		want       bool
+		panic      bool
+	}{
+		{name: "FirstName", password: "Maria2024!", userFields: []string{"Maria Silva"}, want: true},
+		{name: "LastName", password: "s1lva#secure", userFields: []string{"Maria Silva"}, want: true},
+		{name: "Leetspeak", password: "M4ria2024!", userFields: []string{"Maria Silva"}, want: true},
+		{name: "EmailLocalPart", password: "xx.jsmith.xx", userFields: []string{"j.smith@example.com"}, want: true},
+		{name: "EmailLocalWord", password: "smith2024", userFields: []string{"j.smith@example.com"}, want: true},
+		{name: "EmailDomainIgnored", password: "example2024", userFields: []string{"maria@example.com"}, want: false},
+		{name: "ShortWordsIgnored", password: "da-Costa-free", userFields: []string{"Jo da Si"}, want: false},
+		{name: "Unrelated", password: "T7#kq9!vLm", userFields: []string{"Maria Silva", "maria@example.com"}},
+		{name: "NoFields", password: "Maria2024!", userFields: nil, want: false},
+		{name: "Nil", password: nil, userFields: []string{"Maria"}, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := ContainsUserInfo(tc.password, tc.userFields...); got != tc.want {
+				t.Errorf("ContainsUserInfo(%v, %v) = %v, want %v", tc.password, tc.userFields, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// jsonPatchOperation is an operation of a JSON Patch document, keeping its members raw so that a missing member
+// can be told apart from one set to null.
+type jsonPatchOperation struct {
+	Op    json.RawMessage
+	Path  json.RawMessage
+	From  json.RawMessage
+	Value json.RawMessage
+}
+
+// IsJSONMergePatch checks if a given value is a JSON Merge Patch document as defined by RFC 7386: a JSON object
+// whose members replace the members of the target with the same name, with null members removing them and nested
+// objects being merged recursively. RFC 7386 also lets any other JSON value replace the whole target, which is
+// rarely what a PATCH endpoint expects, so such documents, including "null", fail the check.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being decoded.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a JSON Merge Patch object.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsJSONMergePatch(`{"name": "Maria", "phone": null}`))     // true
+//	fmt.Println(IsJSONMergePatch(`{}`))                                   // true
+//	fmt.Println(IsJSONMergePatch(`[{"op": "remove", "path": "/phone"}]`)) // false
+//	fmt.Println(IsJSONMergePatch("null"))                                 // false
+func IsJSONMergePatch(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsJSONMergePatch", time.Now(), &passed)
+	}
+	object, ok := unmarshalJSONObject(a)
+	return ok && object != nil
+}
+
+// IsJSONPatch checks if a given value is a JSON Patch document as defined by RFC 6902: a JSON array of operation
+// objects, each with an "op" among "add", "remove", "replace", "move", "copy" and "test" and a "path" that is a
+// JSON Pointer (RFC 6901). The "add", "replace" and "test" operations also require a "value", which may be null,
+// and "move" and "copy" require a "from" pointer, which for "move" must not be a parent of "path". Other members
+// are ignored, as the RFC mandates, but operations with a repeated member, or with one of these members named in
+// another case, such as "PATH", are rejected, as decoders disagree on which one applies.
+//
+// Parameters:
+//   - a: Any value to be checked. It is converted to bytes before being decoded.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid JSON Patch document.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsJSONPatch(`[{"op": "replace", "path": "/name", "value": "Maria"}]`)) // true
+//	fmt.Println(IsJSONPatch(`[{"op": "move", "from": "/a", "path": "/a/b"}]`))         // false
+//	fmt.Println(IsJSONPatch(`[{"op": "add", "path": "name", "value": 1}]`))            // false
+//	fmt.Println(IsJSONPatch(`{"name": "Maria"}`))                                      // false
+func IsJSONPatch(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsJSONPatch", time.Now(), &passed)
+	}
+	_, ok := parseJSONPatch(toBytes(a))
+	return ok
+}
+
+// PatchTargetsAllowedPaths checks if a given patch document only changes the locations allowed by the given JSON
+// Pointers, each allowing itself and everything under it, so "/address" allows "/address/city". A JSON Patch (see
+// IsJSONPatch) changes the "path" of each operation and reads the "from" of "move" and "copy" operations, which
+// must be allowed as well, as a move removes the value there and a copy discloses it at the "path". The "test"
+// operations change nothing and are not checked. A JSON Merge Patch (see IsJSONMergePatch)
+// changes the location of each member that is not a non-empty object, whose own members are checked instead.
+// Values that are neither fail the check.
+//
+// Parameters:
+//   - patch: Any value to be checked. It is converted to bytes before being decoded.
+//   - allowed: The JSON Pointers of the locations the patch may change.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a patch changing only allowed locations.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	ops := `[{"op": "replace", "path": "/address/city", "value": "Recife"}]`
+//	fmt.Println(PatchTargetsAllowedPaths(ops, "/name", "/address"))                           // true
+//	fmt.Println(PatchTargetsAllowedPaths(`{"name": "Maria", "role": "admin"}`, "/name"))      // false
+//	fmt.Println(PatchTargetsAllowedPaths(`{"address": {"city": "Recife"}}`, "/address/city")) // true
+//	copied := `[{"op": "copy", "from": "/password", "path": "/name"}]`
+//	fmt.Println(PatchTargetsAllowedPaths(copied, "/name"))                                    // false
+func PatchTargetsAllowedPaths(patch any, allowed ...string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("PatchTargetsAllowedPaths", time.Now(), &passed)
+	}
+	b := toBytes(patch)
+
+	var targets []string
+	if operations, ok := parseJSONPatch(b); ok {
+		for _, operation := range operations {
+			switch unquoteJSONPatchMember(operation.Op) {
+			case "test":
+				continue
+			case "move", "copy":
+				targets = append(targets, unquoteJSONPatchMember(operation.From))
+			}
+			targets = append(targets, unquoteJSONPatchMember(operation.Path))
+		}
+	} else if object, ok := unmarshalJSONObject(b); ok && object != nil {
+		targets = mergePatchTargets("", object)
+	} else {
+		return false
+	}
+
+	for _, target := range targets {
+		if !containsOnSlice(allowed, func(_ int, pointer string) bool {
+			return target == pointer || strings.HasPrefix(target, pointer+"/")
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseJSONPatch decodes b as a JSON Patch document, reporting false when it is not one by the rules of
+// IsJSONPatch.
+func parseJSONPatch(b []byte) ([]jsonPatchOperation, bool) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(b, &raws); err != nil || raws == nil {
+		return nil, false
+	}
+
+	operations := make([]jsonPatchOperation, 0, len(raws))
+	for _, raw := range raws {
+		members, ok := unmarshalJSONPatchMembers(raw)
+		if !ok {
+			return nil, false
+		}
+		operation := jsonPatchOperation{Op: members["op"], Path: members["path"], From: members["from"],
+			Value: members["value"]}
+		operations = append(operations, operation)
+
+		path, ok := jsonPatchPointer(operation.Path)
+		if !ok {
+			return nil, false
+		}
+		switch unquoteJSONPatchMember(operation.Op) {
+		case "remove":
+		case "add", "replace", "test":
+			if operation.Value == nil {
+				return nil, false
+			}
+		case "move", "copy":
+			from, ok := jsonPatchPointer(operation.From)
+			if !ok || (unquoteJSONPatchMember(operation.Op) == "move" && strings.HasPrefix(path, from+"/")) {
+				return nil, false
+			}
+		default:
+			return nil, false
+		}
+	}
+	return operations, true
+}
+
+// unmarshalJSONPatchMembers decodes a raw operation as a JSON object, keyed by the exact member names. As
+// encoding/json matches struct fields case-insensitively and keeps the last of repeated members, an operation
+// with a repeated member, or with a member naming "op", "path", "from" or "value" in another case, is rejected,
+// so that no reading of the operation can differ from the one checked.
+func unmarshalJSONPatchMembers(raw json.RawMessage) (map[string]json.RawMessage, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return nil, false
+	}
+
+	members := map[string]json.RawMessage{}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, false
+		}
+		key := token.(string)
+		if _, ok := members[key]; ok {
+			return nil, false
+		}
+		for _, name := range []string{"op", "path", "from", "value"} {
+			if key != name && strings.EqualFold(key, name) {
+				return nil, false
+			}
+		}
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, false
+		}
+		members[key] = value
+	}
+	return members, true
+}
+
+// jsonPatchPointer decodes a raw operation member as a JSON Pointer, "" or a sequence of "/"-prefixed reference
+// tokens in which "~" is only used in the "~0" and "~1" escapes.
+func jsonPatchPointer(raw json.RawMessage) (string, bool) {
+	var pointer string
+	if err := json.Unmarshal(raw, &pointer); raw == nil || err != nil {
+		return "", false
+	}
+	regex := regexp.MustCompile(`^(/([^~/]|~[01])*)*$`)
+	return pointer, regex.MatchString(pointer)
+}
+
+// unquoteJSONPatchMember decodes a raw operation member as a string, returning an empty string when it is missing
+// or not a string.
+func unquoteJSONPatchMember(raw json.RawMessage) string {
+	var s string
+	_ = json.Unmarshal(raw, &s)
+	return s
+}
+
+// mergePatchTargets lists the JSON Pointers of the locations changed by the members of a merge patch object,
+// descending into the members that are non-empty objects.
+func mergePatchTargets(prefix string, object map[string]json.RawMessage) []string {
+	var targets []string
+	for key, value := range object {
+		pointer := prefix + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+		if nested, ok := unmarshalJSONObject([]byte(value)); ok && len(nested) > 0 {
+			targets = append(targets, mergePatchTargets(pointer, nested)...)
+		} else {
+			targets = append(targets, pointer)
+		}
+	}
+	return targets
+}
@@ -0,0 +1,149 @@
+package checker
+
+import "testing"
+
+func TestIsJSONMergePatch(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Object", arg: `{"name": "Maria", "phone": null}`, want: true},
+		{name: "Nested", arg: `{"address": {"city": "Recife", "zip": null}}`, want: true},
+		{name: "Empty", arg: `{}`, want: true},
+		{name: "Map", arg: map[string]any{"name": "Maria"}, want: true},
+		{name: "JSONPatch", arg: `[{"op": "remove", "path": "/phone"}]`, want: false},
+		{name: "Scalar", arg: `"Maria"`, want: false},
+		{name: "Null", arg: "null", want: false},
+		{name: "Malformed", arg: `{"name": }`, want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsJSONMergePatch(tc.arg); got != tc.want {
+				t.Errorf("IsJSONMergePatch(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsJSONPatch(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Replace", arg: `[{"op": "replace", "path": "/name", "value": "Maria"}]`, want: true},
+		{name: "AllOperations", arg: `[{"op": "add", "path": "/a/-", "value": 1}, {"op": "remove", "path": "/b"},` +
+			`{"op": "replace", "path": "", "value": {}}, {"op": "move", "from": "/c", "path": "/d"},` +
+			`{"op": "copy", "from": "/d", "path": "/d/e"}, {"op": "test", "path": "/f", "value": null}]`, want: true},
+		{name: "EscapedPointer", arg: `[{"op": "remove", "path": "/a~1b/c~0d"}]`, want: true},
+		{name: "ExtraMembers", arg: `[{"op": "remove", "path": "/a", "comment": "ignored"}]`, want: true},
+		{name: "Empty", arg: `[]`, want: true},
+		{name: "MissingValue", arg: `[{"op": "add", "path": "/a"}]`, want: false},
+		{name: "MissingFrom", arg: `[{"op": "copy", "path": "/a"}]`, want: false},
+		{name: "MissingPath", arg: `[{"op": "remove"}]`, want: false},
+		{name: "MoveIntoChild", arg: `[{"op": "move", "from": "/a", "path": "/a/b"}]`, want: false},
+		{name: "MoveToSibling", arg: `[{"op": "move", "from": "/a", "path": "/ab"}]`, want: true},
+		{name: "UnknownOp", arg: `[{"op": "merge", "path": "/a", "value": 1}]`, want: false},
+		{name: "NonStringOp", arg: `[{"op": 1, "path": "/a"}]`, want: false},
+		{name: "RelativePath", arg: `[{"op": "add", "path": "name", "value": 1}]`, want: false},
+		{name: "BadEscape", arg: `[{"op": "remove", "path": "/a~2"}]`, want: false},
+		{name: "NonStringPath", arg: `[{"op": "remove", "path": 1}]`, want: false},
+		{name: "NonObjectOperation", arg: `[1]`, want: false},
+		{name: "NullOperation", arg: `[null]`, want: false},
+		{name: "RepeatedMember", arg: `[{"op": "remove", "path": "/a", "path": "/b"}]`, want: false},
+		{name: "CaseVariantMember", arg: `[{"op": "remove", "PATH": "/a"}]`, want: false},
+		{name: "MergePatch", arg: `{"name": "Maria"}`, want: false},
+		{name: "Null", arg: "null", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsJSONPatch(tc.arg); got != tc.want {
+				t.Errorf("IsJSONPatch(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+type patchPathsCase struct {
+	name    string
+	patch   any
+	allowed []string
+	want    bool
+	panic   bool
+}
+
+func TestPatchTargetsAllowedPaths(t *testing.T) {
+	tests := []patchPathsCase{
+		{name: "PatchExact", patch: `[{"op": "replace", "path": "/name", "value": "Maria"}]`,
+			allowed: []string{"/name"}, want: true},
+		{name: "PatchUnderAllowed", patch: `[{"op": "replace", "path": "/address/city", "value": "Recife"}]`,
+			allowed: []string{"/name", "/address"}, want: true},
+		{name: "PatchSiblingPrefix", patch: `[{"op": "replace", "path": "/names", "value": []}]`,
+			allowed: []string{"/name"}, want: false},
+		{name: "PatchParentOfAllowed", patch: `[{"op": "remove", "path": "/address"}]`,
+			allowed: []string{"/address/city"}, want: false},
+		{name: "PatchMoveFrom", patch: `[{"op": "move", "from": "/role", "path": "/name"}]`,
+			allowed: []string{"/name"}, want: false},
+		{name: "PatchCopyFrom", patch: `[{"op": "copy", "from": "/role", "path": "/name"}]`,
+			allowed: []string{"/name"}, want: false},
+		{name: "PatchCopyAllowed", patch: `[{"op": "copy", "from": "/address/city", "path": "/name"}]`,
+			allowed: []string{"/name", "/address"}, want: true},
+		{name: "PatchTest", patch: `[{"op": "test", "path": "/role", "value": "user"}]`,
+			allowed: []string{"/name"}, want: true},
+		{name: "PatchCaseVariantPath", patch: `[{"op":"replace","path":"/role","value":"admin","PATH":"/name"}]`,
+			allowed: []string{"/name"}, want: false},
+		{name: "PatchCaseVariantOp", patch: `[{"op": "replace", "path": "/role", "value": "admin", "Op": "test"}]`,
+			allowed: []string{"/name"}, want: false},
+		{name: "PatchRepeatedPath", patch: `[{"op": "replace", "path": "/role", "path": "/name", "value": "x"}]`,
+			allowed: []string{"/name"}, want: false},
+		{name: "PatchRoot", patch: `[{"op": "replace", "path": "", "value": {}}]`,
+			allowed: []string{"/name"}, want: false},
+		{name: "RootAllowed", patch: `[{"op": "remove", "path": "/anything"}]`,
+			allowed: []string{""}, want: true},
+		{name: "MergeExact", patch: `{"name": "Maria", "phone": null}`,
+			allowed: []string{"/name", "/phone"}, want: true},
+		{name: "MergeForbidden", patch: `{"name": "Maria", "role": "admin"}`,
+			allowed: []string{"/name"}, want: false},
+		{name: "MergeNested", patch: `{"address": {"city": "Recife"}}`,
+			allowed: []string{"/address/city"}, want: true},
+		{name: "MergeNestedForbidden", patch: `{"address": {"city": "Recife", "zip": "50000"}}`,
+			allowed: []string{"/address/city"}, want: false},
+		{name: "MergeEmptyObject", patch: `{"address": {}}`,
+			allowed: []string{"/address/city"}, want: false},
+		{name: "MergeEscapedKey", patch: `{"a/b": 1}`,
+			allowed: []string{"/a~1b"}, want: true},
+		{name: "MergeEmpty", patch: `{}`, want: true},
+		{name: "NoAllowed", patch: `{"name": "Maria"}`, want: false},
+		{name: "InvalidPatch", patch: `[{"op": "add", "path": "/name"}]`,
+			allowed: []string{"/name"}, want: false},
+		{name: "Scalar", patch: `"Maria"`, allowed: []string{"/name"}, want: false},
+		{name: "Nil", patch: nil, allowed: []string{"/name"}, panic: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := PatchTargetsAllowedPaths(tc.patch, tc.allowed...); got != tc.want {
+				t.Errorf("PatchTargetsAllowedPaths(%v, %v) = %v, want %v", tc.patch, tc.allowed, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// IsISOWeek checks if a given value is an ISO 8601 week identifier in the "YYYY-Www" format, such as "2024-W07".
+// The week must exist in the ISO week-numbering year, so week 53 is only accepted in the years that have it,
+// such as 2020.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an ISO week.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an existing ISO week.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsISOWeek("2024-W07")) // true
+//	fmt.Println(IsISOWeek("2020-W53")) // true
+//	fmt.Println(IsISOWeek("2024-W53")) // false
+//	fmt.Println(IsISOWeek("2024-W7"))  // false
+func IsISOWeek(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsISOWeek", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^\d{4}-W\d{2}$`)
+	s := toString(a)
+	if !regex.MatchString(s) {
+		return false
+	}
+	_, _, ok := parsePeriod(s, time.UTC)
+	return ok
+}
+
+// IsYearMonth checks if a given value is a month identifier in the "YYYY-MM" format, such as "2024-02".
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a year and month.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a year and month.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsYearMonth("2024-02")) // true
+//	fmt.Println(IsYearMonth("2024-13")) // false
+//	fmt.Println(IsYearMonth("2024-2"))  // false
+func IsYearMonth(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsYearMonth", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^\d{4}-(0[1-9]|1[0-2])$`)
+	return regex.MatchString(toString(a))
+}
+
+// IsQuarter checks if a given value is a quarter identifier in the "YYYY-Qn" format, such as "2024-Q1".
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a quarter.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a quarter.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsQuarter("2024-Q1")) // true
+//	fmt.Println(IsQuarter("2024-Q5")) // false
+//	fmt.Println(IsQuarter("2024Q1"))  // false
+func IsQuarter(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsQuarter", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^\d{4}-Q[1-4]$`)
+	return regex.MatchString(toString(a))
+}
+
+// PeriodContains checks whether the given time falls within the given period, which can be a year ("2024"), a
+// quarter ("2024-Q1"), a month ("2024-02") or an ISO week ("2024-W07"). The period starts at midnight of its
+// first day and ends at midnight of the day after its last one, both in the location of the time, so the same
+// instant is judged by the calendar of the zone it is expressed in.
+//
+// Parameters:
+//   - period: Any value to be converted into a string and used as the period.
+//   - t: Any value to be converted into a time.Time and checked against the period.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the time falls within the period.
+//
+// Panic:
+//   - The function will panic if the period is not in one of the supported formats, or if the time cannot be
+//     converted to a time.Time through the toTime function.
+//
+// Example:
+//
+//	fmt.Println(PeriodContains("2024-Q1", "2024-03-31"))  // true
+//	fmt.Println(PeriodContains("2024-Q1", "2024-04-01"))  // false
+//	fmt.Println(PeriodContains("2024-02", "2024-02-29"))  // true
+//	fmt.Println(PeriodContains("2025-W01", "2024-12-30")) // true
+func PeriodContains(period any, t any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("PeriodContains", time.Now(), &passed)
+	}
+	p := toString(period)
+	instant := toTime(t)
+	start, end, ok := parsePeriod(p, instant.Location())
+	if !ok {
+		panic(fmt.Sprintf("Invalid period: %s", p))
+	}
+	return !instant.Before(start) && instant.Before(end)
+}
+
+// parsePeriod returns the start, inclusive, and the end, exclusive, of the period in the given location, and
+// whether the period is a valid year, quarter, month or ISO week.
+func parsePeriod(period string, loc *time.Location) (time.Time, time.Time, bool) {
+	regex := regexp.MustCompile(`^(\d{4})(?:-(?:(0[1-9]|1[0-2])|Q([1-4])|W(\d{2})))?$`)
+	matches := regex.FindStringSubmatch(period)
+	if matches == nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	year, _ := strconv.Atoi(matches[1])
+	switch {
+	case matches[2] != "":
+		month, _ := strconv.Atoi(matches[2])
+		start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, 1, 0), true
+	case matches[3] != "":
+		quarter, _ := strconv.Atoi(matches[3])
+		start := time.Date(year, time.Month(3*quarter-2), 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, 3, 0), true
+	case matches[4] != "":
+		week, _ := strconv.Atoi(matches[4])
+		if _, weeks := time.Date(year, time.December, 28, 0, 0, 0, 0, loc).ISOWeek(); week < 1 || week > weeks {
+			return time.Time{}, time.Time{}, false
+		}
+		january4 := time.Date(year, time.January, 4, 0, 0, 0, 0, loc)
+		start := january4.AddDate(0, 0, -(int(january4.Weekday())+6)%7+(week-1)*7)
+		return start, start.AddDate(0, 0, 7), true
+	default:
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(1, 0, 0), true
+	}
+}
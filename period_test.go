@@ -0,0 +1,149 @@
+package checker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsISOWeek(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Week07", arg: "2024-W07", want: true},
+		{name: "FirstWeek", arg: "2024-W01", want: true},
+		{name: "Week52", arg: "2024-W52", want: true},
+		{name: "Week53", arg: "2020-W53", want: true},
+		{name: "Week53Missing", arg: "2024-W53", want: false},
+		{name: "Week00", arg: "2024-W00", want: false},
+		{name: "Week54", arg: "2026-W54", want: false},
+		{name: "SingleDigit", arg: "2024-W7", want: false},
+		{name: "Lowercase", arg: "2024-w07", want: false},
+		{name: "Compact", arg: "2024W07", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsISOWeek(tc.arg); got != tc.want {
+				t.Errorf("IsISOWeek(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsYearMonth(t *testing.T) {
+	testCases := []baseCase{
+		{name: "February", arg: "2024-02", want: true},
+		{name: "December", arg: "2024-12", want: true},
+		{name: "Month13", arg: "2024-13", want: false},
+		{name: "Month00", arg: "2024-00", want: false},
+		{name: "SingleDigit", arg: "2024-2", want: false},
+		{name: "WithDay", arg: "2024-02-01", want: false},
+		{name: "ShortYear", arg: "24-02", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsYearMonth(tc.arg); got != tc.want {
+				t.Errorf("IsYearMonth(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsQuarter(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Q1", arg: "2024-Q1", want: true},
+		{name: "Q4", arg: "2024-Q4", want: true},
+		{name: "Q0", arg: "2024-Q0", want: false},
+		{name: "Q5", arg: "2024-Q5", want: false},
+		{name: "Lowercase", arg: "2024-q1", want: false},
+		{name: "Compact", arg: "2024Q1", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsQuarter(tc.arg); got != tc.want {
+				t.Errorf("IsQuarter(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPeriodContains(t *testing.T) {
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+	testCases := []struct {
+		name   string
+		period any
+		t      any
+		want   bool
+		panic  bool
+	}{
+		{name: "YearStart", period: "2024", t: "2024-01-01", want: true},
+		{name: "YearEnd", period: "2024", t: "2024-12-31 23:59:59", want: true},
+		{name: "NextYear", period: "2024", t: "2025-01-01", want: false},
+		{name: "QuarterEnd", period: "2024-Q1", t: "2024-03-31", want: true},
+		{name: "NextQuarter", period: "2024-Q1", t: "2024-04-01", want: false},
+		{name: "FourthQuarter", period: "2024-Q4", t: "2024-11-15", want: true},
+		{name: "LeapDay", period: "2024-02", t: "2024-02-29", want: true},
+		{name: "NextMonth", period: "2024-02", t: "2024-03-01", want: false},
+		{name: "PreviousMonth", period: "2024-02", t: "2024-01-31 23:59:59", want: false},
+		{name: "WeekMonday", period: "2024-W07", t: "2024-02-12", want: true},
+		{name: "WeekSunday", period: "2024-W07", t: "2024-02-18 23:59:59", want: true},
+		{name: "WeekNextMonday", period: "2024-W07", t: "2024-02-19", want: false},
+		{name: "WeekInPreviousYear", period: "2025-W01", t: "2024-12-30", want: true},
+		{name: "FirstDaysInPreviousWeekYear", period: "2021-W01", t: "2021-01-03", want: false},
+		{name: "Week53", period: "2020-W53", t: "2021-01-03", want: true},
+		{name: "LocalCalendar", period: "2024-01", t: time.Date(2024, time.January, 31, 22, 0, 0, 0, saoPaulo),
+			want: true},
+		{name: "UTCCalendar", period: "2024-01", t: time.Date(2024, time.February, 1, 1, 0, 0, 0, time.UTC),
+			want: false},
+		{name: "InvalidPeriod", period: "2024-13", t: "2024-01-01", panic: true},
+		{name: "MissingWeek53", period: "2024-W53", t: "2024-12-30", panic: true},
+		{name: "InvalidTime", period: "2024", t: "not a time", panic: true},
+		{name: "NilPeriod", period: nil, t: "2024-01-01", panic: true},
+		{name: "NilTime", period: "2024", t: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := PeriodContains(tc.period, tc.t); got != tc.want {
+				t.Errorf("PeriodContains(%v, %v) = %v, want %v", tc.period, tc.t, got, tc.want)
+			}
+		})
+	}
+}
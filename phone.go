@@ -0,0 +1,200 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// brazilDDDs lists the area codes (DDD) in use in Brazil, as assigned by Anatel.
+var brazilDDDs = []string{
+	"11", "12", "13", "14", "15", "16", "17", "18", "19", "21", "22", "24", "27", "28", "31", "32", "33", "34", "35",
+	"37", "38", "41", "42", "43", "44", "45", "46", "47", "48", "49", "51", "53", "54", "55", "61", "62", "63", "64",
+	"65", "66", "67", "68", "69", "71", "73", "74", "75", "77", "79", "81", "82", "83", "84", "85", "86", "87", "88",
+	"89", "91", "92", "93", "94", "95", "96", "97", "98", "99",
+}
+
+// IsValidDDD checks if a given value is a Brazilian area code (DDD) in use, such as "11" or "(21)", according to
+// the Anatel numbering plan.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a DDD.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a DDD in use.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsValidDDD("11"))   // true
+//	fmt.Println(IsValidDDD("(21)")) // true
+//	fmt.Println(IsValidDDD(20))     // false
+//	fmt.Println(IsValidDDD("1"))    // false
+func IsValidDDD(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsValidDDD", time.Now(), &passed)
+	}
+	s := strings.TrimSpace(toString(a))
+	regex := regexp.MustCompile(`^(\d{2}|\(\d{2}\))$`)
+	return regex.MatchString(s) && slices.Contains(brazilDDDs, strings.Trim(s, "()"))
+}
+
+// IsBrazilMobile checks if a given value is a Brazilian mobile number, that is, a DDD in use (see IsValidDDD)
+// followed by 9 digits starting with 9, such as "(11) 98765-4321". The number may have the "+55" country code or
+// the "0" trunk prefix, and formatting characters such as spaces, dashes, dots and parentheses are ignored.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a Brazilian mobile number.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a Brazilian mobile number.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsBrazilMobile("(11) 98765-4321"))   // true
+//	fmt.Println(IsBrazilMobile("+55 21 99876-5432")) // true
+//	fmt.Println(IsBrazilMobile("(11) 3456-7890"))    // false
+//	fmt.Println(IsBrazilMobile("(20) 98765-4321"))   // false
+func IsBrazilMobile(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBrazilMobile", time.Now(), &passed)
+	}
+	return isBrazilMobile(a)
+}
+
+// isBrazilMobile is the unobserved implementation of IsBrazilMobile.
+func isBrazilMobile(a any) bool {
+	number, ok := brazilNationalNumber(toString(a))
+	return ok && len(number) == 11 && number[2] == '9'
+}
+
+// IsBrazilLandline checks if a given value is a Brazilian landline number, that is, a DDD in use (see
+// IsValidDDD) followed by 8 digits starting with 2 to 5, such as "(11) 3456-7890". The number may have the "+55"
+// country code or the "0" trunk prefix, and formatting characters such as spaces, dashes, dots and parentheses
+// are ignored.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a Brazilian landline number.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a Brazilian landline number.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsBrazilLandline("(11) 3456-7890"))   // true
+//	fmt.Println(IsBrazilLandline("+55 48 2101-0000")) // true
+//	fmt.Println(IsBrazilLandline("(11) 98765-4321"))  // false
+//	fmt.Println(IsBrazilLandline("(11) 8456-7890"))   // false
+func IsBrazilLandline(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBrazilLandline", time.Now(), &passed)
+	}
+	return isBrazilLandline(a)
+}
+
+// isBrazilLandline is the unobserved implementation of IsBrazilLandline.
+func isBrazilLandline(a any) bool {
+	number, ok := brazilNationalNumber(toString(a))
+	return ok && len(number) == 10 && number[2] >= '2' && number[2] <= '5'
+}
+
+// IsWhatsAppNumber checks if a given value is in the format WhatsApp expects for click-to-chat links and its
+// APIs, that is, the full international number, with the country code and no leading zeros, made only of digits
+// and optionally prefixed by "+", such as "5511987654321". Brazilian numbers must also be valid mobile or
+// landline numbers (see IsBrazilMobile and IsBrazilLandline), since business accounts may use landlines.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a WhatsApp number.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a WhatsApp number.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsWhatsAppNumber("5511987654321"))   // true
+//	fmt.Println(IsWhatsAppNumber("+14155552671"))    // true
+//	fmt.Println(IsWhatsAppNumber("(11) 98765-4321")) // false
+//	fmt.Println(IsWhatsAppNumber("5520987654321"))   // false
+func IsWhatsAppNumber(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsWhatsAppNumber", time.Now(), &passed)
+	}
+	s := strings.TrimPrefix(toString(a), "+")
+	regex := regexp.MustCompile(`^[1-9]\d{7,14}$`)
+	if !regex.MatchString(s) {
+		return false
+	} else if strings.HasPrefix(s, "55") {
+		return isBrazilMobile("+"+s) || isBrazilLandline("+"+s)
+	}
+	return true
+}
+
+// brazilNationalNumber removes the formatting, the "+55" country code and the "0" trunk prefix of a Brazilian
+// phone number, returning its 10 or 11 national digits when they start with a DDD in use.
+func brazilNationalNumber(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if strings.LastIndex(s, "+") > 0 || strings.ContainsFunc(s, func(r rune) bool {
+		return !strings.ContainsRune("0123456789+-.() ", r)
+	}) {
+		return "", false
+	}
+
+	digits := removeNonDigits(s)
+	if strings.HasPrefix(s, "+") {
+		if !strings.HasPrefix(digits, "55") {
+			return "", false
+		}
+		digits = digits[2:]
+	} else if (len(digits) == 12 || len(digits) == 13) && strings.HasPrefix(digits, "55") {
+		digits = digits[2:]
+	} else if (len(digits) == 11 || len(digits) == 12) && digits[0] == '0' {
+		digits = digits[1:]
+	}
+
+	if (len(digits) != 10 && len(digits) != 11) || !slices.Contains(brazilDDDs, digits[:2]) {
+		return "", false
+	}
+	return digits, true
+}
@@ -0,0 +1,127 @@
+package checker
+
+import "testing"
+
+func TestIsValidDDD(t *testing.T) {
+	testCases := []baseCase{
+		{name: "SaoPaulo", arg: "11", want: true},
+		{name: "Parentheses", arg: "(21)", want: true},
+		{name: "Int", arg: 99, want: true},
+		{name: "Unassigned", arg: 20, want: false},
+		{name: "Unassigned23", arg: "23", want: false},
+		{name: "OneDigit", arg: "1", want: false},
+		{name: "ThreeDigits", arg: "011", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsValidDDD(tc.arg); got != tc.want {
+				t.Errorf("IsValidDDD(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBrazilMobile(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Formatted", arg: "(11) 98765-4321", want: true},
+		{name: "CountryCode", arg: "+55 21 99876-5432", want: true},
+		{name: "CountryCodeWithoutPlus", arg: "5511987654321", want: true},
+		{name: "TrunkPrefix", arg: "011 98765-4321", want: true},
+		{name: "DigitsOnly", arg: "11987654321", want: true},
+		{name: "DDD55", arg: "55987654321", want: true},
+		{name: "Landline", arg: "(11) 3456-7890", want: false},
+		{name: "InvalidDDD", arg: "(20) 98765-4321", want: false},
+		{name: "EightDigits", arg: "(11) 9876-5432", want: false},
+		{name: "OtherCountry", arg: "+1 415 555 2671", want: false},
+		{name: "Letters", arg: "(11) 9876A-4321", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsBrazilMobile(tc.arg); got != tc.want {
+				t.Errorf("IsBrazilMobile(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBrazilLandline(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Formatted", arg: "(11) 3456-7890", want: true},
+		{name: "CountryCode", arg: "+55 48 2101-0000", want: true},
+		{name: "DigitsOnly", arg: "1134567890", want: true},
+		{name: "Mobile", arg: "(11) 98765-4321", want: false},
+		{name: "StartsWithEight", arg: "(11) 8456-7890", want: false},
+		{name: "InvalidDDD", arg: "(10) 3456-7890", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsBrazilLandline(tc.arg); got != tc.want {
+				t.Errorf("IsBrazilLandline(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsWhatsAppNumber(t *testing.T) {
+	testCases := []baseCase{
+		{name: "BrazilMobile", arg: "5511987654321", want: true},
+		{name: "BrazilLandline", arg: "551134567890", want: true},
+		{name: "Plus", arg: "+14155552671", want: true},
+		{name: "Formatted", arg: "(11) 98765-4321", want: false},
+		{name: "InvalidBrazilDDD", arg: "5520987654321", want: false},
+		{name: "LeadingZero", arg: "05511987654321", want: false},
+		{name: "TooShort", arg: "1234567", want: false},
+		{name: "TooLong", arg: "1234567890123456", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsWhatsAppNumber(tc.arg); got != tc.want {
+				t.Errorf("IsWhatsAppNumber(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,349 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Policy is a set of checks declared outside the code, usually in a configuration file, that maps field paths of
+// a payload to the names of the Rules they must pass. A Policy is created by LoadPolicy and is safe for
+// concurrent use by multiple goroutines.
+type Policy struct {
+	fields []policyField
+}
+
+// Report is the result of evaluating a Policy against a payload.
+type Report struct {
+	// Errors holds one failure for each Rule a field did not pass, in the order they are declared in the Policy.
+	Errors Errors
+}
+
+// policyField holds the Rules declared for a single field path of a Policy.
+type policyField struct {
+	path     string
+	optional bool
+	rules    []policyRule
+}
+
+// policyRule is a Rule of a Policy kept together with its declaration, such as "max=10000", which is used as the
+// failure message.
+type policyRule struct {
+	name string
+	rule Rule
+}
+
+// LoadPolicy reads a Policy from the given reader, so that the validation of a payload can be changed by editing
+// a configuration file instead of deploying new code. Two formats are accepted:
+//
+//   - A JSON object mapping each field path to the list of its Rules, such as {"amount": ["min=0", "max=10000"]}.
+//   - A YAML-like text with one field per line, such as "amount: [min=0, max=10000]", where a single Rule may be
+//     written without brackets and lines starting with '#' are comments.
+//
+// Field paths are dot-separated, such as "customer.email" or "items.0.sku", and walk through maps, struct
+// fields (matched by their json tag or name) and slice indexes. Rules are referenced by the names known by
+// RuleByName, such as "is_email", and resolved when the Policy is loaded, so Rules must be registered before it.
+// The "is_positive" Rule and the parameterized Rules "min", "max" (numeric bounds), "min_length", "max_length"
+// and "length" are also available, and the "optional" keyword skips the other Rules of a field when it is missing
+// or nil.
+//
+// Parameters:
+//   - r: The reader the Policy is read from.
+//
+// Returns:
+//   - *Policy: The loaded Policy, or nil if it could not be loaded.
+//   - error: An error if the Policy could not be read, is malformed, or references an unknown Rule or an invalid
+//     parameter.
+//
+// Example:
+//
+//	policy, err := LoadPolicy(strings.NewReader(`
+//	email: [is_email]
+//	amount: [is_positive, max=10000]
+//	`))
+//	fmt.Println(err) // <nil>
+//	report := policy.Evaluate(map[string]any{"email": "test@example.com", "amount": 20000})
+//	fmt.Println(report.Errors.Error()) // amount: failed max=10000
+func LoadPolicy(r io.Reader) (*Policy, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading policy: %w", err)
+	}
+
+	var declarations []policyDeclaration
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && trimmed[0] == '{' {
+		declarations, err = parseJSONPolicy(trimmed)
+	} else {
+		declarations, err = parseTextPolicy(b)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &Policy{}
+	seen := make(map[string]bool, len(declarations))
+	for _, declaration := range declarations {
+		if seen[declaration.path] {
+			return nil, fmt.Errorf("invalid policy: duplicate field %q", declaration.path)
+		}
+		seen[declaration.path] = true
+
+		field, err := newPolicyField(declaration)
+		if err != nil {
+			return nil, err
+		}
+		policy.fields = append(policy.fields, field)
+	}
+	return policy, nil
+}
+
+// Evaluate runs the Rules of the Policy against the fields of the given payload and reports every failure. The
+// payload can be a map, a struct, a pointer to them, or a JSON document as a string or []byte. A missing field
+// is evaluated as nil, unless the field is declared "optional", and a Rule that panics is reported as a failure.
+//
+// Parameters:
+//   - payload: The value to be evaluated.
+//
+// Returns:
+//   - Report: The failures of the payload, empty if it passes every Rule.
+//
+// Example:
+//
+//	policy, _ := LoadPolicy(strings.NewReader(`{"customer.email": ["is_email"]}`))
+//	report := policy.Evaluate(`{"customer": {"email": "invalid"}}`)
+//	fmt.Println(report.Passed(), report.Errors.Error()) // false customer.email: failed is_email
+func (p *Policy) Evaluate(payload any) Report {
+	switch document := payload.(type) {
+	case string:
+		payload = decodePolicyPayload([]byte(document))
+	case []byte:
+		payload = decodePolicyPayload(document)
+	case json.RawMessage:
+		payload = decodePolicyPayload(document)
+	}
+
+	var report Report
+	for _, field := range p.fields {
+		value, found := policyFieldValue(payload, field.path)
+		if field.optional && (!found || isNilCollection(value)) {
+			continue
+		}
+		for _, rule := range field.rules {
+			report.Errors.AddIf(field.path, rule.rule, value, "failed "+rule.name)
+		}
+	}
+	return report
+}
+
+// Passed returns whether the payload passed every Rule of the Policy.
+//
+// Returns:
+//   - bool: A boolean value indicating whether no failure was reported.
+//
+// Example:
+//
+//	policy, _ := LoadPolicy(strings.NewReader("email: is_email"))
+//	fmt.Println(policy.Evaluate(map[string]any{"email": "test@example.com"}).Passed()) // true
+func (r Report) Passed() bool {
+	return !r.Errors.HasAny()
+}
+
+// policyDeclaration is a field path of a Policy and the Rules declared for it, as written in the source.
+type policyDeclaration struct {
+	path  string
+	rules []string
+}
+
+// parseJSONPolicy parses a Policy written as a JSON object, keeping the fields in the order they are declared.
+// Each value can be a list of Rule names or a single Rule name.
+func parseJSONPolicy(b []byte) ([]policyDeclaration, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, fmt.Errorf("invalid policy: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	if _, err := decoder.Token(); err != nil {
+		return nil, fmt.Errorf("invalid policy: %w", err)
+	}
+
+	var declarations []policyDeclaration
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("invalid policy: %w", err)
+		}
+		path := token.(string)
+
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("invalid policy: %w", err)
+		}
+
+		var rules []string
+		if err := json.Unmarshal(raw, &rules); err != nil {
+			var rule string
+			if json.Unmarshal(raw, &rule) != nil {
+				return nil, fmt.Errorf("invalid policy: rules of field %q must be a string or a list of strings", path)
+			}
+			rules = []string{rule}
+		}
+		declarations = append(declarations, policyDeclaration{path: path, rules: rules})
+	}
+	return declarations, nil
+}
+
+// parseTextPolicy parses a Policy written with one "path: [rule, rule=param]" pair per line, skipping blank lines
+// and comments.
+func parseTextPolicy(b []byte) ([]policyDeclaration, error) {
+	var declarations []policyDeclaration
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		path, value, ok := strings.Cut(text, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid policy line %d: expected \"field: [rules]\"", line)
+		}
+		value = strings.TrimSpace(value)
+		if strings.HasPrefix(value, "[") {
+			if !strings.HasSuffix(value, "]") {
+				return nil, fmt.Errorf("invalid policy line %d: unclosed rule list", line)
+			}
+			value = value[1 : len(value)-1]
+		}
+
+		var rules []string
+		if strings.TrimSpace(value) != "" {
+			for _, rule := range strings.Split(value, ",") {
+				rules = append(rules, strings.Trim(strings.TrimSpace(rule), `"'`))
+			}
+		}
+		declarations = append(declarations, policyDeclaration{path: strings.TrimSpace(path), rules: rules})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading policy: %w", err)
+	}
+	return declarations, nil
+}
+
+// newPolicyField resolves the Rules of a declaration, reporting empty paths, unknown Rules and invalid parameters.
+func newPolicyField(declaration policyDeclaration) (policyField, error) {
+	field := policyField{path: declaration.path}
+	if field.path == "" || strings.Contains(field.path, "..") || strings.HasPrefix(field.path, ".") ||
+		strings.HasSuffix(field.path, ".") {
+		return field, fmt.Errorf("invalid policy: invalid field path %q", field.path)
+	}
+
+	for _, declared := range declaration.rules {
+		if declared == "optional" {
+			field.optional = true
+			continue
+		}
+
+		descriptor, err := ParseDescriptor(declared)
+		if err != nil {
+			return field, fmt.Errorf("invalid policy: %w for field %q", err, field.path)
+		}
+		rule, err := descriptor.Rule()
+		if err != nil {
+			return field, fmt.Errorf("invalid policy: %w for field %q", err, field.path)
+		}
+		field.rules = append(field.rules, policyRule{name: declared, rule: rule})
+	}
+	return field, nil
+}
+
+// decodePolicyPayload decodes a JSON payload, returning nil if it is not valid JSON so that every field is
+// evaluated as missing.
+func decodePolicyPayload(b []byte) any {
+	var payload any
+	if json.Unmarshal(b, &payload) != nil {
+		return nil
+	}
+	return payload
+}
+
+// policyFieldValue walks the dot-separated path through the maps, structs and slices of the payload, returning
+// the value found and whether every segment of the path exists.
+func policyFieldValue(payload any, path string) (any, bool) {
+	value := reflect.ValueOf(payload)
+	for _, segment := range strings.Split(path, ".") {
+		for value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				return nil, false
+			}
+			value = value.Elem()
+		}
+
+		switch value.Kind() {
+		case reflect.Map:
+			if value.Type().Key().Kind() != reflect.String {
+				return nil, false
+			}
+			value = value.MapIndex(reflect.ValueOf(segment).Convert(value.Type().Key()))
+		case reflect.Struct:
+			value = policyStructField(value, segment)
+		case reflect.Slice, reflect.Array:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= value.Len() {
+				return nil, false
+			}
+			value = value.Index(index)
+		default:
+			return nil, false
+		}
+		if !value.IsValid() {
+			return nil, false
+		}
+	}
+	return value.Interface(), true
+}
+
+// policyStructField returns the exported field of the struct whose json tag name, or otherwise Go name, equals
+// the given name, or an invalid value if there is none.
+func policyStructField(value reflect.Value, name string) reflect.Value {
+	structType := value.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		tag, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+		if tag == name || (tag == "" && structField.Name == name) {
+			return value.Field(i)
+		}
+	}
+	return reflect.Value{}
+}
@@ -0,0 +1,245 @@
+package checker
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type policyCustomer struct {
+	Email    string `json:"email"`
+	Document string
+	Phones   []string `json:"phones,omitempty"`
+	secret   string
+}
+
+type policyOrder struct {
+	Amount   float64         `json:"amount"`
+	Customer *policyCustomer `json:"customer"`
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestLoadPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr bool
+	}{
+		{name: "Text", policy: "email: [is_email]\namount: [min=0, max=10000]"},
+		{name: "TextIsPositive", policy: "amount: [is_positive, max=10000]"},
+		{name: "TextSingleRule", policy: "email: is_email"},
+		{name: "TextQuotedRules", policy: `email: ["is_email", 'is_not_empty']`},
+		{name: "TextComments", policy: "# customer rules\n\nemail: [is_email]\n"},
+		{name: "TextEmptyList", policy: "email: []"},
+		{name: "TextOptional", policy: "email: [optional, is_email]"},
+		{name: "TextLengths", policy: "name: [min_length=2, max_length=60, length=10]"},
+		{name: "JSON", policy: `{"amount": ["min=0", "max=10000"], "email": "is_email"}`},
+		{name: "Empty", policy: ""},
+		{name: "EmptyJSON", policy: "{}"},
+		{name: "TextMissingColon", policy: "email is_email", wantErr: true},
+		{name: "TextUnclosedList", policy: "email: [is_email", wantErr: true},
+		{name: "TextEmptyRule", policy: "email: [is_email,]", wantErr: true},
+		{name: "UnknownRule", policy: "email: [is_unknown]", wantErr: true},
+		{name: "UnknownParameterizedRule", policy: "email: [is_email=1]", wantErr: true},
+		{name: "InvalidNumericParameter", policy: "amount: [max=ten]", wantErr: true},
+		{name: "NegativeLength", policy: "name: [max_length=-1]", wantErr: true},
+		{name: "EmptyPath", policy: ": [is_email]", wantErr: true},
+		{name: "InvalidPath", policy: "customer..email: [is_email]", wantErr: true},
+		{name: "DuplicateField", policy: "email: [is_email]\nemail: [is_not_empty]", wantErr: true},
+		{name: "InvalidJSON", policy: `{"email": ["is_email"]`, wantErr: true},
+		{name: "JSONInvalidRules", policy: `{"email": 1}`, wantErr: true},
+		{name: "JSONUnknownRule", policy: `{"email": ["is_unknown"]}`, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := LoadPolicy(strings.NewReader(tc.policy))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("LoadPolicy() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if (policy == nil) != tc.wantErr {
+				t.Errorf("LoadPolicy() policy = %v, wantErr %v", policy, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadPolicyReadError(t *testing.T) {
+	if _, err := LoadPolicy(failingReader{}); err == nil {
+		t.Errorf("LoadPolicy() did not return the read error")
+	}
+}
+
+func TestPolicyEvaluate(t *testing.T) {
+	order := policyOrder{
+		Amount:   150.5,
+		Customer: &policyCustomer{Email: "test@example.com", Document: "12101721007", Phones: []string{"11987654321"}},
+	}
+	tests := []struct {
+		name    string
+		policy  string
+		payload any
+		want    string
+	}{
+		{
+			name:    "MapPassed",
+			policy:  "email: [is_email]\namount: [min=0, max=10000]",
+			payload: map[string]any{"email": "test@example.com", "amount": 100},
+		},
+		{
+			name:    "MapFailed",
+			policy:  "email: [is_email]\namount: [min=0, max=10000]",
+			payload: map[string]any{"email": "invalid", "amount": 20000},
+			want:    "email: failed is_email; amount: failed max=10000",
+		},
+		{
+			name:    "IsPositivePassed",
+			policy:  "amount: [is_positive, max=10000]",
+			payload: map[string]any{"amount": "150.50"},
+		},
+		{
+			name:    "IsPositiveFailed",
+			policy:  "amount: [is_positive, max=10000]",
+			payload: `{"amount": -5}`,
+			want:    "amount: failed is_positive",
+		},
+		{
+			name:    "IsPositiveNotNumber",
+			policy:  "amount: [is_positive]",
+			payload: map[string]any{"amount": "abc"},
+			want:    "amount: failed is_positive",
+		},
+		{
+			name:    "OptionalArray",
+			policy:  "codes: [optional, min_length=2]",
+			payload: map[string]any{"codes": [2]int{1, 2}},
+		},
+		{
+			name:    "NestedMap",
+			policy:  "customer.email: is_email",
+			payload: map[string]any{"customer": map[string]any{"email": "invalid"}},
+			want:    "customer.email: failed is_email",
+		},
+		{
+			name: "StructPassed",
+			policy: "amount: [max=10000]\ncustomer.email: [is_email]\ncustomer.Document: [is_cpf]\n" +
+				"customer.phones.0: [is_numeric]",
+			payload: order,
+		},
+		{
+			name:    "StructPointer",
+			policy:  "customer.email: [is_email]",
+			payload: &order,
+		},
+		{
+			name:    "StructFieldNameHiddenByTag",
+			policy:  "customer.Email: [is_email]",
+			payload: order,
+			want:    "customer.Email: failed is_email",
+		},
+		{
+			name:    "StructUnexportedField",
+			policy:  "customer.secret: [is_not_empty]",
+			payload: order,
+			want:    "customer.secret: failed is_not_empty",
+		},
+		{
+			name:    "SliceIndexOutOfRange",
+			policy:  "customer.phones.1: [is_numeric]",
+			payload: order,
+			want:    "customer.phones.1: failed is_numeric",
+		},
+		{
+			name:    "JSONString",
+			policy:  `{"customer.email": ["is_email"], "items.0.quantity": ["min=1"]}`,
+			payload: `{"customer": {"email": "test@example.com"}, "items": [{"quantity": 0}]}`,
+			want:    "items.0.quantity: failed min=1",
+		},
+		{
+			name:    "JSONBytes",
+			policy:  "name: [min_length=2, max_length=5]",
+			payload: []byte(`{"name": "Gabriel"}`),
+			want:    "name: failed max_length=5",
+		},
+		{
+			name:    "InvalidJSON",
+			policy:  "email: [is_email]",
+			payload: `{"email":`,
+			want:    "email: failed is_email",
+		},
+		{
+			name:    "MissingField",
+			policy:  "email: [is_email]",
+			payload: map[string]any{},
+			want:    "email: failed is_email",
+		},
+		{
+			name:    "MissingFieldIsNil",
+			policy:  "email: [is_nil]",
+			payload: map[string]any{},
+		},
+		{
+			name:    "OptionalMissing",
+			policy:  "email: [optional, is_email]",
+			payload: map[string]any{"email": nil},
+		},
+		{
+			name:    "OptionalPresent",
+			policy:  "email: [optional, is_email]",
+			payload: map[string]any{"email": "invalid"},
+			want:    "email: failed is_email",
+		},
+		{
+			name:    "NilPayload",
+			policy:  "email: [is_email]",
+			payload: nil,
+			want:    "email: failed is_email",
+		},
+		{
+			name:    "ScalarPayload",
+			policy:  "email: [is_email]",
+			payload: 10,
+			want:    "email: failed is_email",
+		},
+		{
+			name:    "NonStringMapKeys",
+			policy:  "1: [is_not_empty]",
+			payload: map[int]string{1: "one"},
+			want:    "1: failed is_not_empty",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := LoadPolicy(strings.NewReader(tc.policy))
+			if err != nil {
+				t.Fatalf("LoadPolicy() error = %v", err)
+			}
+			report := policy.Evaluate(tc.payload)
+			if got := report.Errors.Error(); got != tc.want {
+				t.Errorf("Evaluate() = %q, want %q", got, tc.want)
+			}
+			if report.Passed() != (tc.want == "") {
+				t.Errorf("Passed() = %v, want %v", report.Passed(), tc.want == "")
+			}
+		})
+	}
+}
+
+func TestPolicyEvaluateRegisteredRule(t *testing.T) {
+	RegisterRule("is_policy_test_code", func(a any) bool { return strings.HasPrefix(toString(a), "PT-") })
+
+	policy, err := LoadPolicy(strings.NewReader("code: [is_policy_test_code]"))
+	if err != nil {
+		t.Fatalf("LoadPolicy() error = %v", err)
+	}
+	if !policy.Evaluate(map[string]any{"code": "PT-1"}).Passed() {
+		t.Errorf("Evaluate() did not pass the registered rule")
+	}
+	if policy.Evaluate(map[string]any{"code": "XX-1"}).Passed() {
+		t.Errorf("Evaluate() passed an invalid value for the registered rule")
+	}
+}
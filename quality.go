@@ -0,0 +1,176 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"reflect"
+	"time"
+)
+
+// PercentSatisfying returns the percentage, from 0 to 100, of the elements of a given collection that pass the
+// Rule, such as the share of imported rows with a valid CPF. The elements of slices and arrays and the values of
+// maps are checked, and pointers to collections are followed. An empty collection returns 0.
+//
+// Parameters:
+//   - a: A slice, array or map (or a pointer to one of them) with the elements to check.
+//   - rule: The Rule run against each element.
+//
+// Returns:
+//   - float64: The percentage of elements that pass the Rule.
+//
+// Panic:
+//   - If 'a' is nil, it panics with ErrNilValue.
+//   - If 'a' is not a slice, array or map, it panics with an ErrUnsupportedType indicating the unsupported type.
+//   - If the Rule panics on an element, the panic is propagated; wrap it with Guard to count it as a failure.
+//
+// Example:
+//
+//	rows := []string{"529.982.247-25", "111.111.111-11", "121.017.210-07", "invalid"}
+//	fmt.Println(PercentSatisfying(rows, IsCPF))       // 50
+//	fmt.Println(PercentSatisfying([]string{}, IsCPF)) // 0
+func PercentSatisfying(a any, rule Rule) float64 {
+	values := collectionValues(a)
+	if len(values) == 0 {
+		return 0
+	}
+
+	passed := 0
+	for _, value := range values {
+		if rule(value) {
+			passed++
+		}
+	}
+	return float64(passed) * 100 / float64(len(values))
+}
+
+// AtLeastNSatisfy checks if at least n elements of a given collection (see PercentSatisfying) pass the Rule. The
+// check stops as soon as n elements pass, so a non-positive n always passes without running the Rule.
+//
+// Parameters:
+//   - a: A slice, array or map (or a pointer to one of them) with the elements to check.
+//   - n: The minimum number of elements that must pass the Rule.
+//   - rule: The Rule run against each element.
+//
+// Returns:
+//   - bool: A boolean value indicating whether at least n elements pass the Rule.
+//
+// Panic:
+//   - If 'a' is nil, it panics with ErrNilValue.
+//   - If 'a' is not a slice, array or map, it panics with an ErrUnsupportedType indicating the unsupported type.
+//   - If the Rule panics on an element, the panic is propagated; wrap it with Guard to count it as a failure.
+//
+// Example:
+//
+//	emails := []string{"ana@example.com", "bob@example.com", "not an email"}
+//	fmt.Println(AtLeastNSatisfy(emails, 2, IsEmail)) // true
+//	fmt.Println(AtLeastNSatisfy(emails, 3, IsEmail)) // false
+func AtLeastNSatisfy(a any, n int, rule Rule) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("AtLeastNSatisfy", time.Now(), &passed)
+	}
+	values := collectionValues(a)
+
+	count := 0
+	for _, value := range values {
+		if count >= n {
+			break
+		} else if rule(value) {
+			count++
+		}
+	}
+	return count >= n
+}
+
+// AtMostNSatisfy checks if at most n elements of a given collection (see PercentSatisfying) pass the Rule, such
+// as a cap on rows flagged as duplicates. The check stops as soon as more than n elements pass.
+//
+// Parameters:
+//   - a: A slice, array or map (or a pointer to one of them) with the elements to check.
+//   - n: The maximum number of elements that may pass the Rule.
+//   - rule: The Rule run against each element.
+//
+// Returns:
+//   - bool: A boolean value indicating whether at most n elements pass the Rule.
+//
+// Panic:
+//   - If 'a' is nil, it panics with ErrNilValue.
+//   - If 'a' is not a slice, array or map, it panics with an ErrUnsupportedType indicating the unsupported type.
+//   - If the Rule panics on an element, the panic is propagated; wrap it with Guard to count it as a failure.
+//
+// Example:
+//
+//	emails := []string{"ana@example.com", "bob@example.com", "not an email"}
+//	fmt.Println(AtMostNSatisfy(emails, 1, IsNotEmail)) // true
+//	fmt.Println(AtMostNSatisfy(emails, 1, IsEmail))    // false
+func AtMostNSatisfy(a any, n int, rule Rule) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("AtMostNSatisfy", time.Now(), &passed)
+	}
+	values := collectionValues(a)
+
+	count := 0
+	for _, value := range values {
+		if rule(value) {
+			count++
+			if count > n {
+				return false
+			}
+		}
+	}
+	return count <= n
+}
+
+// isNilCollection checks whether a collection is nil as isNil does, reporting false for arrays, which can not be
+// nil, instead of panicking.
+func isNilCollection(a any) bool {
+	return reflect.ValueOf(a).Kind() != reflect.Array && isNil(a)
+}
+
+// collectionValues returns the elements of a slice or array, or the values of a map, following pointers and
+// interfaces, and panics on nil or any other kind of value.
+func collectionValues(a any) []any {
+	if isNilCollection(a) {
+		panic(ErrNilValue)
+	}
+
+	reflectValue := reflect.ValueOf(a)
+	if reflectValue.Kind() == reflect.Ptr || reflectValue.Kind() == reflect.Interface {
+		return collectionValues(reflectValue.Elem().Interface())
+	}
+
+	var values []any
+	switch reflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < reflectValue.Len(); i++ {
+			values = append(values, reflectValue.Index(i).Interface())
+		}
+	case reflect.Map:
+		iter := reflectValue.MapRange()
+		for iter.Next() {
+			values = append(values, iter.Value().Interface())
+		}
+	default:
+		panic(ErrUnsupportedType{Kind: reflectValue.Kind()})
+	}
+	return values
+}
@@ -0,0 +1,100 @@
+package checker
+
+import "testing"
+
+type collectionCase struct {
+	name  string
+	arg   any
+	n     int
+	rule  Rule
+	want  bool
+	panic bool
+}
+
+func runCollectionCases(t *testing.T, name string, fn func(a any, n int, rule Rule) bool, testCases []collectionCase) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := fn(tc.arg, tc.n, tc.rule); got != tc.want {
+				t.Errorf("%s(%v, %v) = %v, want %v", name, tc.arg, tc.n, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPercentSatisfying(t *testing.T) {
+	rows := []string{"529.982.247-25", "111.111.111-11", "121.017.210-07", "invalid"}
+	rowsArray := [2]string{"529.982.247-25", "invalid"}
+	testCases := []struct {
+		name  string
+		arg   any
+		rule  Rule
+		want  float64
+		panic bool
+	}{
+		{name: "Slice", arg: rows, rule: IsCPF, want: 50},
+		{name: "Pointer", arg: &rows, rule: IsCPF, want: 50},
+		{name: "Array", arg: rowsArray, rule: IsCPF, want: 50},
+		{name: "Map", arg: map[string]string{"a": "ana@example.com", "b": "bob"}, rule: IsEmail, want: 50},
+		{name: "AllPass", arg: []string{"ana@example.com"}, rule: IsEmail, want: 100},
+		{name: "Empty", arg: []string{}, rule: IsCPF, want: 0},
+		{name: "Unsupported", arg: "529.982.247-25", rule: IsCPF, panic: true},
+		{name: "Nil", arg: nil, rule: IsCPF, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := PercentSatisfying(tc.arg, tc.rule); got != tc.want {
+				t.Errorf("PercentSatisfying(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAtLeastNSatisfy(t *testing.T) {
+	emails := []string{"ana@example.com", "bob@example.com", "not an email"}
+	calls := 0
+	counting := Rule(func(a any) bool {
+		calls++
+		return IsEmail(a)
+	})
+
+	runCollectionCases(t, "AtLeastNSatisfy", AtLeastNSatisfy, []collectionCase{
+		{name: "Enough", arg: emails, n: 2, rule: IsEmail, want: true},
+		{name: "NotEnough", arg: emails, n: 3, rule: IsEmail, want: false},
+		{name: "Zero", arg: []string{}, n: 0, rule: IsEmail, want: true},
+		{name: "EmptyCollection", arg: []string{}, n: 1, rule: IsEmail, want: false},
+		{name: "Unsupported", arg: 10, n: 1, rule: IsEmail, panic: true},
+		{name: "Nil", arg: nil, n: 1, rule: IsEmail, panic: true},
+	})
+
+	if AtLeastNSatisfy(emails, 1, counting); calls != 1 {
+		t.Errorf("AtLeastNSatisfy() ran the rule %d times, want 1", calls)
+	}
+}
+
+func TestAtMostNSatisfy(t *testing.T) {
+	emails := []string{"ana@example.com", "bob@example.com", "not an email"}
+
+	runCollectionCases(t, "AtMostNSatisfy", AtMostNSatisfy, []collectionCase{
+		{name: "WithinLimit", arg: emails, n: 1, rule: IsNotEmail, want: true},
+		{name: "AboveLimit", arg: emails, n: 1, rule: IsEmail, want: false},
+		{name: "EqualToLimit", arg: emails, n: 2, rule: IsEmail, want: true},
+		{name: "EmptyCollection", arg: []string{}, n: 0, rule: IsEmail, want: true},
+		{name: "Unsupported", arg: 10, n: 1, rule: IsEmail, panic: true},
+		{name: "Nil", arg: nil, n: 1, rule: IsEmail, panic: true},
+	})
+}
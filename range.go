@@ -0,0 +1,146 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"math"
+	"time"
+)
+
+// IsValidRange checks if the given bounds form a valid range, that is, if start is lower than or equal to end,
+// such as the (from, to) pair of a booking or a report. The bounds are compared as floats when both are numbers
+// or numeric strings, and as times, converted with toTime, when neither is. Bounds of different kinds, such as a
+// number and a time.Time, or "20240101" and "2024-02-01", fail the check, and so do NaN bounds, which are neither
+// lower nor greater than any number.
+//
+// Parameters:
+//   - start: The lower bound of the range.
+//   - end: The upper bound of the range.
+//
+// Returns:
+//   - bool: A boolean value indicating whether start is lower than or equal to end.
+//
+// Panic:
+//   - The function will panic if a bound that is not numeric cannot be converted into a time.Time.
+//
+// Example:
+//
+//	fmt.Println(IsValidRange(1, 10))                      // true
+//	fmt.Println(IsValidRange(10, 10))                     // true
+//	fmt.Println(IsValidRange("2024-01-10", "2024-01-01")) // false
+//	fmt.Println(IsValidRange("20240101", "2024-02-01"))   // false
+func IsValidRange(start, end any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsValidRange", time.Now(), &passed)
+	}
+	numeric, ok := boundsKind(start, end)
+	return ok && compareBounds(start, end, numeric) <= 0
+}
+
+// IsNonEmptyRange checks if the given bounds form a non-empty range, that is, if start is strictly lower than
+// end, so a range that starts and ends at the same instant fails. The bounds are compared as IsValidRange does.
+//
+// Parameters:
+//   - start: The lower bound of the range.
+//   - end: The upper bound of the range.
+//
+// Returns:
+//   - bool: A boolean value indicating whether start is lower than end.
+//
+// Panic:
+//   - The function will panic if a bound that is not numeric cannot be converted into a time.Time.
+//
+// Example:
+//
+//	fmt.Println(IsNonEmptyRange(1, 10))                      // true
+//	fmt.Println(IsNonEmptyRange(10, 10))                     // false
+//	fmt.Println(IsNonEmptyRange("2024-01-01", "2024-01-10")) // true
+func IsNonEmptyRange(start, end any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNonEmptyRange", time.Now(), &passed)
+	}
+	numeric, ok := boundsKind(start, end)
+	return ok && compareBounds(start, end, numeric) < 0
+}
+
+// RangeWithin checks if the inner range is a valid range, as checked by IsValidRange, contained in the outer
+// range, bounds included, such as a booking inside the opening period of a venue. The bounds are compared as
+// IsValidRange does, so they must all be numeric or all be times. The outer range is not checked, so an invalid
+// outer range contains no range.
+//
+// Parameters:
+//   - outerStart: The lower bound of the outer range.
+//   - outerEnd: The upper bound of the outer range.
+//   - innerStart: The lower bound of the inner range.
+//   - innerEnd: The upper bound of the inner range.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the inner range is within the outer range.
+//
+// Panic:
+//   - The function will panic if a bound that is not numeric cannot be converted into a time.Time.
+//
+// Example:
+//
+//	fmt.Println(RangeWithin(0, 100, 10, 20))                                         // true
+//	fmt.Println(RangeWithin(0, 100, 0, 100))                                         // true
+//	fmt.Println(RangeWithin(0, 100, 90, 110))                                        // false
+//	fmt.Println(RangeWithin("2024-01-01", "2024-01-31", "2024-01-10", "2024-01-15")) // true
+func RangeWithin(outerStart, outerEnd, innerStart, innerEnd any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("RangeWithin", time.Now(), &passed)
+	}
+	numeric, ok := boundsKind(outerStart, outerEnd, innerStart, innerEnd)
+	return ok && compareBounds(outerStart, innerStart, numeric) <= 0 &&
+		compareBounds(innerStart, innerEnd, numeric) <= 0 &&
+		compareBounds(innerEnd, outerEnd, numeric) <= 0
+}
+
+// boundsKind reports whether the bounds are to be compared as floats, being all numbers or numeric strings, or as
+// times, being none of them, and false when they are of different kinds or one of them is NaN.
+func boundsKind(bounds ...any) (numeric bool, ok bool) {
+	numeric = isNumericElement(bounds[0])
+	for _, bound := range bounds {
+		if isNumericElement(bound) != numeric || numeric && math.IsNaN(toFloat(bound)) {
+			return false, false
+		}
+	}
+	return numeric, true
+}
+
+// compareBounds returns -1, 0 or 1 when a is lower than, equal to or greater than b, comparing floats when
+// numeric is true and times otherwise.
+func compareBounds(a, b any, numeric bool) int {
+	if !numeric {
+		return toTime(a).Compare(toTime(b))
+	}
+	x, y := toFloat(a), toFloat(b)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
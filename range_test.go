@@ -0,0 +1,114 @@
+package checker
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+type rangeCase struct {
+	name       string
+	start, end any
+	want       bool
+	panic      bool
+}
+
+func runRangeCases(t *testing.T, name string, fn func(start, end any) bool, tests []rangeCase) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := fn(tt.start, tt.end); got != tt.want {
+				t.Errorf("%s(%v, %v) = %v, want %v", name, tt.start, tt.end, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidRange(t *testing.T) {
+	now := time.Now()
+	runRangeCases(t, "IsValidRange", IsValidRange, []rangeCase{
+		{name: "Ints", start: 1, end: 10, want: true},
+		{name: "Equal", start: 10, end: 10, want: true},
+		{name: "Reversed", start: 10, end: 1, want: false},
+		{name: "Floats", start: 1.5, end: 1.25, want: false},
+		{name: "NumericStrings", start: "9", end: "10", want: true},
+		{name: "Times", start: now, end: now.Add(time.Hour), want: true},
+		{name: "ReversedTimes", start: now.Add(time.Hour), end: now, want: false},
+		{name: "DateStrings", start: "2024-01-01", end: "2024-01-10", want: true},
+		{name: "ReversedDateStrings", start: "2024-01-10", end: "2024-01-01", want: false},
+		{name: "EqualDateStrings", start: "2024-01-01", end: "2024-01-01", want: true},
+		{name: "NotTime", start: "yesterday", end: "2024-01-01", panic: true},
+		{name: "NumberAndText", start: 1, end: "ten", want: false},
+		{name: "NumberAndTime", start: 1, end: now, want: false},
+		{name: "TimeAndNumber", start: now, end: 1, want: false},
+		{name: "CompactAndDashedDates", start: "20240101", end: "2024-02-01", want: false},
+		{name: "NaNBounds", start: math.NaN(), end: math.NaN(), want: false},
+		{name: "NaNStart", start: math.NaN(), end: 10, want: false},
+		{name: "NaNStringEnd", start: 1, end: "NaN", want: false},
+		{name: "InfiniteEnd", start: 1, end: math.Inf(1), want: true},
+	})
+}
+
+func TestIsNonEmptyRange(t *testing.T) {
+	now := time.Now()
+	runRangeCases(t, "IsNonEmptyRange", IsNonEmptyRange, []rangeCase{
+		{name: "Ints", start: 1, end: 10, want: true},
+		{name: "Equal", start: 10, end: 10, want: false},
+		{name: "Reversed", start: 10, end: 1, want: false},
+		{name: "Times", start: now, end: now.Add(time.Nanosecond), want: true},
+		{name: "EqualTimes", start: now, end: now, want: false},
+		{name: "DateStrings", start: "2024-01-01", end: "2024-01-10", want: true},
+		{name: "EqualDateStrings", start: "2024-01-01", end: "2024-01-01", want: false},
+		{name: "NotTime", start: "yesterday", end: "2024-01-01", panic: true},
+		{name: "NumberAndTime", start: 1, end: now, want: false},
+		{name: "NaNEnd", start: 1, end: math.NaN(), want: false},
+	})
+}
+
+func TestRangeWithin(t *testing.T) {
+	tests := []struct {
+		name                 string
+		outerStart, outerEnd any
+		innerStart, innerEnd any
+		want                 bool
+		panic                bool
+	}{
+		{name: "Inside", outerStart: 0, outerEnd: 100, innerStart: 10, innerEnd: 20, want: true},
+		{name: "SameBounds", outerStart: 0, outerEnd: 100, innerStart: 0, innerEnd: 100, want: true},
+		{name: "EndsAfter", outerStart: 0, outerEnd: 100, innerStart: 90, innerEnd: 110, want: false},
+		{name: "StartsBefore", outerStart: 0, outerEnd: 100, innerStart: -1, innerEnd: 10, want: false},
+		{name: "ReversedInner", outerStart: 0, outerEnd: 100, innerStart: 20, innerEnd: 10, want: false},
+		{name: "ReversedOuter", outerStart: 100, outerEnd: 0, innerStart: 10, innerEnd: 20, want: false},
+		{name: "NaNInner", outerStart: 0, outerEnd: 100, innerStart: math.NaN(), innerEnd: math.NaN(), want: false},
+		{name: "NaNOuter", outerStart: math.NaN(), outerEnd: math.NaN(), innerStart: 10, innerEnd: 20, want: false},
+		{name: "Dates", outerStart: "2024-01-01", outerEnd: "2024-01-31", innerStart: "2024-01-10",
+			innerEnd: "2024-01-15", want: true},
+		{name: "DatesOutside", outerStart: "2024-01-01", outerEnd: "2024-01-31", innerStart: "2024-01-10",
+			innerEnd: "2024-02-01", want: false},
+		{name: "MixedKinds", outerStart: 0, outerEnd: 100, innerStart: "2024-01-10", innerEnd: "2024-01-15",
+			want: false},
+		{name: "NotTime", outerStart: "2024-01-01", outerEnd: "2024-01-31", innerStart: "soon",
+			innerEnd: "2024-01-15", panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := RangeWithin(tt.outerStart, tt.outerEnd, tt.innerStart, tt.innerEnd); got != tt.want {
+				t.Errorf("RangeWithin(%v, %v, %v, %v) = %v, want %v", tt.outerStart, tt.outerEnd, tt.innerStart,
+					tt.innerEnd, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"fmt"
+	"time"
+)
+
+// MissingDay represents a custom type for how recurring dates handle a day missing from a month, such as
+// February 29 in common years or the 31st in 30-day months.
+type MissingDay string
+
+const (
+	// MissingDaySkip represents a constant of type MissingDay that indicates no occurrence in months missing the
+	// day. It is the behavior of the zero value.
+	MissingDaySkip MissingDay = "SKIP"
+	// MissingDayLastOfMonth represents a constant of type MissingDay that indicates an occurrence on the last day of
+	// months missing the day, such as February 28 for February 29.
+	MissingDayLastOfMonth MissingDay = "LAST_OF_MONTH"
+	// MissingDayNextMonth represents a constant of type MissingDay that indicates an occurrence on the first day of
+	// the month after months missing the day, such as March 1 for February 29.
+	MissingDayNextMonth MissingDay = "NEXT_MONTH"
+)
+
+// IsEnumValid returns whether the missing day handling is one of the MissingDay constants.
+func (m MissingDay) IsEnumValid() bool {
+	switch m {
+	case MissingDaySkip, MissingDayLastOfMonth, MissingDayNextMonth:
+		return true
+	}
+	return false
+}
+
+// RecurrenceOptions configures IsAnniversaryOfWithOptions and OccursOnDayOfMonthWithOptions. The zero value matches
+// IsAnniversaryOf and OccursOnDayOfMonth.
+type RecurrenceOptions struct {
+	// MissingDay sets where a recurring day falls in months that miss it, such as February 29 in common years or
+	// the 31st in 30-day months. The zero value behaves as MissingDaySkip.
+	MissingDay MissingDay
+}
+
+// IsAnniversaryOf checks whether the date a falls on the same month and day as the date b, such as the renewal of
+// a yearly subscription signed on b, whatever their years. Each date is taken in its own location, and dates of
+// February 29 have no anniversary in common years; IsAnniversaryOfWithOptions can move it to February 28 or
+// March 1 instead.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format, checked as an anniversary.
+//   - b: Any value that can be converted into a time.Time format, the original date.
+//
+// Returns:
+//   - bool: A boolean value indicating whether a is an anniversary of b.
+//
+// Panic:
+//
+//	This function will panic if it's unable to convert the provided values into a time.Time format.
+//
+// Example:
+//
+//	fmt.Println(IsAnniversaryOf("2025-03-15", "2020-03-15")) // true
+//	fmt.Println(IsAnniversaryOf("2025-03-16", "2020-03-15")) // false
+//	fmt.Println(IsAnniversaryOf("2025-02-28", "2020-02-29")) // false
+func IsAnniversaryOf(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAnniversaryOf", time.Now(), &passed)
+	}
+	return isAnniversaryOfWithOptions(a, b, RecurrenceOptions{})
+}
+
+// IsAnniversaryOfWithOptions checks whether the date a is an anniversary of the date b, as IsAnniversaryOf does,
+// handling anniversaries of February 29 in common years as set by opts.MissingDay: with MissingDayLastOfMonth
+// they fall on February 28, and with MissingDayNextMonth on March 1.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format, checked as an anniversary.
+//   - b: Any value that can be converted into a time.Time format, the original date.
+//   - opts: The options of the check, such as the handling of February 29.
+//
+// Returns:
+//   - bool: A boolean value indicating whether a is an anniversary of b.
+//
+// Panic:
+//
+//	This function will panic if opts.MissingDay is not one of the MissingDay constants, or if it's unable to
+//	convert the provided values into a time.Time format.
+//
+// Example:
+//
+//	feb28 := RecurrenceOptions{MissingDay: MissingDayLastOfMonth}
+//	mar1 := RecurrenceOptions{MissingDay: MissingDayNextMonth}
+//	fmt.Println(IsAnniversaryOfWithOptions("2025-02-28", "2020-02-29", feb28)) // true
+//	fmt.Println(IsAnniversaryOfWithOptions("2024-02-28", "2020-02-29", feb28)) // false
+//	fmt.Println(IsAnniversaryOfWithOptions("2025-03-01", "2020-02-29", mar1))  // true
+func IsAnniversaryOfWithOptions(a, b any, opts RecurrenceOptions) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAnniversaryOfWithOptions", time.Now(), &passed)
+	}
+	return isAnniversaryOfWithOptions(a, b, opts)
+}
+
+// isAnniversaryOfWithOptions is the unobserved implementation of IsAnniversaryOfWithOptions.
+func isAnniversaryOfWithOptions(a, b any, opts RecurrenceOptions) bool {
+	original := toTime(b)
+	return isRecurrence(toTime(a), original.Month(), original.Day(), opts.MissingDay)
+}
+
+// OccursOnWeekday checks whether the given date falls on the weekday wd, such as a weekly billing run every
+// Monday. The date is taken in its own location.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format.
+//   - wd: The expected day of the week.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the date is on the weekday.
+//
+// Panic:
+//
+//	This function will panic if it's unable to convert the provided value into a time.Time format.
+//
+// Example:
+//
+//	fmt.Println(OccursOnWeekday("2024-01-01", time.Monday)) // true
+//	fmt.Println(OccursOnWeekday("2024-01-01", time.Sunday)) // false
+func OccursOnWeekday(a any, wd time.Weekday) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("OccursOnWeekday", time.Now(), &passed)
+	}
+	return toTime(a).Weekday() == wd
+}
+
+// OccursOnDayOfMonth checks whether the given date falls on the day of the month day, such as a monthly billing
+// run on the 10th. The date is taken in its own location, and months missing the day, such as February for the
+// 30th, have no occurrence; OccursOnDayOfMonthWithOptions can move it to the last day of the month or the first
+// day of the next one instead.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format.
+//   - day: The expected day of the month, from 1 to 31.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the date is on the day of the month.
+//
+// Panic:
+//
+//	This function will panic if day is not between 1 and 31, or if it's unable to convert the provided value into
+//	a time.Time format.
+//
+// Example:
+//
+//	fmt.Println(OccursOnDayOfMonth("2024-03-10", 10)) // true
+//	fmt.Println(OccursOnDayOfMonth("2024-03-11", 10)) // false
+//	fmt.Println(OccursOnDayOfMonth("2024-04-30", 31)) // false
+func OccursOnDayOfMonth(a any, day int) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("OccursOnDayOfMonth", time.Now(), &passed)
+	}
+	return occursOnDayOfMonthWithOptions(a, day, RecurrenceOptions{})
+}
+
+// OccursOnDayOfMonthWithOptions checks whether the given date falls on the day of the month day, as
+// OccursOnDayOfMonth does, handling months missing the day as set by opts.MissingDay: with MissingDayLastOfMonth
+// the occurrence falls on the last day of the month, such as February 28 or 29 for the 31st, and with
+// MissingDayNextMonth on the first day of the next month.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format.
+//   - day: The expected day of the month, from 1 to 31.
+//   - opts: The options of the check, such as the handling of months missing the day.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the date is on the day of the month.
+//
+// Panic:
+//
+//	This function will panic if day is not between 1 and 31, if opts.MissingDay is not one of the MissingDay
+//	constants, or if it's unable to convert the provided value into a time.Time format.
+//
+// Example:
+//
+//	monthEnd := RecurrenceOptions{MissingDay: MissingDayLastOfMonth}
+//	fmt.Println(OccursOnDayOfMonthWithOptions("2024-04-30", 31, monthEnd)) // true
+//	fmt.Println(OccursOnDayOfMonthWithOptions("2024-02-29", 30, monthEnd)) // true
+//	fmt.Println(OccursOnDayOfMonthWithOptions("2024-02-28", 30, monthEnd)) // false
+func OccursOnDayOfMonthWithOptions(a any, day int, opts RecurrenceOptions) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("OccursOnDayOfMonthWithOptions", time.Now(), &passed)
+	}
+	return occursOnDayOfMonthWithOptions(a, day, opts)
+}
+
+// occursOnDayOfMonthWithOptions is the unobserved implementation of OccursOnDayOfMonthWithOptions.
+func occursOnDayOfMonthWithOptions(a any, day int, opts RecurrenceOptions) bool {
+	if day < 1 || day > 31 {
+		panic(fmt.Sprintf("Invalid day of month: %d", day))
+	}
+	return isRecurrence(toTime(a), 0, day, opts.MissingDay)
+}
+
+// isRecurrence checks whether t is an occurrence of the given day of month, in every month when month is 0,
+// moving the occurrence as set by missing in months that miss the day.
+func isRecurrence(t time.Time, month time.Month, day int, missing MissingDay) bool {
+	if missing != "" && !missing.IsEnumValid() {
+		panic(fmt.Sprintf("Unsupported missing day: %s", missing))
+	}
+	if (month == 0 || t.Month() == month) && t.Day() == day {
+		return true
+	}
+
+	switch missing {
+	case MissingDayLastOfMonth:
+		last := daysInMonth(t.Year(), t.Month())
+		return (month == 0 || t.Month() == month) && day > last && t.Day() == last
+	case MissingDayNextMonth:
+		previous := t.AddDate(0, 0, -1)
+		return (month == 0 || previous.Month() == month) && t.Day() == 1 && day > previous.Day()
+	default:
+		return false
+	}
+}
+
+// daysInMonth returns the number of days of the month in the year.
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,149 @@
+package checker
+
+import (
+	"testing"
+	"time"
+)
+
+type recurrenceCase struct {
+	name  string
+	a     any
+	b     any
+	day   int
+	opts  RecurrenceOptions
+	want  bool
+	panic bool
+}
+
+func runRecurrenceCases(t *testing.T, name string, fn func(tc recurrenceCase) bool, tests []recurrenceCase) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := fn(tt); got != tt.want {
+				t.Errorf("%s(%v, %v, %d, %+v) = %v, want %v", name, tt.a, tt.b, tt.day, tt.opts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAnniversaryOf(t *testing.T) {
+	runRecurrenceCases(t, "IsAnniversaryOf", func(tc recurrenceCase) bool { return IsAnniversaryOf(tc.a, tc.b) },
+		[]recurrenceCase{
+			{name: "SameDay", a: "2025-03-15", b: "2020-03-15", want: true},
+			{name: "SameYear", a: "2020-03-15", b: "2020-03-15", want: true},
+			{name: "BeforeOriginal", a: "2019-03-15", b: "2020-03-15", want: true},
+			{name: "NextDay", a: "2025-03-16", b: "2020-03-15", want: false},
+			{name: "OtherMonth", a: "2025-04-15", b: "2020-03-15", want: false},
+			{name: "LeapDayInLeapYear", a: "2024-02-29", b: "2020-02-29", want: true},
+			{name: "LeapDayFeb28", a: "2025-02-28", b: "2020-02-29", want: false},
+			{name: "LeapDayMar1", a: "2025-03-01", b: "2020-02-29", want: false},
+			{name: "Times", a: time.Date(2025, 3, 15, 23, 0, 0, 0, time.UTC), b: "2020-03-15T08:00:00Z", want: true},
+			{name: "NotTime", a: "someday", b: "2020-03-15", panic: true},
+		})
+}
+
+func TestIsAnniversaryOfWithOptions(t *testing.T) {
+	feb28 := RecurrenceOptions{MissingDay: MissingDayLastOfMonth}
+	mar1 := RecurrenceOptions{MissingDay: MissingDayNextMonth}
+	runRecurrenceCases(t, "IsAnniversaryOfWithOptions", func(tc recurrenceCase) bool {
+		return IsAnniversaryOfWithOptions(tc.a, tc.b, tc.opts)
+	}, []recurrenceCase{
+		{name: "SkipFeb28", a: "2025-02-28", b: "2020-02-29", opts: RecurrenceOptions{MissingDay: MissingDaySkip}},
+		{name: "LastOfMonthFeb28", a: "2025-02-28", b: "2020-02-29", opts: feb28, want: true},
+		{name: "LastOfMonthFeb28InLeapYear", a: "2024-02-28", b: "2020-02-29", opts: feb28, want: false},
+		{name: "LastOfMonthLeapDay", a: "2024-02-29", b: "2020-02-29", opts: feb28, want: true},
+		{name: "LastOfMonthMar1", a: "2025-03-01", b: "2020-02-29", opts: feb28, want: false},
+		{name: "NextMonthMar1", a: "2025-03-01", b: "2020-02-29", opts: mar1, want: true},
+		{name: "NextMonthMar1InLeapYear", a: "2024-03-01", b: "2020-02-29", opts: mar1, want: false},
+		{name: "NextMonthFeb28", a: "2025-02-28", b: "2020-02-29", opts: mar1, want: false},
+		{name: "OrdinaryDay", a: "2025-03-15", b: "2020-03-15", opts: feb28, want: true},
+		{name: "OrdinaryNextMonth", a: "2025-03-01", b: "2020-02-28", opts: mar1, want: false},
+		{name: "InvalidOption", a: "2025-03-15", b: "2020-03-15", opts: RecurrenceOptions{MissingDay: "ROUND"},
+			panic: true},
+	})
+}
+
+func TestOccursOnWeekday(t *testing.T) {
+	tests := []struct {
+		name  string
+		arg   any
+		wd    time.Weekday
+		want  bool
+		panic bool
+	}{
+		{name: "Monday", arg: "2024-01-01", wd: time.Monday, want: true},
+		{name: "NotSunday", arg: "2024-01-01", wd: time.Sunday, want: false},
+		{name: "LeapDay", arg: "2024-02-29", wd: time.Thursday, want: true},
+		{name: "OwnLocation", arg: time.Date(2024, 1, 1, 23, 0, 0, 0, time.FixedZone("BRT", -3*60*60)),
+			wd: time.Monday, want: true},
+		{name: "NotTime", arg: "monday", wd: time.Monday, panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := OccursOnWeekday(tt.arg, tt.wd); got != tt.want {
+				t.Errorf("OccursOnWeekday(%v, %v) = %v, want %v", tt.arg, tt.wd, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOccursOnDayOfMonth(t *testing.T) {
+	runRecurrenceCases(t, "OccursOnDayOfMonth", func(tc recurrenceCase) bool {
+		return OccursOnDayOfMonth(tc.a, tc.day)
+	}, []recurrenceCase{
+		{name: "SameDay", a: "2024-03-10", day: 10, want: true},
+		{name: "OtherDay", a: "2024-03-11", day: 10, want: false},
+		{name: "ThirtyFirst", a: "2024-03-31", day: 31, want: true},
+		{name: "MissingThirtyFirst", a: "2024-04-30", day: 31, want: false},
+		{name: "LeapDay", a: "2024-02-29", day: 29, want: true},
+		{name: "MissingLeapDay", a: "2023-02-28", day: 29, want: false},
+		{name: "DayZero", a: "2024-03-10", day: 0, panic: true},
+		{name: "DayThirtyTwo", a: "2024-03-10", day: 32, panic: true},
+		{name: "NotTime", a: "tenth", day: 10, panic: true},
+	})
+}
+
+func TestOccursOnDayOfMonthWithOptions(t *testing.T) {
+	monthEnd := RecurrenceOptions{MissingDay: MissingDayLastOfMonth}
+	nextMonth := RecurrenceOptions{MissingDay: MissingDayNextMonth}
+	runRecurrenceCases(t, "OccursOnDayOfMonthWithOptions", func(tc recurrenceCase) bool {
+		return OccursOnDayOfMonthWithOptions(tc.a, tc.day, tc.opts)
+	}, []recurrenceCase{
+		{name: "LastOfMonthApril", a: "2024-04-30", day: 31, opts: monthEnd, want: true},
+		{name: "LastOfMonthApril29", a: "2024-04-29", day: 31, opts: monthEnd, want: false},
+		{name: "LastOfMonthLeapFebruary", a: "2024-02-29", day: 30, opts: monthEnd, want: true},
+		{name: "LastOfMonthLeapFebruary28", a: "2024-02-28", day: 30, opts: monthEnd, want: false},
+		{name: "LastOfMonthCommonFebruary", a: "2023-02-28", day: 29, opts: monthEnd, want: true},
+		{name: "LastOfMonthLeapFebruaryFor29", a: "2024-02-28", day: 29, opts: monthEnd, want: false},
+		{name: "LastOfMonthLongMonth", a: "2024-03-30", day: 31, opts: monthEnd, want: false},
+		{name: "NextMonthMay1", a: "2024-05-01", day: 31, opts: nextMonth, want: true},
+		{name: "NextMonthMarch1", a: "2023-03-01", day: 29, opts: nextMonth, want: true},
+		{name: "NextMonthMarch1InLeapYear", a: "2024-03-01", day: 29, opts: nextMonth, want: false},
+		{name: "NextMonthApril1", a: "2024-04-01", day: 31, opts: nextMonth, want: false},
+		{name: "NextMonthSameDay", a: "2024-05-31", day: 31, opts: nextMonth, want: true},
+		{name: "InvalidOption", a: "2024-05-31", day: 31, opts: RecurrenceOptions{MissingDay: "ROUND"}, panic: true},
+		{name: "InvalidDay", a: "2024-05-31", day: -1, opts: monthEnd, panic: true},
+	})
+}
+
+func TestMissingDayIsEnumValid(t *testing.T) {
+	if !IsEnumValid(MissingDayNextMonth) {
+		t.Errorf("IsEnumValid(%v) = false, want true", MissingDayNextMonth)
+	}
+	if IsEnumValid(MissingDay("PREVIOUS_MONTH")) {
+		t.Errorf("IsEnumValid(PREVIOUS_MONTH) = true, want false")
+	}
+}
@@ -0,0 +1,188 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// brazilStates maps the abbreviation of each Brazilian state and of the Federal District to its IBGE code.
+var brazilStates = map[string]string{
+	"RO": "11", "AC": "12", "AM": "13", "RR": "14", "PA": "15", "AP": "16", "TO": "17", "MA": "21", "PI": "22",
+	"CE": "23", "RN": "24", "PB": "25", "PE": "26", "AL": "27", "SE": "28", "BA": "29", "MG": "31", "ES": "32",
+	"RJ": "33", "SP": "35", "PR": "41", "SC": "42", "RS": "43", "MS": "50", "MT": "51", "GO": "52", "DF": "53",
+}
+
+// municipalityCheckDigitExceptions lists the IBGE municipality codes whose last digit does not follow the check
+// digit calculation, as they were created before the rule.
+var municipalityCheckDigitExceptions = []string{
+	"2201919", "2201988", "2202251", "2611533", "3117836", "3152131", "4305871", "5203939", "5203962",
+}
+
+// IsGenderCode checks if a given value is a gender code. It uses the toString function to convert the value and
+// compares it case-insensitively against the accepted genders, which default to all the Gender constants ("M",
+// "F", "O" and "N") when none is informed.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a gender code.
+//   - accepted: The accepted genders, all the Gender constants when empty.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an accepted gender code.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsGenderCode("F"))                           // true
+//	fmt.Println(IsGenderCode("m"))                           // true
+//	fmt.Println(IsGenderCode("O", GenderMale, GenderFemale)) // false
+//	fmt.Println(IsGenderCode("X"))                           // false
+func IsGenderCode(a any, accepted ...Gender) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsGenderCode", time.Now(), &passed)
+	}
+	if len(accepted) == 0 {
+		accepted = []Gender{GenderMale, GenderFemale, GenderOther, GenderNotInformed}
+	}
+
+	s := strings.TrimSpace(toString(a))
+	for _, gender := range accepted {
+		if strings.EqualFold(s, string(gender)) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMaritalStatus checks if a given value is a marital status, as defined by the MaritalStatus constants. It uses
+// the toString function to convert the value and compares it case-insensitively.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a marital status.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a marital status.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsMaritalStatus("MARRIED"))      // true
+//	fmt.Println(IsMaritalStatus("stable_union")) // true
+//	fmt.Println(IsMaritalStatus("complicated"))  // false
+func IsMaritalStatus(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMaritalStatus", time.Now(), &passed)
+	}
+	return MaritalStatus(strings.ToUpper(strings.TrimSpace(toString(a)))).IsEnumValid()
+}
+
+// IsBrazilUF checks if a given value is the abbreviation (UF) of a Brazilian state or of the Federal District,
+// such as "SP" or "DF". It uses the toString function to convert the value and compares it case-insensitively.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a UF.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a Brazilian UF.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsBrazilUF("SP")) // true
+//	fmt.Println(IsBrazilUF("rj")) // true
+//	fmt.Println(IsBrazilUF("XX")) // false
+func IsBrazilUF(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBrazilUF", time.Now(), &passed)
+	}
+	_, ok := brazilStates[strings.ToUpper(strings.TrimSpace(toString(a)))]
+	return ok
+}
+
+// IsBrazilMunicipalityIBGECode checks if a given value is the 7-digit IBGE code of a Brazilian municipality, such
+// as "3550308" (São Paulo). The first two digits must be the IBGE code of a state and the last digit must match
+// the check digit calculated over the first six digits with alternating weights 1 and 2, except for the few
+// municipalities whose codes predate the rule.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a municipality code.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid IBGE municipality code.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsBrazilMunicipalityIBGECode("3550308")) // true
+//	fmt.Println(IsBrazilMunicipalityIBGECode(3304557))   // true
+//	fmt.Println(IsBrazilMunicipalityIBGECode("3550309")) // false
+//	fmt.Println(IsBrazilMunicipalityIBGECode("9950308")) // false
+func IsBrazilMunicipalityIBGECode(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBrazilMunicipalityIBGECode", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^\d{7}$`)
+	if !regex.MatchString(s) || !isBrazilStateIBGECode(s[:2]) {
+		return false
+	} else if contains(municipalityCheckDigitExceptions, s) {
+		return true
+	}
+
+	sum := 0
+	for i := 0; i < 6; i++ {
+		product := int(s[i]-'0') * (1 + i%2)
+		sum += product/10 + product%10
+	}
+	return strconv.Itoa((10-sum%10)%10) == s[6:]
+}
+
+// isBrazilStateIBGECode checks if the given two digits are the IBGE code of a Brazilian state or of the Federal
+// District.
+func isBrazilStateIBGECode(code string) bool {
+	for _, stateCode := range brazilStates {
+		if stateCode == code {
+			return true
+		}
+	}
+	return false
+}
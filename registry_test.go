@@ -0,0 +1,124 @@
+package checker
+
+import "testing"
+
+func TestIsGenderCode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		arg      any
+		accepted []Gender
+		want     bool
+		panic    bool
+	}{
+		{name: "Male", arg: "M", want: true},
+		{name: "LowerCaseFemale", arg: "f", want: true},
+		{name: "Constant", arg: GenderNotInformed, want: true},
+		{name: "Unknown", arg: "X", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "AcceptedSet", arg: "F", accepted: []Gender{GenderMale, GenderFemale}, want: true},
+		{name: "OutsideAcceptedSet", arg: "O", accepted: []Gender{GenderMale, GenderFemale}, want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsGenderCode(tc.arg, tc.accepted...); got != tc.want {
+				t.Errorf("IsGenderCode(%v, %v) = %v, want %v", tc.arg, tc.accepted, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsMaritalStatus(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Single", arg: "SINGLE", want: true},
+		{name: "LowerCase", arg: "married", want: true},
+		{name: "StableUnion", arg: "stable_union", want: true},
+		{name: "Constant", arg: MaritalStatusWidowed, want: true},
+		{name: "Padded", arg: " DIVORCED ", want: true},
+		{name: "Unknown", arg: "complicated", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsMaritalStatus(tc.arg); got != tc.want {
+				t.Errorf("IsMaritalStatus(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBrazilUF(t *testing.T) {
+	testCases := []baseCase{
+		{name: "SaoPaulo", arg: "SP", want: true},
+		{name: "FederalDistrict", arg: "DF", want: true},
+		{name: "LowerCase", arg: "rj", want: true},
+		{name: "Unknown", arg: "XX", want: false},
+		{name: "FullName", arg: "São Paulo", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsBrazilUF(tc.arg); got != tc.want {
+				t.Errorf("IsBrazilUF(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBrazilMunicipalityIBGECode(t *testing.T) {
+	testCases := []baseCase{
+		{name: "SaoPaulo", arg: "3550308", want: true},
+		{name: "RioDeJaneiroInt", arg: 3304557, want: true},
+		{name: "Brasilia", arg: "5300108", want: true},
+		{name: "CheckDigitException", arg: "2201919", want: true},
+		{name: "WrongCheckDigit", arg: "3550309", want: false},
+		{name: "UnknownState", arg: "9950308", want: false},
+		{name: "Short", arg: "355030", want: false},
+		{name: "Letters", arg: "35503A8", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsBrazilMunicipalityIBGECode(tc.arg); got != tc.want {
+				t.Errorf("IsBrazilMunicipalityIBGECode(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
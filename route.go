@@ -0,0 +1,146 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// IsVersionedAPIPath checks if a given value is a URL path, as checked by IsURLPath, that starts with an API
+// version segment "/v{N}/", where N is a positive integer without leading zeros, such as "/v1/users" or
+// "/v2/orders/42".
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a versioned API path.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a path prefixed by an API version.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsVersionedAPIPath("/v1/users"))      // true
+//	fmt.Println(IsVersionedAPIPath("/v12/orders/42")) // true
+//	fmt.Println(IsVersionedAPIPath("/users"))         // false
+//	fmt.Println(IsVersionedAPIPath("/v01/users"))     // false
+//	fmt.Println(IsVersionedAPIPath("/v1"))            // false
+func IsVersionedAPIPath(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsVersionedAPIPath", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^/v[1-9]\d*/`)
+	return regex.MatchString(s) && isURLPath(s)
+}
+
+// PathMatchesTemplate checks if a given concrete path fits the given route template, such as "/users/42/orders/7"
+// for "/users/{id}/orders/{orderId}". Literal segments must be equal, and each parameter, written as "{name}" in
+// place of a whole segment, must match a single non-empty segment made of RFC 3986 path characters, with valid
+// percent-encodings. The "." and ".." segments, also when percent-encoded as in "%2e%2e", and segments with an
+// encoded "/" or "\" are never accepted as parameter values, so they cannot be used for path traversal by servers
+// that decode them. Trailing slashes are significant and query strings are not accepted.
+//
+// Parameters:
+//   - path: Any value to be converted into a string and checked against the template.
+//   - template: Any value to be converted into a string and used as the route template.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the path fits the template.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//   - The function will panic if the template is not a path starting with "/", or if a parameter is malformed,
+//     does not take a whole segment, or is declared twice.
+//
+// Example:
+//
+//	template := "/users/{id}/orders/{orderId}"
+//	fmt.Println(PathMatchesTemplate("/users/42/orders/7", template))     // true
+//	fmt.Println(PathMatchesTemplate("/users/42/orders", template))       // false
+//	fmt.Println(PathMatchesTemplate("/users/../orders/7", template))     // false
+//	fmt.Println(PathMatchesTemplate("/users/%2e%2e/orders/7", template)) // false
+//	fmt.Println(PathMatchesTemplate("/users/42/orders/7/", template))    // false
+//	fmt.Println(PathMatchesTemplate("/users/a%20b/orders/7", template))  // true
+//	fmt.Println(PathMatchesTemplate("/users/a%2Fb/orders/7", template))  // false
+func PathMatchesTemplate(path, template any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("PathMatchesTemplate", time.Now(), &passed)
+	}
+	templateSegments := parsePathTemplate(toString(template))
+
+	s := toString(path)
+	if !strings.HasPrefix(s, "/") {
+		return false
+	}
+	segments := strings.Split(s[1:], "/")
+	if len(segments) != len(templateSegments) {
+		return false
+	}
+
+	value := regexp.MustCompile(`^([A-Za-z0-9\-._~!$&'()*+,;=:@]|%[0-9A-Fa-f]{2})+$`)
+	for i, segment := range segments {
+		expected := templateSegments[i]
+		if !strings.HasPrefix(expected, "{") {
+			if segment != expected {
+				return false
+			}
+		} else if !value.MatchString(segment) {
+			return false
+		} else if decoded, err := url.PathUnescape(segment); err != nil || decoded == "." || decoded == ".." ||
+			strings.ContainsAny(decoded, `/\`) {
+			return false
+		}
+	}
+	return true
+}
+
+// parsePathTemplate splits the route template into its segments, panicking if it is not a valid template.
+func parsePathTemplate(template string) []string {
+	if !strings.HasPrefix(template, "/") || strings.ContainsAny(template, "?# ") {
+		panic(fmt.Sprintf("Invalid path template: %s", template))
+	}
+
+	parameter := regexp.MustCompile(`^\{[A-Za-z_][A-Za-z0-9_]*\}$`)
+	names := map[string]bool{}
+	segments := strings.Split(template[1:], "/")
+	for _, segment := range segments {
+		if !strings.ContainsAny(segment, "{}") {
+			continue
+		}
+		if !parameter.MatchString(segment) || names[segment] {
+			panic(fmt.Sprintf("Invalid path template: %s", template))
+		}
+		names[segment] = true
+	}
+	return segments
+}
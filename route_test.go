@@ -0,0 +1,100 @@
+package checker
+
+import "testing"
+
+func TestIsVersionedAPIPath(t *testing.T) {
+	testCases := []baseCase{
+		{name: "V1", arg: "/v1/users", want: true},
+		{name: "V12", arg: "/v12/orders/42", want: true},
+		{name: "TrailingSlash", arg: "/v1/", want: true},
+		{name: "Nested", arg: "/v2/users/42/orders", want: true},
+		{name: "NoVersion", arg: "/users", want: false},
+		{name: "LeadingZero", arg: "/v01/users", want: false},
+		{name: "VersionZero", arg: "/v0/users", want: false},
+		{name: "VersionOnly", arg: "/v1", want: false},
+		{name: "Uppercase", arg: "/V1/users", want: false},
+		{name: "NotPrefix", arg: "/api/v1/users", want: false},
+		{name: "Relative", arg: "v1/users", want: false},
+		{name: "Query", arg: "/v1/users?page=2", want: false},
+		{name: "Space", arg: "/v1/my users", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsVersionedAPIPath(tc.arg); got != tc.want {
+				t.Errorf("IsVersionedAPIPath(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPathMatchesTemplate(t *testing.T) {
+	orders := "/users/{id}/orders/{orderId}"
+	testCases := []struct {
+		name     string
+		path     any
+		template any
+		want     bool
+		panic    bool
+	}{
+		{name: "Match", path: "/users/42/orders/7", template: orders, want: true},
+		{name: "UUIDParameter", path: "/users/3f1c5a2e-8d9b-4e6f-a1b2-c3d4e5f60718/orders/7", template: orders,
+			want: true},
+		{name: "PercentEncodedParameter", path: "/users/a%20b/orders/7", template: orders, want: true},
+		{name: "EncodedDotParameter", path: "/users/%2e/orders/7", template: orders, want: false},
+		{name: "EncodedDotDotParameter", path: "/files/%2e%2e", template: "/files/{name}", want: false},
+		{name: "UpperEncodedDotDotParameter", path: "/files/%2E%2E", template: "/files/{name}", want: false},
+		{name: "MixedEncodedDotDotParameter", path: "/files/%2E.", template: "/files/{name}", want: false},
+		{name: "EncodedSlashParameter", path: "/users/a%2Fb/orders/7", template: orders, want: false},
+		{name: "LowerEncodedSlashParameter", path: "/files/..%2fetc", template: "/files/{name}", want: false},
+		{name: "EncodedBackslashParameter", path: "/files/..%5Cetc", template: "/files/{name}", want: false},
+		{name: "DotsInParameter", path: "/files/a..b", template: "/files/{name}", want: true},
+		{name: "SubDelimsParameter", path: "/users/name:john@x/orders/7", template: orders, want: true},
+		{name: "LiteralOnly", path: "/health", template: "/health", want: true},
+		{name: "Root", path: "/", template: "/", want: true},
+		{name: "TrailingSlashTemplate", path: "/users/42/", template: "/users/{id}/", want: true},
+		{name: "MissingSegment", path: "/users/42/orders", template: orders, want: false},
+		{name: "ExtraSegment", path: "/users/42/orders/7/items", template: orders, want: false},
+		{name: "TrailingSlash", path: "/users/42/orders/7/", template: orders, want: false},
+		{name: "LiteralMismatch", path: "/users/42/invoices/7", template: orders, want: false},
+		{name: "EmptyParameter", path: "/users//orders/7", template: orders, want: false},
+		{name: "DotParameter", path: "/users/./orders/7", template: orders, want: false},
+		{name: "DotDotParameter", path: "/users/../orders/7", template: orders, want: false},
+		{name: "InvalidPercentEncoding", path: "/users/%zz/orders/7", template: orders, want: false},
+		{name: "SpaceInParameter", path: "/users/4 2/orders/7", template: orders, want: false},
+		{name: "Query", path: "/users/42/orders/7?x=1", template: orders, want: false},
+		{name: "Relative", path: "users/42/orders/7", template: orders, want: false},
+		{name: "RelativeTemplate", path: "/users/42", template: "users/{id}", panic: true},
+		{name: "PartialParameter", path: "/files/a.txt", template: "/files/{name}.txt", panic: true},
+		{name: "EmptyParameterName", path: "/users/42", template: "/users/{}", panic: true},
+		{name: "UnclosedParameter", path: "/users/42", template: "/users/{id", panic: true},
+		{name: "DuplicateParameter", path: "/users/1/2", template: "/users/{id}/{id}", panic: true},
+		{name: "NilPath", path: nil, template: orders, panic: true},
+		{name: "NilTemplate", path: "/users/42", template: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := PathMatchesTemplate(tc.path, tc.template); got != tc.want {
+				t.Errorf("PathMatchesTemplate(%v, %v) = %v, want %v", tc.path, tc.template, got, tc.want)
+			}
+		})
+	}
+}
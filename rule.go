@@ -0,0 +1,154 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import "sort"
+
+// Rule represents a single-value checker, such as IsCPF, IsEmail or IsNotEmpty. Any function with the
+// signature func(a any) bool can be used as a Rule, which allows checkers to be combined, wrapped and
+// evaluated generically.
+type Rule func(a any) bool
+
+// Quietly runs the given check and recovers from any panic raised by it. Checkers panic when they receive
+// unsupported or nil values; Quietly reports this case through the second return value instead of letting
+// the panic propagate.
+//
+// Parameters:
+//   - fn: The check to be executed.
+//
+// Returns:
+//   - result: The result of the check, or false if it panicked.
+//   - panicked: A boolean value indicating whether the check panicked.
+//
+// Example:
+//
+//	fmt.Println(Quietly(func() bool { return IsCPF("12101721007") })) // true false
+//	fmt.Println(Quietly(func() bool { return IsCPF(nil) }))           // false true
+func Quietly(fn func() bool) (result bool, panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			result, panicked = false, true
+		}
+	}()
+	return fn(), false
+}
+
+// Guard wraps the given Rule into a new Rule that returns false instead of panicking. It uses the Quietly
+// function to run the original Rule.
+//
+// Parameters:
+//   - fn: The Rule to be guarded.
+//
+// Returns:
+//   - Rule: A Rule with the same result as 'fn', except that panics are converted to false.
+//
+// Example:
+//
+//	isEmail := Guard(IsEmail)
+//	fmt.Println(isEmail("test@example.com")) // true
+//	fmt.Println(isEmail(nil))                // false
+func Guard(fn Rule) Rule {
+	return func(a any) bool {
+		result, _ := Quietly(func() bool {
+			return fn(a)
+		})
+		return result
+	}
+}
+
+// AllTrue returns whether all the given check results are true, which makes long chains of checks combined with
+// && easier to read. It returns true when no check is given.
+//
+// Parameters:
+//   - checks: The results of the checks.
+//
+// Returns:
+//   - bool: A boolean value indicating whether every check is true.
+//
+// Example:
+//
+//	fmt.Println(AllTrue(IsCPF("12101721007"), IsEmail("test@example.com"))) // true
+//	fmt.Println(AllTrue(IsCPF("12101721007"), IsEmail("invalid")))          // false
+func AllTrue(checks ...bool) bool {
+	for _, check := range checks {
+		if !check {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyTrue returns whether at least one of the given check results is true, which makes long chains of checks
+// combined with || easier to read. It returns false when no check is given.
+//
+// Parameters:
+//   - checks: The results of the checks.
+//
+// Returns:
+//   - bool: A boolean value indicating whether any check is true.
+//
+// Example:
+//
+//	fmt.Println(AnyTrue(IsCPF("invalid"), IsCNPJ("11222333000181"))) // true
+//	fmt.Println(AnyTrue(IsCPF("invalid"), IsCNPJ("invalid")))        // false
+func AnyTrue(checks ...bool) bool {
+	for _, check := range checks {
+		if check {
+			return true
+		}
+	}
+	return false
+}
+
+// FirstFalse finds a failed check among the given named check results, so that the failing check can be
+// identified by name in error messages. As maps have no order, the names are evaluated in lexicographic order,
+// so the same failing name is always reported for the same results.
+//
+// Parameters:
+//   - named: The results of the checks, keyed by name.
+//
+// Returns:
+//   - string: The name of the first failed check, or an empty string if all of them are true.
+//   - bool: A boolean value indicating whether a failed check was found.
+//
+// Example:
+//
+//	name, failed := FirstFalse(map[string]bool{
+//		"document": IsCPF("12101721007"),
+//		"email":    IsEmail("invalid"),
+//	})
+//	fmt.Println(name, failed) // email true
+func FirstFalse(named map[string]bool) (string, bool) {
+	names := make([]string, 0, len(named))
+	for name, check := range named {
+		if !check {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", false
+	}
+
+	sort.Strings(names)
+	return names[0], true
+}
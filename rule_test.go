@@ -0,0 +1,117 @@
+package checker
+
+import "testing"
+
+func TestQuietly(t *testing.T) {
+	tests := []struct {
+		name         string
+		fn           func() bool
+		want         bool
+		wantPanicked bool
+	}{
+		{name: "True", fn: func() bool { return IsCPF("12101721007") }, want: true},
+		{name: "False", fn: func() bool { return IsCPF("11111111111") }, want: false},
+		{name: "Panic", fn: func() bool { return IsCPF(nil) }, want: false, wantPanicked: true},
+		{name: "PanicWithError", fn: func() bool { return IsBeforeNow("invalid") }, want: false, wantPanicked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, panicked := Quietly(tt.fn)
+			if got != tt.want || panicked != tt.wantPanicked {
+				t.Errorf("Quietly() = %v, %v, want %v, %v", got, panicked, tt.want, tt.wantPanicked)
+			}
+		})
+	}
+}
+
+func TestGuard(t *testing.T) {
+	var nilString *string
+	testCases := []baseCase{
+		{name: "ValidEmail", arg: "test@example.com", want: true},
+		{name: "InvalidEmail", arg: "invalid", want: false},
+		{name: "Nil", arg: nil, want: false},
+		{name: "NilPointer", arg: nilString, want: false},
+		{name: "Unsupported", arg: make(chan int), want: false},
+	}
+
+	isEmail := Guard(IsEmail)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isEmail(tc.arg); got != tc.want {
+				t.Errorf("Guard(IsEmail)(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAllTrue(t *testing.T) {
+	tests := []struct {
+		name   string
+		checks []bool
+		want   bool
+	}{
+		{name: "Empty", checks: nil, want: true},
+		{name: "AllTrue", checks: []bool{true, true, true}, want: true},
+		{name: "OneFalse", checks: []bool{true, false, true}, want: false},
+		{name: "AllFalse", checks: []bool{false, false}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AllTrue(tt.checks...); got != tt.want {
+				t.Errorf("AllTrue(%v) = %v, want %v", tt.checks, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnyTrue(t *testing.T) {
+	tests := []struct {
+		name   string
+		checks []bool
+		want   bool
+	}{
+		{name: "Empty", checks: nil, want: false},
+		{name: "AllTrue", checks: []bool{true, true}, want: true},
+		{name: "OneTrue", checks: []bool{false, true, false}, want: true},
+		{name: "AllFalse", checks: []bool{false, false}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AnyTrue(tt.checks...); got != tt.want {
+				t.Errorf("AnyTrue(%v) = %v, want %v", tt.checks, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFirstFalse(t *testing.T) {
+	tests := []struct {
+		name       string
+		named      map[string]bool
+		wantName   string
+		wantFailed bool
+	}{
+		{name: "Nil", named: nil, wantName: "", wantFailed: false},
+		{name: "AllTrue", named: map[string]bool{"email": true, "name": true}, wantName: "", wantFailed: false},
+		{name: "OneFalse", named: map[string]bool{"email": false, "name": true}, wantName: "email", wantFailed: true},
+		{
+			name:       "LexicographicOrder",
+			named:      map[string]bool{"zip": false, "document": false, "email": true, "name": false},
+			wantName:   "document",
+			wantFailed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotName, gotFailed := FirstFalse(tt.named)
+			if gotName != tt.wantName || gotFailed != tt.wantFailed {
+				t.Errorf("FirstFalse(%v) = %v, %v, want %v, %v", tt.named, gotName, gotFailed, tt.wantName,
+					tt.wantFailed)
+			}
+		})
+	}
+}
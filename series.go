@@ -0,0 +1,183 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// IsMonotonicIncreasing checks if the elements of a given series never decrease, that is, each element is greater
+// than or equal to the previous one, such as the timestamps of an event stream. When the first element is a
+// number or a numeric string, every element is converted with toFloat; otherwise they are converted with toTime,
+// so time.Time values and date strings are compared chronologically. Series with fewer than 2 elements pass.
+//
+// Parameters:
+//   - a: A slice or array (or a pointer to one of them) with the series.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the series is monotonically increasing.
+//
+// Panic:
+//   - If 'a' is nil, it panics with ErrNilValue.
+//   - If 'a' is not a slice or array, it panics with an ErrUnsupportedType indicating the unsupported type.
+//   - The function will panic if an element cannot be converted into a float or a time.Time.
+//
+// Example:
+//
+//	fmt.Println(IsMonotonicIncreasing([]int{1, 2, 2, 3}))                    // true
+//	fmt.Println(IsMonotonicIncreasing([]string{"2024-01-01", "2024-01-02"})) // true
+//	fmt.Println(IsMonotonicIncreasing([]float64{1, 3, 2}))                   // false
+func IsMonotonicIncreasing(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMonotonicIncreasing", time.Now(), &passed)
+	}
+	comparisons := compareConsecutive(a)
+	for _, comparison := range comparisons {
+		if comparison < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsStrictlyIncreasing checks if each element of a given series is greater than the previous one, so repeated
+// values fail, such as the sequence numbers of a stream or the open times of OHLC candles. The elements are
+// converted as IsMonotonicIncreasing does, and series with fewer than 2 elements pass.
+//
+// Parameters:
+//   - a: A slice or array (or a pointer to one of them) with the series.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the series is strictly increasing.
+//
+// Panic:
+//   - If 'a' is nil, it panics with ErrNilValue.
+//   - If 'a' is not a slice or array, it panics with an ErrUnsupportedType indicating the unsupported type.
+//   - The function will panic if an element cannot be converted into a float or a time.Time.
+//
+// Example:
+//
+//	fmt.Println(IsStrictlyIncreasing([]int{1, 2, 3}))    // true
+//	fmt.Println(IsStrictlyIncreasing([]int{1, 2, 2, 3})) // false
+//	fmt.Println(IsStrictlyIncreasing([]int{3, 2, 1}))    // false
+func IsStrictlyIncreasing(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsStrictlyIncreasing", time.Now(), &passed)
+	}
+	comparisons := compareConsecutive(a)
+	for _, comparison := range comparisons {
+		if comparison <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// HasGapLargerThan checks if any two consecutive elements of a given series of times are further apart than the
+// given duration, such as a missing candle or an outage in an event stream. Every element is converted with
+// toTime, so numbers are Unix timestamps in milliseconds, and the gap is the absolute difference between
+// neighbours, so the series is expected to be sorted.
+//
+// Parameters:
+//   - a: A slice or array (or a pointer to one of them) with the series.
+//   - d: The largest gap allowed between consecutive elements.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the series has a gap larger than the duration.
+//
+// Panic:
+//   - If 'a' is nil, it panics with ErrNilValue.
+//   - If 'a' is not a slice or array, it panics with an ErrUnsupportedType indicating the unsupported type.
+//   - The function will panic if an element cannot be converted into a time.Time.
+//
+// Example:
+//
+//	candles := []string{"2024-01-01 10:00:00", "2024-01-01 10:01:00", "2024-01-01 10:05:00"}
+//	fmt.Println(HasGapLargerThan(candles, time.Minute))   // true
+//	fmt.Println(HasGapLargerThan(candles, 5*time.Minute)) // false
+func HasGapLargerThan(a any, d time.Duration) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("HasGapLargerThan", time.Now(), &passed)
+	}
+	values := sequenceValues(a)
+	for i := 1; i < len(values); i++ {
+		gap := toTime(values[i]).Sub(toTime(values[i-1]))
+		if gap < 0 {
+			gap = -gap
+		}
+		if gap > d {
+			return true
+		}
+	}
+	return false
+}
+
+// compareConsecutive returns, for each element of the series after the first, -1, 0 or 1 when it is lower than,
+// equal to or greater than the previous one, comparing floats when the first element is numeric and times
+// otherwise.
+func compareConsecutive(a any) []int {
+	values := sequenceValues(a)
+	if len(values) == 0 {
+		return nil
+	}
+
+	numeric := isNumericElement(values[0])
+	var comparisons []int
+	for i := 1; i < len(values); i++ {
+		comparisons = append(comparisons, compareBounds(values[i], values[i-1], numeric))
+	}
+	return comparisons
+}
+
+// sequenceValues returns the elements of a slice or array, as collectionValues does, but panics on maps, whose
+// iteration order is not defined.
+func sequenceValues(a any) []any {
+	reflectValue := reflect.ValueOf(a)
+	for (reflectValue.Kind() == reflect.Ptr || reflectValue.Kind() == reflect.Interface) && !reflectValue.IsNil() {
+		reflectValue = reflectValue.Elem()
+	}
+	if reflectValue.Kind() == reflect.Map {
+		panic(ErrUnsupportedType{Kind: reflect.Map})
+	}
+	return collectionValues(a)
+}
+
+// isNumericElement checks whether the element is a number, a numeric string or a pointer to one of them.
+func isNumericElement(a any) bool {
+	reflectValue := reflect.ValueOf(a)
+	for (reflectValue.Kind() == reflect.Ptr || reflectValue.Kind() == reflect.Interface) && !reflectValue.IsNil() {
+		reflectValue = reflectValue.Elem()
+	}
+
+	switch reflectValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8,
+		reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	case reflect.String:
+		_, err := strconv.ParseFloat(reflectValue.String(), 64)
+		return err == nil
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package checker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsMonotonicIncreasing(t *testing.T) {
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	testCases := []baseCase{
+		{name: "Increasing", arg: []int{1, 2, 3}, want: true},
+		{name: "WithRepeats", arg: []int{1, 2, 2, 3}, want: true},
+		{name: "NumericStrings", arg: []string{"1.5", "2", "10"}, want: true},
+		{name: "Mixed", arg: []any{1, "2", 3.5}, want: true},
+		{name: "DateStrings", arg: []string{"2024-01-01", "2024-01-02"}, want: true},
+		{name: "Times", arg: []time.Time{start, start, start.Add(time.Nanosecond)}, want: true},
+		{name: "Pointer", arg: &[]int{1, 2}, want: true},
+		{name: "Array", arg: [3]int{1, 2, 3}, want: true},
+		{name: "Single", arg: []int{1}, want: true},
+		{name: "Empty", arg: []int{}, want: true},
+		{name: "Decreasing", arg: []float64{1, 3, 2}, want: false},
+		{name: "DecreasingTimes", arg: []time.Time{start, start.Add(-time.Nanosecond)}, want: false},
+		{name: "DecreasingDates", arg: []string{"2024-01-02", "2024-01-01"}, want: false},
+		{name: "Map", arg: map[string]int{"a": 1}, panic: true},
+		{name: "InvalidElement", arg: []any{1, "a"}, panic: true},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsMonotonicIncreasing(tc.arg); got != tc.want {
+				t.Errorf("IsMonotonicIncreasing(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsStrictlyIncreasing(t *testing.T) {
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	testCases := []baseCase{
+		{name: "Increasing", arg: []int{1, 2, 3}, want: true},
+		{name: "Times", arg: []time.Time{start, start.Add(time.Minute)}, want: true},
+		{name: "Single", arg: []int{1}, want: true},
+		{name: "WithRepeats", arg: []int{1, 2, 2, 3}, want: false},
+		{name: "RepeatedTimes", arg: []time.Time{start, start}, want: false},
+		{name: "Decreasing", arg: []int{3, 2, 1}, want: false},
+		{name: "Unsupported", arg: "1,2,3", panic: true},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsStrictlyIncreasing(tc.arg); got != tc.want {
+				t.Errorf("IsStrictlyIncreasing(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHasGapLargerThan(t *testing.T) {
+	candles := []string{"2024-01-01 10:00:00", "2024-01-01 10:01:00", "2024-01-01 10:05:00"}
+	testCases := []struct {
+		name  string
+		arg   any
+		d     time.Duration
+		want  bool
+		panic bool
+	}{
+		{name: "LargerGap", arg: candles, d: time.Minute, want: true},
+		{name: "GapEqualToDuration", arg: candles, d: 4 * time.Minute, want: false},
+		{name: "NoGap", arg: candles, d: 5 * time.Minute, want: false},
+		{name: "UnixMillis", arg: []int64{1000, 2000, 9000}, d: 5 * time.Second, want: true},
+		{name: "Unsorted", arg: []int64{9000, 1000}, d: 5 * time.Second, want: true},
+		{name: "Single", arg: []int64{1000}, d: 0, want: false},
+		{name: "InvalidElement", arg: []string{"2024-01-01", "tomorrow"}, d: time.Hour, panic: true},
+		{name: "Map", arg: map[int]int64{1: 1000}, d: time.Hour, panic: true},
+		{name: "Nil", arg: nil, d: time.Hour, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := HasGapLargerThan(tc.arg, tc.d); got != tc.want {
+				t.Errorf("HasGapLargerThan(%v, %v) = %v, want %v", tc.arg, tc.d, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"reflect"
+)
+
+// Set represents a pre-indexed collection of values for repeated membership checks.
+// It is built once by NewSet and answers Contains and NotContains in constant time for
+// comparable values, which makes it suited for hot paths querying the same allowlist.
+// A Set is immutable after creation and safe for concurrent use.
+type Set struct {
+	values        map[any]struct{}
+	uncomparables []any
+}
+
+// NewSet creates a Set containing every element of the given slice or array, or every value of the given map.
+// Comparable elements are indexed in a hash map, while uncomparable elements (slices, maps, functions) are kept
+// aside and compared with reflect.DeepEqual, as the Contains function does.
+//
+// Parameters:
+//   - values: A slice, array or map (or a pointer to one of them) with the values to index.
+//
+// Returns:
+//   - *Set: The Set built from the given values.
+//
+// Panic:
+//   - If 'values' is nil, it panics with ErrNilValue.
+//   - If 'values' is not a slice, array or map, it panics with an ErrUnsupportedType indicating the unsupported type.
+//
+// Example:
+//
+//	allowlist := NewSet([]string{"admin", "operator"})
+//	fmt.Println(allowlist.Contains("admin"))  // true
+//	fmt.Println(allowlist.Contains("viewer")) // false
+func NewSet(values any) *Set {
+	if isNilCollection(values) {
+		panic(ErrNilValue)
+	}
+
+	reflectValue := reflect.ValueOf(values)
+	if reflectValue.Kind() == reflect.Ptr || reflectValue.Kind() == reflect.Interface {
+		return NewSet(reflectValue.Elem().Interface())
+	}
+
+	set := &Set{values: map[any]struct{}{}}
+	switch reflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < reflectValue.Len(); i++ {
+			set.add(reflectValue.Index(i))
+		}
+	case reflect.Map:
+		iter := reflectValue.MapRange()
+		for iter.Next() {
+			set.add(iter.Value())
+		}
+	default:
+		panic(ErrUnsupportedType{Kind: reflectValue.Kind()})
+	}
+	return set
+}
+
+// Contains checks if the provided value 'b' is part of the Set. If 'b' is a pointer or interface,
+// its underlying value is checked, just like the Contains function does.
+//
+// Parameters:
+//   - b: Any value to be checked for its existence in the Set.
+//
+// Returns:
+//   - bool: A boolean value indicating whether 'b' is contained within the Set.
+//
+// Example:
+//
+//	s := NewSet([]int{1, 2, 3})
+//	fmt.Println(s.Contains(2)) // true
+//	fmt.Println(s.Contains(4)) // false
+func (s *Set) Contains(b any) bool {
+	reflectValueB := reflect.ValueOf(b)
+	if (reflectValueB.Kind() == reflect.Ptr || reflectValueB.Kind() == reflect.Interface) && !reflectValueB.IsNil() {
+		return s.Contains(reflectValueB.Elem().Interface())
+	}
+
+	if !reflectValueB.IsValid() || reflectValueB.Comparable() {
+		_, found := s.values[b]
+		return found
+	}
+	for _, value := range s.uncomparables {
+		if reflect.DeepEqual(value, b) {
+			return true
+		}
+	}
+	return false
+}
+
+// NotContains checks if the provided value 'b' is not part of the Set. It returns the negation
+// of the Contains method.
+//
+// Parameters:
+//   - b: Any value to be checked for its absence in the Set.
+//
+// Returns:
+//   - bool: A boolean value indicating whether 'b' is not contained within the Set.
+//
+// Example:
+//
+//	s := NewSet([]int{1, 2, 3})
+//	fmt.Println(s.NotContains(4)) // true
+func (s *Set) NotContains(b any) bool {
+	return !s.Contains(b)
+}
+
+// Len returns the number of distinct comparable values plus the uncomparable values stored in the Set.
+func (s *Set) Len() int {
+	return len(s.values) + len(s.uncomparables)
+}
+
+// add stores the given element in the Set, indexing it when its type is comparable.
+func (s *Set) add(element reflect.Value) {
+	value := element.Interface()
+	if reflectValue := reflect.ValueOf(value); !reflectValue.IsValid() || reflectValue.Comparable() {
+		s.values[value] = struct{}{}
+	} else {
+		s.uncomparables = append(s.uncomparables, value)
+	}
+}
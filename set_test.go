@@ -0,0 +1,82 @@
+package checker
+
+import "testing"
+
+type setCase struct {
+	name  string
+	a     any
+	b     any
+	want  bool
+	panic bool
+}
+
+func TestNewSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  any
+		wantLen int
+		panic   bool
+	}{
+		{name: "Slice", values: []string{"a", "b", "a"}, wantLen: 2},
+		{name: "Array", values: [3]int{1, 2, 3}, wantLen: 3},
+		{name: "Map", values: map[string]int{"one": 1, "two": 2}, wantLen: 2},
+		{name: "Pointer", values: &[]int{1, 2}, wantLen: 2},
+		{name: "Uncomparable", values: []any{[]int{1}, map[string]int{}, 1}, wantLen: 3},
+		{name: "Empty", values: []string{}, wantLen: 0},
+		{name: "Nil", values: nil, panic: true},
+		{name: "NilSlice", values: ([]int)(nil), panic: true},
+		{name: "Unsupported", values: "text", panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := NewSet(tt.values).Len(); got != tt.wantLen {
+				t.Errorf("NewSet().Len() = %v, want = %v", got, tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestSetContains(t *testing.T) {
+	str := "b"
+	tests := []setCase{
+		{name: "StringFound", a: []string{"a", "b"}, b: "b", want: true},
+		{name: "StringNotFound", a: []string{"a", "b"}, b: "c", want: false},
+		{name: "StringPointer", a: []string{"a", "b"}, b: &str, want: true},
+		{name: "DifferentType", a: []int{1, 2}, b: int64(1), want: false},
+		{name: "MapValue", a: map[string]int{"one": 1}, b: 1, want: true},
+		{name: "MapKeyIsNotValue", a: map[string]int{"one": 1}, b: "one", want: false},
+		{name: "Struct", a: []struct{ X int }{{1}, {2}}, b: struct{ X int }{2}, want: true},
+		{name: "UncomparableFound", a: []any{[]int{1, 2}, 3}, b: []int{1, 2}, want: true},
+		{name: "UncomparableNotFound", a: []any{[]int{1, 2}, 3}, b: []int{2, 1}, want: false},
+		{name: "InterfaceWithUncomparable", a: []any{struct{ X any }{[]int{1}}}, b: struct{ X any }{[]int{1}}, want: true},
+		{name: "NilElement", a: []any{nil, 1}, b: nil, want: true},
+		{name: "NilNotFound", a: []any{1}, b: nil, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := NewSet(tt.a)
+			if got := set.Contains(tt.b); got != tt.want {
+				t.Errorf("Set.Contains() = %v, want = %v", got, tt.want)
+			}
+			if got := set.NotContains(tt.b); got == tt.want {
+				t.Errorf("Set.NotContains() = %v, want = %v", got, !tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkSetContains(b *testing.B) {
+	set := NewSet(buildStringAllowlist(100_000))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		set.Contains("value-99999")
+	}
+}
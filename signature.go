@@ -0,0 +1,216 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HashAlgo represents a custom type for the hash algorithms used to sign and verify payloads.
+type HashAlgo string
+
+const (
+	// HashAlgoSHA1 represents a constant of type HashAlgo that indicates the SHA-1 algorithm.
+	HashAlgoSHA1 HashAlgo = "SHA1"
+	// HashAlgoSHA256 represents a constant of type HashAlgo that indicates the SHA-256 algorithm.
+	HashAlgoSHA256 HashAlgo = "SHA256"
+	// HashAlgoSHA512 represents a constant of type HashAlgo that indicates the SHA-512 algorithm.
+	HashAlgoSHA512 HashAlgo = "SHA512"
+)
+
+// IsEnumValid returns whether the hash algorithm is one of the HashAlgo constants.
+func (h HashAlgo) IsEnumValid() bool {
+	switch h {
+	case HashAlgoSHA1, HashAlgoSHA256, HashAlgoSHA512:
+		return true
+	}
+	return false
+}
+
+// IsValidHMACSignature checks if the signature is the HMAC of the payload with the given secret and hash
+// algorithm. The payload may be a []byte or any value accepted by toString, and it must be the raw body exactly
+// as received. The signature may be hex-encoded, in any case, or base64-encoded, with the standard or URL-safe
+// alphabet and with or without padding. The comparison runs in constant time.
+//
+// Parameters:
+//   - payload: Any value to be converted into bytes and signed.
+//   - signature: Any value to be converted into a string and decoded as the expected HMAC.
+//   - secret: The shared secret key.
+//   - algo: The HashAlgo of the HMAC.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the signature is valid.
+//
+// Panic:
+//   - The function will panic if the secret is empty, as anyone can compute an HMAC without a key.
+//   - The function will panic if the algorithm is not one of the HashAlgo constants.
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	secret := []byte("secret")
+//	signature := "88aab3ede8d3adf94d26ab90d3bafd4a2083070c3bcce9c014ee04a443847c0b"
+//	fmt.Println(IsValidHMACSignature("hello", signature, secret, HashAlgoSHA256))  // true
+//	fmt.Println(IsValidHMACSignature("hello!", signature, secret, HashAlgoSHA256)) // false
+//	fmt.Println(IsValidHMACSignature("hello", signature, secret, HashAlgoSHA512))  // false
+func IsValidHMACSignature(payload any, signature any, secret []byte, algo HashAlgo) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsValidHMACSignature", time.Now(), &passed)
+	}
+	return isValidHMACSignature(payload, signature, secret, algo)
+}
+
+// isValidHMACSignature is the unobserved implementation of IsValidHMACSignature.
+func isValidHMACSignature(payload any, signature any, secret []byte, algo HashAlgo) bool {
+	checkHMACSecret(secret)
+	newHash := hashConstructor(algo)
+	mac := hmac.New(newHash, secret)
+	mac.Write(toBytes(payload))
+
+	expected, ok := decodeDigest(toString(signature), mac.Size())
+	return ok && hmac.Equal(mac.Sum(nil), expected)
+}
+
+// IsValidGitHubWebhookSignature checks if the value of the X-Hub-Signature-256 header sent by GitHub, such as
+// "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17", is the HMAC-SHA256 of the raw request
+// body with the webhook secret (see IsValidHMACSignature).
+//
+// Parameters:
+//   - payload: Any value to be converted into bytes, holding the raw request body.
+//   - header: Any value to be converted into a string, holding the X-Hub-Signature-256 header.
+//   - secret: The webhook secret.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the signature is valid.
+//
+// Panic:
+//   - The function will panic if the secret is empty, as anyone can compute an HMAC without a key.
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	secret := []byte("It's a Secret to Everybody")
+//	header := "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"
+//	fmt.Println(IsValidGitHubWebhookSignature("Hello, World!", header, secret))                  // true
+//	fmt.Println(IsValidGitHubWebhookSignature("Hello, World!", header[len("sha256="):], secret)) // false
+func IsValidGitHubWebhookSignature(payload any, header any, secret []byte) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsValidGitHubWebhookSignature", time.Now(), &passed)
+	}
+	checkHMACSecret(secret)
+	signature, found := strings.CutPrefix(strings.TrimSpace(toString(header)), "sha256=")
+	return found && len(signature) == 64 && isValidHMACSignature(payload, signature, secret, HashAlgoSHA256)
+}
+
+// IsValidStripeSignature checks if the value of the Stripe-Signature header, such as "t=1492774577,v1=7656fc28...",
+// holds a v1 signature that is the HMAC-SHA256 of the timestamp, a dot and the raw request body with the endpoint
+// secret, and if the timestamp is not further than the tolerance from the current time, to prevent replays. Any
+// of the v1 signatures may match, which happens while secrets are rolled.
+//
+// Parameters:
+//   - payload: Any value to be converted into bytes, holding the raw request body.
+//   - header: Any value to be converted into a string, holding the Stripe-Signature header.
+//   - secret: The endpoint secret, including the "whsec_" prefix.
+//   - tolerance: The maximum age of the timestamp; 0 disables the check.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the signature is valid and recent.
+//
+// Panic:
+//   - The function will panic if the secret is empty, as anyone can compute an HMAC without a key.
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	header := "t=1492774577,v1=7656fc2882a7ca0a651666b36bf2f2f22ee204f54f608f227498a2419f2890b2"
+//	fmt.Println(IsValidStripeSignature(`{"id":"evt_1"}`, header, []byte("whsec_test"), 0))             // true
+//	fmt.Println(IsValidStripeSignature(`{"id":"evt_1"}`, header, []byte("whsec_test"), 5*time.Minute)) // false
+//	fmt.Println(IsValidStripeSignature(`{"id":"evt_2"}`, header, []byte("whsec_test"), 0))             // false
+func IsValidStripeSignature(payload any, header any, secret []byte, tolerance time.Duration) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsValidStripeSignature", time.Now(), &passed)
+	}
+	checkHMACSecret(secret)
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(toString(header), ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return false
+	}
+	age := timeNow().Sub(time.Unix(seconds, 0))
+	if tolerance > 0 && (age > tolerance || age < -tolerance) {
+		return false
+	}
+
+	signedPayload := append([]byte(timestamp+"."), toBytes(payload)...)
+	for _, signature := range signatures {
+		if len(signature) == 64 && isValidHMACSignature(signedPayload, signature, secret, HashAlgoSHA256) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkHMACSecret panics if the secret is empty, which would make every signature forgeable.
+func checkHMACSecret(secret []byte) {
+	if len(secret) == 0 {
+		panic("Invalid HMAC secret: empty")
+	}
+}
+
+// hashConstructor returns the constructor of the hash of the given algorithm, panicking on unknown ones.
+func hashConstructor(algo HashAlgo) func() hash.Hash {
+	switch algo {
+	case HashAlgoSHA1:
+		return sha1.New
+	case HashAlgoSHA256:
+		return sha256.New
+	case HashAlgoSHA512:
+		return sha512.New
+	}
+	panic(fmt.Sprintf("Unsupported hash algorithm: %s", algo))
+}
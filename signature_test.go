@@ -0,0 +1,173 @@
+package checker
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestIsValidHMACSignature(t *testing.T) {
+	secret := []byte("secret")
+	signature := "88aab3ede8d3adf94d26ab90d3bafd4a2083070c3bcce9c014ee04a443847c0b"
+	testCases := []struct {
+		name      string
+		payload   any
+		signature any
+		secret    []byte
+		algo      HashAlgo
+		want      bool
+		panic     bool
+	}{
+		{name: "SHA256Hex", payload: "hello", signature: signature, secret: secret, algo: HashAlgoSHA256, want: true},
+		{name: "SHA256Base64", payload: []byte("hello"), signature: "iKqz7ejTrflNJquQ07r9SiCDBww7zOnAFO4EpEOEfAs=",
+			secret: secret, algo: HashAlgoSHA256, want: true},
+		{name: "SHA1", payload: "hello", signature: "5112055c05f944f85755efc5cd8970e194e9f45b", secret: secret,
+			algo: HashAlgoSHA1, want: true},
+		{name: "WrongPayload", payload: "hello!", signature: signature, secret: secret, algo: HashAlgoSHA256, want: false},
+		{name: "WrongSecret", payload: "hello", signature: signature,
+			secret: []byte("other"), algo: HashAlgoSHA256, want: false},
+		{name: "WrongAlgo", payload: "hello", signature: signature, secret: secret, algo: HashAlgoSHA512, want: false},
+		{name: "Malformed", payload: "hello", signature: "not a signature", secret: secret, algo: HashAlgoSHA256,
+			want: false},
+		{name: "UnsupportedAlgo", payload: "hello", signature: "", secret: secret, algo: HashAlgo("MD5"), panic: true},
+		{name: "NilPayload", payload: nil, signature: "", secret: secret, algo: HashAlgoSHA256, panic: true},
+		{name: "EmptySecret", payload: "hello", signature: signature, secret: []byte{}, algo: HashAlgoSHA256,
+			panic: true},
+		{name: "NilSecret", payload: "hello", signature: signature, secret: nil, algo: HashAlgoSHA256, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsValidHMACSignature(tc.payload, tc.signature, tc.secret, tc.algo); got != tc.want {
+				t.Errorf("IsValidHMACSignature(%v, %v) = %v, want %v", tc.payload, tc.signature, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsValidGitHubWebhookSignature(t *testing.T) {
+	secret := []byte("It's a Secret to Everybody")
+	signature := "757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"
+	testCases := []struct {
+		name    string
+		payload any
+		header  any
+		want    bool
+		panic   bool
+	}{
+		{name: "Valid", payload: "Hello, World!", header: "sha256=" + signature, want: true},
+		{name: "MissingPrefix", payload: "Hello, World!", header: signature, want: false},
+		{name: "SHA1Prefix", payload: "Hello, World!", header: "sha1=" + signature, want: false},
+		{name: "Tampered", payload: "Hello, World?", header: "sha256=" + signature, want: false},
+		{name: "Empty", payload: "Hello, World!", header: "", want: false},
+		{name: "Nil", payload: "Hello, World!", header: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsValidGitHubWebhookSignature(tc.payload, tc.header, secret); got != tc.want {
+				t.Errorf("IsValidGitHubWebhookSignature(%v, %v) = %v, want %v", tc.payload, tc.header, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWebhookSignatureEmptySecret(t *testing.T) {
+	testCases := []struct {
+		name string
+		fn   func()
+	}{
+		{name: "GitHub", fn: func() { IsValidGitHubWebhookSignature("Hello, World!", "sha256=abc", nil) }},
+		{name: "GitHubMalformedHeader", fn: func() { IsValidGitHubWebhookSignature("Hello, World!", "", []byte{}) }},
+		{name: "Stripe", fn: func() { IsValidStripeSignature(`{"id":"evt_1"}`, "t=1,v1=abc", nil, 0) }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("The code did not panic")
+				}
+			}()
+			tc.fn()
+		})
+	}
+}
+
+func TestIsValidStripeSignature(t *testing.T) {
+	secret := []byte("whsec_test")
+	payload := `{"id":"evt_1"}`
+	sign := func(timestamp int64) string {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "." + payload))
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+	now := time.Now().Unix()
+	old := now - 3600
+	recent := "t=" + strconv.FormatInt(now, 10) + ",v1=" + sign(now)
+	testCases := []struct {
+		name      string
+		payload   any
+		header    any
+		tolerance time.Duration
+		want      bool
+		panic     bool
+	}{
+		{name: "Recent", payload: payload, header: recent, tolerance: 5 * time.Minute, want: true},
+		{name: "RolledSecrets", payload: payload, tolerance: 5 * time.Minute, want: true,
+			header: "t=" + strconv.FormatInt(now, 10) + ",v1=" + sign(0) + ",v1=" + sign(now) + ",v0=abc"},
+		{name: "Old", payload: payload, header: "t=" + strconv.FormatInt(old, 10) + ",v1=" + sign(old),
+			tolerance: 5 * time.Minute, want: false},
+		{name: "OldWithoutTolerance", payload: payload, header: "t=" + strconv.FormatInt(old, 10) + ",v1=" + sign(old),
+			tolerance: 0, want: true},
+		{name: "Example", payload: payload, tolerance: 0, want: true,
+			header: "t=1492774577,v1=7656fc2882a7ca0a651666b36bf2f2f22ee204f54f608f227498a2419f2890b2"},
+		{name: "Tampered", payload: `{"id":"evt_2"}`, header: recent, tolerance: 5 * time.Minute, want: false},
+		{name: "OnlyV0", payload: payload, header: "t=" + strconv.FormatInt(now, 10) + ",v0=" + sign(now),
+			tolerance: 5 * time.Minute, want: false},
+		{name: "MissingTimestamp", payload: payload, header: "v1=" + sign(now), tolerance: 5 * time.Minute,
+			want: false},
+		{name: "Nil", payload: payload, header: nil, tolerance: 5 * time.Minute, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsValidStripeSignature(tc.payload, tc.header, secret, tc.tolerance); got != tc.want {
+				t.Errorf("IsValidStripeSignature(%v, %v) = %v, want %v", tc.payload, tc.header, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHashAlgoIsEnumValid(t *testing.T) {
+	if !IsEnumValid(HashAlgoSHA256) {
+		t.Errorf("IsEnumValid(%v) = false, want true", HashAlgoSHA256)
+	}
+	if IsEnumValid(HashAlgo("MD5")) {
+		t.Errorf("IsEnumValid(MD5) = true, want false")
+	}
+}
@@ -22,6 +22,8 @@
 
 package checker
 
+import "time"
+
 // IsGreaterThan compares two values of any type and returns whether the first value is greater than the second value.
 // If the supplied values are not of a numeric type, a panic is thrown.
 //
@@ -35,7 +37,15 @@ package checker
 //	fmt.Println(IsGreaterThan(5.5, 5.4))    // Outputs: true
 //
 // Returns true if the first value is greater than the second value, false otherwise.
-func IsGreaterThan(a, b any) bool {
+func IsGreaterThan(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsGreaterThan", time.Now(), &passed)
+	}
+	return isGreaterThan(a, b)
+}
+
+// isGreaterThan is the unobserved implementation of IsGreaterThan.
+func isGreaterThan(a, b any) bool {
 	return toFloat(a) > toFloat(b)
 }
 
@@ -53,7 +63,15 @@ func IsGreaterThan(a, b any) bool {
 //	fmt.Println(IsGreaterThanOrEqual(5.5, 5.4))    // Outputs: true
 //
 // Returns true if the first value is greater than or equal to the second value, false otherwise.
-func IsGreaterThanOrEqual(a, b any) bool {
+func IsGreaterThanOrEqual(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsGreaterThanOrEqual", time.Now(), &passed)
+	}
+	return isGreaterThanOrEqual(a, b)
+}
+
+// isGreaterThanOrEqual is the unobserved implementation of IsGreaterThanOrEqual.
+func isGreaterThanOrEqual(a, b any) bool {
 	return toFloat(a) >= toFloat(b)
 }
 
@@ -70,7 +88,15 @@ func IsGreaterThanOrEqual(a, b any) bool {
 //	fmt.Println(IsLessThan(5.4, 5.5))    // Outputs: true
 //
 // Returns true if the first value is less than the second value, false otherwise.
-func IsLessThan(a, b any) bool {
+func IsLessThan(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsLessThan", time.Now(), &passed)
+	}
+	return isLessThan(a, b)
+}
+
+// isLessThan is the unobserved implementation of IsLessThan.
+func isLessThan(a, b any) bool {
 	return toFloat(a) < toFloat(b)
 }
 
@@ -88,7 +114,15 @@ func IsLessThan(a, b any) bool {
 //
 // Returns true if the first value is less than or equal to the second value, false otherwise.
 // The function is composed of the IsLessThan and the Equals functions.
-func IsLessThanOrEqual(a, b any) bool {
+func IsLessThanOrEqual(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsLessThanOrEqual", time.Now(), &passed)
+	}
+	return isLessThanOrEqual(a, b)
+}
+
+// isLessThanOrEqual is the unobserved implementation of IsLessThanOrEqual.
+func isLessThanOrEqual(a, b any) bool {
 	return toFloat(a) <= toFloat(b)
 }
 
@@ -103,7 +137,15 @@ func IsLessThanOrEqual(a, b any) bool {
 //
 // Returns true if the length or size of a and b are equal, false otherwise.
 // Panic occurs if a and b are of unsupported types or if the channel, interface, or pointer is nil.
-func IsLengthEquals(a, b any) bool {
+func IsLengthEquals(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsLengthEquals", time.Now(), &passed)
+	}
+	return isLengthEquals(a, b)
+}
+
+// isLengthEquals is the unobserved implementation of IsLengthEquals.
+func isLengthEquals(a, b any) bool {
 	return toLength(a) == toLength(b)
 }
 
@@ -131,8 +173,11 @@ func IsLengthEquals(a, b any) bool {
 //	var x int = 3
 //	var y int = 4
 //	fmt.Println(IsLengthNotEquals(x,y)) // true
-func IsLengthNotEquals(a, b any) bool {
-	return !IsLengthEquals(a, b)
+func IsLengthNotEquals(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsLengthNotEquals", time.Now(), &passed)
+	}
+	return !isLengthEquals(a, b)
 }
 
 // IsLengthGreaterThan compares the length or size of two values of any type and returns whether the first value is
@@ -150,7 +195,15 @@ func IsLengthNotEquals(a, b any) bool {
 //
 // Returns true if the length or size of the first value is greater than the length or size of the second value,
 // false otherwise.
-func IsLengthGreaterThan(a, b any) bool {
+func IsLengthGreaterThan(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsLengthGreaterThan", time.Now(), &passed)
+	}
+	return isLengthGreaterThan(a, b)
+}
+
+// isLengthGreaterThan is the unobserved implementation of IsLengthGreaterThan.
+func isLengthGreaterThan(a, b any) bool {
 	return toLength(a) > toLength(b)
 }
 
@@ -170,8 +223,16 @@ func IsLengthGreaterThan(a, b any) bool {
 //
 // Returns true if the length or size of the first value is greater than or equal to the length or size of the second value,
 // false otherwise.
-func IsLengthGreaterThanOrEqual(a, b any) bool {
-	return IsLengthGreaterThan(a, b) || IsLengthEquals(a, b)
+func IsLengthGreaterThanOrEqual(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsLengthGreaterThanOrEqual", time.Now(), &passed)
+	}
+	return isLengthGreaterThanOrEqual(a, b)
+}
+
+// isLengthGreaterThanOrEqual is the unobserved implementation of IsLengthGreaterThanOrEqual.
+func isLengthGreaterThanOrEqual(a, b any) bool {
+	return isLengthGreaterThan(a, b) || isLengthEquals(a, b)
 }
 
 // IsLengthLessThan compares the length or size of two parameters, a and b, and returns whether the length of an is
@@ -188,7 +249,15 @@ func IsLengthGreaterThanOrEqual(a, b any) bool {
 //
 // Returns true if the length or size of an is less than the length of b, false otherwise.
 // Panic occurs if a and b are of unsupported types or if the channel, interface, or pointer is nil.
-func IsLengthLessThan(a, b any) bool {
+func IsLengthLessThan(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsLengthLessThan", time.Now(), &passed)
+	}
+	return isLengthLessThan(a, b)
+}
+
+// isLengthLessThan is the unobserved implementation of IsLengthLessThan.
+func isLengthLessThan(a, b any) bool {
 	return toLength(a) < toLength(b)
 }
 
@@ -206,6 +275,14 @@ func IsLengthLessThan(a, b any) bool {
 //
 // Returns true if the length or size of an is less than or equal to the length of b, false otherwise.
 // Panic occurs if a and b are of unsupported types or if the channel, interface, or pointer is nil.
-func IsLengthLessThanOrEqual(a, b any) bool {
-	return IsLengthLessThan(a, b) || IsLengthEquals(a, b)
+func IsLengthLessThanOrEqual(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsLengthLessThanOrEqual", time.Now(), &passed)
+	}
+	return isLengthLessThanOrEqual(a, b)
+}
+
+// isLengthLessThanOrEqual is the unobserved implementation of IsLengthLessThanOrEqual.
+func isLengthLessThanOrEqual(a, b any) bool {
+	return isLengthLessThan(a, b) || isLengthEquals(a, b)
 }
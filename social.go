@@ -0,0 +1,161 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"time"
+	"unicode/utf8"
+)
+
+// IsEmojiShortcode checks if a given value is an emoji shortcode, as used by Slack, GitHub and Discord, that is, a
+// name of 1 to 64 lowercase letters, digits, underscores, plus or minus signs enclosed in colons, such as
+// ":thumbsup:", ":+1:" or ":man-technologist:". Whether the emoji exists is not checked.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an emoji shortcode.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an emoji shortcode.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsEmojiShortcode(":thumbsup:"))  // true
+//	fmt.Println(IsEmojiShortcode(":+1:"))        // true
+//	fmt.Println(IsEmojiShortcode("thumbsup"))    // false
+//	fmt.Println(IsEmojiShortcode(":thumbs up:")) // false
+func IsEmojiShortcode(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsEmojiShortcode", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^:[a-z0-9_+-]{1,64}:$`)
+	return regex.MatchString(toString(a))
+}
+
+// ContainsMention checks if a given value contains at least one mention, such as "@user" or "@john.doe", that is,
+// an "@" not preceded by a letter, digit or any of "_.@" (so e-mail addresses are not mentions) followed by a
+// handle of up to 40 letters, digits, underscores, dots or hyphens that neither starts nor ends with a dot or a
+// hyphen.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and searched for mentions.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value contains a mention.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(ContainsMention("Thanks @john.doe!"))         // true
+//	fmt.Println(ContainsMention("@team please review"))       // true
+//	fmt.Println(ContainsMention("Write to john@example.com")) // false
+//	fmt.Println(ContainsMention("No mentions here"))          // false
+func ContainsMention(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ContainsMention", time.Now(), &passed)
+	}
+	return len(extractMentions(toString(a))) > 0
+}
+
+// IsHashtag checks if a given value is a hashtag, such as "#topic" or "#Go2024", that is, a "#" followed by up
+// to 100 letters, digits or underscores, at least one of them a letter, in any script.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a hashtag.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a hashtag.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsHashtag("#topic"))     // true
+//	fmt.Println(IsHashtag("#Promoção"))  // true
+//	fmt.Println(IsHashtag("#2024"))      // false
+//	fmt.Println(IsHashtag("#two words")) // false
+func IsHashtag(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsHashtag", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^#[\p{L}\p{M}\p{N}_]*\p{L}[\p{L}\p{M}\p{N}_]*$`)
+	return utf8.RuneCountInString(s) <= 101 && regex.MatchString(s)
+}
+
+// ExtractAndCheckMentions extracts the mentions of a given value (see ContainsMention) and checks if the handle
+// of every one of them, without the "@", passes the given rule, such as a lookup of existing users. A value
+// without mentions passes.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and searched for mentions.
+//   - rule: The Rule every mentioned handle must pass.
+//
+// Returns:
+//   - bool: A boolean value indicating whether every mentioned handle passes the rule.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	members := Rule(func(a any) bool { return Contains([]string{"ana", "bob"}, a) })
+//	fmt.Println(ExtractAndCheckMentions("@ana and @bob, please review", members)) // true
+//	fmt.Println(ExtractAndCheckMentions("@ana and @eve, please review", members)) // false
+//	fmt.Println(ExtractAndCheckMentions("No mentions here", members))             // true
+func ExtractAndCheckMentions(a any, rule Rule) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ExtractAndCheckMentions", time.Now(), &passed)
+	}
+	for _, mention := range extractMentions(toString(a)) {
+		if !rule(mention) {
+			return false
+		}
+	}
+	return true
+}
+
+// extractMentions returns the handles of the mentions found in the string, without the "@".
+func extractMentions(s string) []string {
+	regex := regexp.MustCompile(`(?:^|[^A-Za-z0-9_.@])@([A-Za-z0-9_](?:[A-Za-z0-9_.-]{0,38}[A-Za-z0-9_])?)`)
+
+	var mentions []string
+	for _, match := range regex.FindAllStringSubmatch(s, -1) {
+		mentions = append(mentions, match[1])
+	}
+	return mentions
+}
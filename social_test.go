@@ -0,0 +1,132 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsEmojiShortcode(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: ":thumbsup:", want: true},
+		{name: "PlusOne", arg: ":+1:", want: true},
+		{name: "Hyphen", arg: ":man-technologist:", want: true},
+		{name: "Underscore", arg: ":white_check_mark:", want: true},
+		{name: "NoColons", arg: "thumbsup", want: false},
+		{name: "Space", arg: ":thumbs up:", want: false},
+		{name: "Uppercase", arg: ":ThumbsUp:", want: false},
+		{name: "EmptyName", arg: "::", want: false},
+		{name: "TooLong", arg: ":" + strings.Repeat("a", 65) + ":", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsEmojiShortcode(tc.arg); got != tc.want {
+				t.Errorf("IsEmojiShortcode(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContainsMention(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: "Thanks @john.doe!", want: true},
+		{name: "Start", arg: "@team please review", want: true},
+		{name: "Parenthesized", arg: "(cc @ana)", want: true},
+		{name: "Email", arg: "Write to john@example.com", want: false},
+		{name: "DoubleAt", arg: "@@", want: false},
+		{name: "OnlyAt", arg: "@ alone", want: false},
+		{name: "None", arg: "No mentions here", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := ContainsMention(tc.arg); got != tc.want {
+				t.Errorf("ContainsMention(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsHashtag(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: "#topic", want: true},
+		{name: "Digits", arg: "#Go2024", want: true},
+		{name: "Accents", arg: "#Promoção", want: true},
+		{name: "Underscore", arg: "#open_source", want: true},
+		{name: "OnlyDigits", arg: "#2024", want: false},
+		{name: "Space", arg: "#two words", want: false},
+		{name: "NoHash", arg: "topic", want: false},
+		{name: "OnlyHash", arg: "#", want: false},
+		{name: "Punctuation", arg: "#topic!", want: false},
+		{name: "TooLong", arg: "#" + strings.Repeat("a", 101), want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsHashtag(tc.arg); got != tc.want {
+				t.Errorf("IsHashtag(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExtractAndCheckMentions(t *testing.T) {
+	members := Rule(func(a any) bool { return Contains([]string{"ana", "bob", "john.doe"}, a) })
+	testCases := []struct {
+		name  string
+		arg   any
+		rule  Rule
+		want  bool
+		panic bool
+	}{
+		{name: "AllMembers", arg: "@ana and @bob, please review", rule: members, want: true},
+		{name: "TrailingDot", arg: "Thanks @john.doe.", rule: members, want: true},
+		{name: "UnknownMember", arg: "@ana and @eve, please review", rule: members, want: false},
+		{name: "EmailIgnored", arg: "@ana, mail eve@example.com", rule: members, want: true},
+		{name: "NoMentions", arg: "No mentions here", rule: members, want: true},
+		{name: "Nil", arg: nil, rule: members, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := ExtractAndCheckMentions(tc.arg, tc.rule); got != tc.want {
+				t.Errorf("ExtractAndCheckMentions(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// sqlDDLKeywords are the keywords that start data definition statements.
+var sqlDDLKeywords = []string{"CREATE", "ALTER", "DROP", "TRUNCATE", "RENAME"}
+
+// sqlWriteKeywords are the keywords that modify data, call procedures or change permissions.
+var sqlWriteKeywords = []string{"INSERT", "UPDATE", "DELETE", "MERGE", "UPSERT", "INTO", "CALL", "EXEC", "EXECUTE",
+	"GRANT", "REVOKE", "LOCK", "COPY"}
+
+// IsSingleSQLStatement checks if a given value holds exactly one SQL statement, ignoring a trailing semicolon.
+// Semicolons inside string literals, quoted identifiers, dollar-quoted strings and comments are not treated as
+// separators, and values with an unterminated literal or comment, or whose literals end in different places
+// depending on whether a backslash escapes a quote, are rejected. The check is a heuristic that does not parse the
+// statement.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a single SQL statement.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value holds exactly one SQL statement.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsSingleSQLStatement("SELECT * FROM users WHERE name = 'a;b';")) // true
+//	fmt.Println(IsSingleSQLStatement("SELECT 1; DROP TABLE users"))              // false
+//	fmt.Println(IsSingleSQLStatement("SELECT 'unterminated"))                    // false
+func IsSingleSQLStatement(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSingleSQLStatement", time.Now(), &passed)
+	}
+	statements, ok := splitSQLStatements(toString(a))
+	return ok && len(statements) == 1
+}
+
+// IsSelectOnlyStatement checks if a given value is a single read-only SQL query, that is, a statement starting
+// with SELECT or WITH that does not contain keywords that modify data or the schema, such as INSERT, UPDATE,
+// DELETE, INTO, CREATE or DROP, outside string literals, quoted identifiers and comments, and that can be split
+// unambiguously, as IsSingleSQLStatement requires. The check is a heuristic meant for user-supplied report
+// queries, not a replacement for a read-only database role.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a read-only SQL query.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a single read-only SQL query.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsSelectOnlyStatement("SELECT id, name FROM users WHERE note = 'delete me'"))       // true
+//	fmt.Println(IsSelectOnlyStatement("WITH t AS (SELECT 1) SELECT * FROM t"))                      // true
+//	fmt.Println(IsSelectOnlyStatement("DELETE FROM users"))                                         // false
+//	fmt.Println(IsSelectOnlyStatement("WITH d AS (DELETE FROM users RETURNING *) SELECT * FROM d")) // false
+func IsSelectOnlyStatement(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSelectOnlyStatement", time.Now(), &passed)
+	}
+	statements, ok := splitSQLStatements(toString(a))
+	if !ok || len(statements) != 1 {
+		return false
+	}
+
+	statement := strings.ToUpper(strings.TrimLeft(statements[0], "( \t\r\n"))
+	regex := regexp.MustCompile(`^(SELECT|WITH)\b`)
+	return regex.MatchString(statement) && !containsSQLKeyword(statement, sqlWriteKeywords) &&
+		!containsSQLKeyword(statement, sqlDDLKeywords)
+}
+
+// ContainsDDL checks if a given value contains a SQL data definition keyword (CREATE, ALTER, DROP, TRUNCATE or
+// RENAME) outside string literals, quoted identifiers and comments, in any of its statements. Values with an
+// unterminated literal or comment, or whose literals end in different places depending on whether a backslash
+// escapes a quote, are considered to contain DDL, as their content cannot be trusted. The content of MySQL and
+// MariaDB executable comments, such as "/*! DROP TABLE users */", is checked, as it is run.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked for DDL.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value contains DDL.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(ContainsDDL("SELECT 1; DROP TABLE users"))           // true
+//	fmt.Println(ContainsDDL("alter table users add column age int")) // true
+//	fmt.Println(ContainsDDL("SELECT 'DROP TABLE users' AS message")) // false
+//	fmt.Println(ContainsDDL("SELECT created_at FROM users -- drop")) // false
+func ContainsDDL(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ContainsDDL", time.Now(), &passed)
+	}
+	statements, ok := splitSQLStatements(toString(a))
+	if !ok {
+		return true
+	}
+	for _, statement := range statements {
+		if containsSQLKeyword(strings.ToUpper(statement), sqlDDLKeywords) {
+			return true
+		}
+	}
+	return false
+}
+
+// sqlDollarQuote matches the opening tag of a PostgreSQL dollar-quoted string, such as "$$" or "$body$".
+var sqlDollarQuote = regexp.MustCompile(`^\$([A-Za-z_][A-Za-z0-9_]*)?\$`)
+
+// splitSQLStatements removes comments and replaces string literals and quoted identifiers with a placeholder,
+// then splits the result on semicolons, dropping empty statements. As dialects disagree on whether a backslash
+// escapes a quote, the value is sanitized both ways and it fails unless the two agree, so that a backslash before
+// a quote can not hide a statement from either reading. It also fails on unterminated literals and comments.
+func splitSQLStatements(s string) ([]string, bool) {
+	standard, ok := sanitizeSQL(s, false)
+	if !ok {
+		return nil, false
+	}
+	escaped, ok := sanitizeSQL(s, true)
+	if !ok || escaped != standard {
+		return nil, false
+	}
+
+	var statements []string
+	for _, statement := range strings.Split(standard, ";") {
+		if strings.TrimSpace(statement) != "" {
+			statements = append(statements, statement)
+		}
+	}
+	return statements, true
+}
+
+// sqlExecutableComment matches the opening of a MySQL or MariaDB executable comment, such as "/*!", "/*!50000"
+// or "/*M!100100", whose content is run as part of the statement.
+var sqlExecutableComment = regexp.MustCompile(`^/\*M?![0-9]*`)
+
+// sanitizeSQL removes the comments of a SQL value and replaces its string literals, quoted identifiers and
+// dollar-quoted strings with a placeholder. A quote is escaped by doubling it, and also by a backslash when
+// backslash is true or the literal has the PostgreSQL E prefix, as in "E'it\'s'". The content of MySQL and
+// MariaDB executable comments is kept, as it is run, and only their markers are removed. It fails on
+// unterminated literals and comments, and on comments opened inside an executable comment.
+func sanitizeSQL(s string, backslash bool) (string, bool) {
+	var sanitized strings.Builder
+	executable := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case executable && strings.HasPrefix(s[i:], "*/"):
+			executable = false
+			i++
+			sanitized.WriteByte(' ')
+		case strings.HasPrefix(s[i:], "/*") && executable:
+			return "", false
+		case sqlExecutableComment.MatchString(s[i:]):
+			executable = true
+			i += len(sqlExecutableComment.FindString(s[i:])) - 1
+			sanitized.WriteByte(' ')
+		case strings.HasPrefix(s[i:], "--"):
+			end := strings.IndexByte(s[i:], '\n')
+			if end < 0 {
+				i = len(s)
+			} else {
+				i += end
+			}
+			sanitized.WriteByte(' ')
+		case strings.HasPrefix(s[i:], "/*"):
+			end := strings.Index(s[i+2:], "*/")
+			if end < 0 {
+				return "", false
+			}
+			i += end + 3
+			sanitized.WriteByte(' ')
+		case s[i] == '$' && (i == 0 || !isSQLIdentifierByte(s[i-1])) && sqlDollarQuote.MatchString(s[i:]):
+			tag := sqlDollarQuote.FindString(s[i:])
+			end := strings.Index(s[i+len(tag):], tag)
+			if end < 0 {
+				return "", false
+			}
+			i += len(tag) + end + len(tag) - 1
+			sanitized.WriteString(" ? ")
+		case s[i] == '\'' || s[i] == '"' || s[i] == '`':
+			quote := s[i]
+			escapes := quote != '`' && (backslash || quote == '\'' && i > 0 && (s[i-1] == 'E' || s[i-1] == 'e') &&
+				(i == 1 || !isSQLIdentifierByte(s[i-2])))
+			closed := false
+			for i++; i < len(s); i++ {
+				if escapes && s[i] == '\\' {
+					i++
+				} else if s[i] == quote {
+					if i+1 < len(s) && s[i+1] == quote {
+						i++
+						continue
+					}
+					closed = true
+					break
+				}
+			}
+			if !closed {
+				return "", false
+			}
+			sanitized.WriteString(" ? ")
+		default:
+			sanitized.WriteByte(s[i])
+		}
+	}
+	if executable {
+		return "", false
+	}
+	return sanitized.String(), true
+}
+
+// isSQLIdentifierByte checks whether the byte can be part of an unquoted SQL identifier.
+func isSQLIdentifierByte(c byte) bool {
+	return c == '_' || c == '$' || c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z'
+}
+
+// containsSQLKeyword checks whether the upper-cased statement contains any of the keywords as a whole word.
+func containsSQLKeyword(statement string, keywords []string) bool {
+	regex := regexp.MustCompile(`\b(` + strings.Join(keywords, "|") + `)\b`)
+	return regex.MatchString(statement)
+}
@@ -0,0 +1,124 @@
+package checker
+
+import "testing"
+
+func TestIsSingleSQLStatement(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Simple", arg: "SELECT 1", want: true},
+		{name: "TrailingSemicolon", arg: "SELECT * FROM users WHERE name = 'a;b';", want: true},
+		{name: "SemicolonInComment", arg: "SELECT 1 -- ; DROP TABLE users", want: true},
+		{name: "SemicolonInBlockComment", arg: "SELECT /* a; b */ 1", want: true},
+		{name: "EscapedQuote", arg: "SELECT 'it''s; fine'", want: true},
+		{name: "BackslashQuote", arg: `SELECT 'a\'' ; DELETE FROM users; -- '`, want: false},
+		{name: "TrailingBackslash", arg: `SELECT 'C:\'`, want: false},
+		{name: "Backslash", arg: `SELECT 'a\nb; c'`, want: true},
+		{name: "EscapeString", arg: `SELECT E'it\'s; fine'`, want: true},
+		{name: "EscapeStringBreakout", arg: `SELECT E'\'' ; DROP TABLE users; -- '`, want: false},
+		{name: "DollarQuoted", arg: "SELECT $$a; b$$", want: true},
+		{name: "TaggedDollarQuoted", arg: "SELECT $fn$ a $$; b $fn$", want: true},
+		{name: "UnterminatedDollarQuote", arg: "SELECT $x$ a; b", want: false},
+		{name: "Placeholder", arg: "SELECT * FROM users WHERE id = $1; SELECT $2", want: false},
+		{name: "DollarInIdentifier", arg: "SELECT a$b$c FROM t", want: true},
+		{name: "Multiple", arg: "SELECT 1; DROP TABLE users", want: false},
+		{name: "UnterminatedString", arg: "SELECT 'unterminated", want: false},
+		{name: "UnterminatedComment", arg: "SELECT 1 /* ; DROP", want: false},
+		{name: "ExecutableComment", arg: "SELECT 1 /*!50000 ; DROP TABLE users */", want: false},
+		{name: "MariaDBExecutableComment", arg: "SELECT 1 /*M!100100 ; DROP TABLE users */", want: false},
+		{name: "UnterminatedExecutableComment", arg: "SELECT 1 /*! FROM t", want: false},
+		{name: "CommentInExecutableComment", arg: "SELECT 1 /*! /* a */ ; DROP TABLE users */", want: false},
+		{name: "OnlySemicolons", arg: ";;", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsSingleSQLStatement(tc.arg); got != tc.want {
+				t.Errorf("IsSingleSQLStatement(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsSelectOnlyStatement(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Select", arg: "SELECT id, name FROM users WHERE note = 'delete me'", want: true},
+		{name: "Lowercase", arg: "select count(*) from orders", want: true},
+		{name: "With", arg: "WITH t AS (SELECT 1) SELECT * FROM t", want: true},
+		{name: "Parenthesized", arg: "(SELECT 1) UNION (SELECT 2)", want: true},
+		{name: "QuotedIdentifier", arg: `SELECT "update" FROM logs`, want: true},
+		{name: "BackslashQuote", arg: `SELECT 'a\'' ; DELETE FROM users; -- '`, want: false},
+		{name: "DollarQuoted", arg: "SELECT $$DELETE FROM users$$", want: true},
+		{name: "Delete", arg: "DELETE FROM users", want: false},
+		{name: "DataModifyingCTE", arg: "WITH d AS (DELETE FROM users RETURNING *) SELECT * FROM d", want: false},
+		{name: "SelectInto", arg: "SELECT * INTO backup FROM users", want: false},
+		{name: "ForUpdate", arg: "SELECT * FROM users FOR UPDATE", want: false},
+		{name: "Stacked", arg: "SELECT 1; SELECT 2", want: false},
+		{name: "ExecutableComment", arg: "SELECT * FROM users /*! INTO OUTFILE '/tmp/x' */", want: false},
+		{name: "VersionedExecutableComment", arg: "SELECT /*!40001 SQL_NO_CACHE */ * FROM users", want: true},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsSelectOnlyStatement(tc.arg); got != tc.want {
+				t.Errorf("IsSelectOnlyStatement(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContainsDDL(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Drop", arg: "SELECT 1; DROP TABLE users", want: true},
+		{name: "Lowercase", arg: "alter table users add column age int", want: true},
+		{name: "Truncate", arg: "TRUNCATE orders", want: true},
+		{name: "Unterminated", arg: "SELECT 'x", want: true},
+		{name: "BackslashQuote", arg: `SELECT 'a\'' ; DROP TABLE users; -- '`, want: true},
+		{name: "EscapeStringBreakout", arg: `SELECT E'\'' ; DROP TABLE users; -- '`, want: true},
+		{name: "EscapeString", arg: `SELECT E'\' DROP TABLE users'`, want: false},
+		{name: "DollarQuoted", arg: "SELECT $body$ DROP TABLE users $body$", want: false},
+		{name: "DollarQuotedBreakout", arg: "SELECT $a$ x $a$; DROP TABLE users", want: true},
+		{name: "InString", arg: "SELECT 'DROP TABLE users' AS message", want: false},
+		{name: "InComment", arg: "SELECT created_at FROM users -- drop", want: false},
+		{name: "ExecutableComment", arg: "/*! DROP TABLE users */", want: true},
+		{name: "MariaDBExecutableComment", arg: "SELECT 1; /*M!100100 DROP TABLE users */", want: true},
+		{name: "OptimizerHint", arg: "SELECT /*+ DROP */ 1", want: false},
+		{name: "Select", arg: "SELECT * FROM users", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := ContainsDDL(tc.arg); got != tc.want {
+				t.Errorf("ContainsDDL(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
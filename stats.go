@@ -0,0 +1,154 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// IsWithinStdDevs checks if a given value is within k sample standard deviations of the mean of a series, such
+// as an incoming metric compared against its historical window. The value and the elements of the series are
+// converted with toFloat, so numbers and numeric strings can be mixed. A value or an element that cannot be
+// converted, or that is NaN, such as "abc" in an incoming metric, fails the check instead of panicking. A series
+// with fewer than 2 elements has no standard deviation, so the check fails.
+//
+// Parameters:
+//   - value: Any value to be converted into a float and compared with the series.
+//   - series: A slice, array or map (or a pointer to one of them) with the historical values.
+//   - k: The number of standard deviations the value may be away from the mean.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is within k standard deviations of the mean.
+//
+// Panic:
+//   - If the series is nil, it panics with ErrNilValue.
+//   - If the series is not a slice, array or map, it panics with an ErrUnsupportedType indicating the unsupported
+//     type.
+//
+// Example:
+//
+//	series := []float64{10, 12, 11, 13, 12, 11, 10, 12}
+//	fmt.Println(IsWithinStdDevs(12.5, series, 2))  // true
+//	fmt.Println(IsWithinStdDevs("30", series, 3))  // false
+//	fmt.Println(IsWithinStdDevs(12, []int{5}, 2))  // false
+//	fmt.Println(IsWithinStdDevs("abc", series, 3)) // false
+func IsWithinStdDevs(value any, series any, k float64) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsWithinStdDevs", time.Now(), &passed)
+	}
+	v, valid := seriesFloat(value)
+	values, ok := seriesToFloats(series)
+	if !valid || !ok || len(values) < 2 {
+		return false
+	}
+
+	mean := 0.0
+	for _, x := range values {
+		mean += x
+	}
+	mean /= float64(len(values))
+
+	variance := 0.0
+	for _, x := range values {
+		variance += (x - mean) * (x - mean)
+	}
+	stdDev := math.Sqrt(variance / float64(len(values)-1))
+	return math.Abs(v-mean) <= k*stdDev
+}
+
+// IsOutlierIQR checks if a given value is an outlier of a series by Tukey's fences, that is, below Q1 - 1.5 x IQR
+// or above Q3 + 1.5 x IQR, where Q1 and Q3 are the first and third quartiles of the series, computed with linear
+// interpolation, and IQR is their difference. The value and the elements of the series are converted with
+// toFloat. A value or an element that cannot be converted, or that is NaN, such as "abc", makes the value an
+// outlier instead of panicking, so that it is flagged rather than let through. Otherwise, a series with fewer than
+// 4 elements is too small to have meaningful quartiles, so no value is an outlier of it.
+//
+// Parameters:
+//   - value: Any value to be converted into a float and compared with the series.
+//   - series: A slice, array or map (or a pointer to one of them) with the historical values.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an outlier of the series.
+//
+// Panic:
+//   - If the series is nil, it panics with ErrNilValue.
+//   - If the series is not a slice, array or map, it panics with an ErrUnsupportedType indicating the unsupported
+//     type.
+//
+// Example:
+//
+//	series := []int{10, 12, 11, 13, 12, 11, 10, 12}
+//	fmt.Println(IsOutlierIQR(30, series))    // true
+//	fmt.Println(IsOutlierIQR("12", series))  // false
+//	fmt.Println(IsOutlierIQR(30, []int{1}))  // false
+//	fmt.Println(IsOutlierIQR("abc", series)) // true
+func IsOutlierIQR(value any, series any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsOutlierIQR", time.Now(), &passed)
+	}
+	v, valid := seriesFloat(value)
+	values, ok := seriesToFloats(series)
+	if !valid || !ok {
+		return true
+	} else if len(values) < 4 {
+		return false
+	}
+
+	sort.Float64s(values)
+	q1, q3 := quantile(values, 0.25), quantile(values, 0.75)
+	iqr := q3 - q1
+	return v < q1-1.5*iqr || v > q3+1.5*iqr
+}
+
+// seriesToFloats converts every element of the collection into a float, reporting false when one of them cannot
+// be converted or is NaN.
+func seriesToFloats(series any) ([]float64, bool) {
+	var values []float64
+	for _, element := range collectionValues(series) {
+		value, ok := seriesFloat(element)
+		if !ok {
+			return nil, false
+		}
+		values = append(values, value)
+	}
+	return values, true
+}
+
+// seriesFloat converts the value into a float, reporting false when it cannot be converted or is NaN, which
+// compares false with every number.
+func seriesFloat(a any) (float64, bool) {
+	f, err := toFloatWithErr(a)
+	return f, err == nil && !math.IsNaN(f)
+}
+
+// quantile returns the q quantile of the sorted values, interpolating linearly between the closest ranks.
+func quantile(sorted []float64, q float64) float64 {
+	position := q * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	if lower+1 >= len(sorted) {
+		return sorted[lower]
+	}
+	return sorted[lower] + (position-float64(lower))*(sorted[lower+1]-sorted[lower])
+}
@@ -0,0 +1,91 @@
+package checker
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIsWithinStdDevs(t *testing.T) {
+	series := []float64{10, 12, 11, 13, 12, 11, 10, 12}
+	testCases := []struct {
+		name   string
+		value  any
+		series any
+		k      float64
+		want   bool
+		panic  bool
+	}{
+		{name: "Within", value: 12.5, series: series, k: 2, want: true},
+		{name: "Mean", value: 11.375, series: series, k: 0, want: true},
+		{name: "Outside", value: "30", series: series, k: 3, want: false},
+		{name: "JustOutside", value: 13.5, series: series, k: 2, want: false},
+		{name: "MixedSeries", value: 2, series: []any{1, "2", 3.0}, k: 1, want: true},
+		{name: "Map", value: 3, series: map[string]int{"a": 1, "b": 2, "c": 3}, k: 1, want: true},
+		{name: "ConstantSeries", value: 5, series: []int{5, 5, 5}, k: 1, want: true},
+		{name: "ConstantSeriesDifferent", value: 6, series: []int{5, 5, 5}, k: 100, want: false},
+		{name: "SinglePoint", value: 12, series: []int{5}, k: 2, want: false},
+		{name: "InvalidElement", value: 1, series: []string{"1", "a"}, k: 1, want: false},
+		{name: "NaNElement", value: 1, series: []float64{1, math.NaN()}, k: 1, want: false},
+		{name: "InvalidValue", value: "a", series: series, k: 1, want: false},
+		{name: "NaNValue", value: "NaN", series: series, k: 1, want: false},
+		{name: "NilValue", value: nil, series: series, k: 1, want: false},
+		{name: "UnsupportedSeries", value: 1, series: 10, k: 1, panic: true},
+		{name: "NilSeries", value: 1, series: nil, k: 1, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsWithinStdDevs(tc.value, tc.series, tc.k); got != tc.want {
+				t.Errorf("IsWithinStdDevs(%v, %v, %v) = %v, want %v", tc.value, tc.series, tc.k, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsOutlierIQR(t *testing.T) {
+	series := []int{10, 12, 11, 13, 12, 11, 10, 12}
+	testCases := []struct {
+		name   string
+		value  any
+		series any
+		want   bool
+		panic  bool
+	}{
+		{name: "HighOutlier", value: 30, series: series, want: true},
+		{name: "LowOutlier", value: "5", series: series, want: true},
+		{name: "Typical", value: "12", series: series, want: false},
+		{name: "UpperFence", value: 13.875, series: series, want: false},
+		{name: "AboveUpperFence", value: 13.9, series: series, want: true},
+		{name: "Pointer", value: 30, series: &series, want: true},
+		{name: "SmallSeries", value: 30, series: []int{1, 2, 3}, want: false},
+		{name: "InvalidValue", value: "a", series: series, want: true},
+		{name: "NaNValue", value: math.NaN(), series: series, want: true},
+		{name: "NilValue", value: nil, series: series, want: true},
+		{name: "InvalidElement", value: 12, series: []string{"10", "12", "a", "13"}, want: true},
+		{name: "InvalidElementSmallSeries", value: 12, series: []any{"a"}, want: true},
+		{name: "UnsupportedSeries", value: 1, series: "1,2,3", panic: true},
+		{name: "NilSeries", value: 1, series: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsOutlierIQR(tc.value, tc.series); got != tc.want {
+				t.Errorf("IsOutlierIQR(%v, %v) = %v, want %v", tc.value, tc.series, got, tc.want)
+			}
+		})
+	}
+}
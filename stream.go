@@ -0,0 +1,153 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+)
+
+// IsJSONStream checks if the content read from r is a single JSON object or array, the same shapes accepted by
+// IsJSON. The content is walked token by token with a json.Decoder, so it is never fully buffered, and reading
+// stops as soon as more than maxBytes are consumed.
+//
+// Parameters:
+//   - r: The reader holding the content to be checked.
+//   - maxBytes: The maximum number of bytes that may be read from r.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the content is a valid JSON object or array within maxBytes.
+//
+// Example:
+//
+//	fmt.Println(IsJSONStream(strings.NewReader(`{"key": [1, 2]}`), 1024)) // true
+//	fmt.Println(IsJSONStream(strings.NewReader(`{"key": [1, 2]}`), 8))    // false
+//	fmt.Println(IsJSONStream(strings.NewReader(`"text"`), 1024))          // false
+func IsJSONStream(r io.Reader, maxBytes int64) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsJSONStream", time.Now(), &passed)
+	}
+	limited := &limitedReader{r: r, limit: maxBytes}
+	decoder := json.NewDecoder(limited)
+
+	token, err := decoder.Token()
+	if err != nil || (token != json.Delim('{') && token != json.Delim('[')) {
+		return false
+	}
+
+	for depth := 1; depth > 0; {
+		token, err = decoder.Token()
+		if err != nil {
+			return false
+		}
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+	}
+
+	_, err = decoder.Token()
+	return err == io.EOF && !limited.exceeded()
+}
+
+// IsBase64Stream checks if the content read from r is non-empty standard Base64, the same encoding accepted by
+// IsBase64. The content is decoded in chunks and discarded, and reading stops as soon as more than maxBytes are
+// consumed.
+//
+// Parameters:
+//   - r: The reader holding the content to be checked.
+//   - maxBytes: The maximum number of bytes that may be read from r.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the content is valid Base64 within maxBytes.
+//
+// Example:
+//
+//	fmt.Println(IsBase64Stream(strings.NewReader("SGVsbG8gV29ybGQ="), 1024)) // true
+//	fmt.Println(IsBase64Stream(strings.NewReader("SGVsbG8gV29ybGQ="), 4))    // false
+//	fmt.Println(IsBase64Stream(strings.NewReader("not base64!"), 1024))      // false
+func IsBase64Stream(r io.Reader, maxBytes int64) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBase64Stream", time.Now(), &passed)
+	}
+	limited := &limitedReader{r: r, limit: maxBytes}
+
+	_, err := io.Copy(io.Discard, base64.NewDecoder(base64.StdEncoding, limited))
+	return err == nil && limited.read > 0 && !limited.exceeded()
+}
+
+// SniffContentType detects the MIME type of the content read from r using http.DetectContentType. At most the
+// first 512 bytes are consumed from r, so callers that still need the full content should combine the sniffed
+// header with the rest of the reader, such as with io.MultiReader, or use a reader that can be rewound.
+//
+// Parameters:
+//   - r: The reader holding the content to be sniffed.
+//
+// Returns:
+//   - string: The detected MIME type, "application/octet-stream" when none matches.
+//   - error: An error if reading from r fails for a reason other than reaching its end.
+//
+// Example:
+//
+//	fmt.Println(SniffContentType(strings.NewReader("<html><body></body></html>"))) // text/html; charset=utf-8 <nil>
+//	fmt.Println(SniffContentType(bytes.NewReader([]byte("%PDF-1.7"))))            // application/pdf <nil>
+func SniffContentType(r io.Reader) (string, error) {
+	header := make([]byte, 512)
+
+	n, err := io.ReadFull(r, header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	return http.DetectContentType(header[:n]), nil
+}
+
+// limitedReader reads from r until more than limit bytes have been consumed, keeping track of the total read so
+// callers can tell a complete content apart from one that was cut at the limit.
+type limitedReader struct {
+	r     io.Reader
+	limit int64
+	read  int64
+}
+
+// Read reads at most one byte past the limit from the underlying reader, returning io.EOF afterward.
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.exceeded() {
+		return 0, io.EOF
+	} else if remaining := l.limit - l.read + 1; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	return n, err
+}
+
+// exceeded reports whether more than limit bytes have been read.
+func (l *limitedReader) exceeded() bool {
+	return l.read > l.limit
+}
@@ -0,0 +1,99 @@
+package checker
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+type streamCase struct {
+	name     string
+	arg      io.Reader
+	maxBytes int64
+	want     bool
+}
+
+func TestIsJSONStream(t *testing.T) {
+	tests := []streamCase{
+		{name: "Object", arg: strings.NewReader(`{"key": [1, 2, {"nested": true}]}`), maxBytes: 1024, want: true},
+		{name: "Array", arg: strings.NewReader(" [1, 2, 3]\n"), maxBytes: 1024, want: true},
+		{name: "ExactLimit", arg: strings.NewReader(`[1]`), maxBytes: 3, want: true},
+		{name: "OverLimit", arg: strings.NewReader(`[1, 2]`), maxBytes: 5, want: false},
+		{name: "EndlessStream", arg: endlessReader('['), maxBytes: 1 << 16, want: false},
+		{name: "String", arg: strings.NewReader(`"text"`), maxBytes: 1024, want: false},
+		{name: "Number", arg: strings.NewReader(`42`), maxBytes: 1024, want: false},
+		{name: "Unterminated", arg: strings.NewReader(`{"key": 1`), maxBytes: 1024, want: false},
+		{name: "Malformed", arg: strings.NewReader(`{"key" 1}`), maxBytes: 1024, want: false},
+		{name: "TrailingValue", arg: strings.NewReader(`{} {}`), maxBytes: 1024, want: false},
+		{name: "Empty", arg: strings.NewReader(""), maxBytes: 1024, want: false},
+		{name: "ReadError", arg: errorReader{}, maxBytes: 1024, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsJSONStream(tt.arg, tt.maxBytes); got != tt.want {
+				t.Errorf("IsJSONStream() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsBase64Stream(t *testing.T) {
+	tests := []streamCase{
+		{name: "Valid", arg: strings.NewReader("SGVsbG8gV29ybGQ="), maxBytes: 1024, want: true},
+		{name: "WithNewlines", arg: strings.NewReader("SGVsbG8g\r\nV29ybGQ="), maxBytes: 1024, want: true},
+		{name: "OverLimit", arg: strings.NewReader("SGVsbG8gV29ybGQ="), maxBytes: 15, want: false},
+		{name: "EndlessStream", arg: endlessReader('A'), maxBytes: 1 << 16, want: false},
+		{name: "Invalid", arg: strings.NewReader("not base64!"), maxBytes: 1024, want: false},
+		{name: "Empty", arg: strings.NewReader(""), maxBytes: 1024, want: false},
+		{name: "ReadError", arg: errorReader{}, maxBytes: 1024, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBase64Stream(tt.arg, tt.maxBytes); got != tt.want {
+				t.Errorf("IsBase64Stream() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSniffContentType(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     io.Reader
+		want    string
+		wantErr bool
+	}{
+		{name: "HTML", arg: strings.NewReader("<html><body></body></html>"), want: "text/html; charset=utf-8"},
+		{name: "PDF", arg: strings.NewReader("%PDF-1.7"), want: "application/pdf"},
+		{name: "EndlessStream", arg: endlessReader(0x00), want: "application/octet-stream"},
+		{name: "Empty", arg: strings.NewReader(""), want: "text/plain; charset=utf-8"},
+		{name: "ReadError", arg: errorReader{}, want: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SniffContentType(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SniffContentType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("SniffContentType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type endlessReader byte
+
+func (r endlessReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(r)
+	}
+	return len(p), nil
+}
+
+type errorReader struct{}
+
+func (errorReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
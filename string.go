@@ -24,11 +24,16 @@ package checker
 
 import (
 	"encoding/base64"
+	"math"
 	"net"
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 // IsURL checks the given value, converts it to string and determines whether it
@@ -51,7 +56,15 @@ import (
 //	fmt.Println(IsURL(&w)) // true
 //	fmt.Println(IsURL(w)) // true
 //	fmt.Println(IsURL(x)) // false
-func IsURL(a any) bool {
+func IsURL(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsURL", time.Now(), &passed)
+	}
+	return isURL(a)
+}
+
+// isURL is the unobserved implementation of IsURL.
+func isURL(a any) bool {
 	_, err := url.ParseRequestURI(toString(a))
 	return err == nil
 }
@@ -77,10 +90,90 @@ func IsURL(a any) bool {
 //	fmt.Println(IsURLPath("/sas-as/asa_asa/abc/")) // true
 //	fmt.Println(IsURLPath("not/a/path")) // false
 //	fmt.Println(IsURLPath("not/a/path?document=12345")) // false
-func IsURLPath(a any) bool {
+func IsURLPath(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsURLPath", time.Now(), &passed)
+	}
+	return isURLPath(a)
+}
+
+// isURLPath is the unobserved implementation of IsURLPath.
+func isURLPath(a any) bool {
 	s := toString(a)
 	regex := regexp.MustCompile(`^(/[^/?\s]*)*$`)
-	return IsNotEmpty(s) && regex.MatchString(s)
+	return isNotEmpty(s) && regex.MatchString(s)
+}
+
+// IsURLSafe checks whether a given value is made only of the unreserved characters of RFC 3986, ASCII letters,
+// digits, '-', '.', '_' and '~', so it can be placed in any component of a URL, such as a path segment or a query
+// parameter, without being percent-encoded. Empty strings are rejected.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as URL-safe.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is made only of unreserved characters.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsURLSafe("order-42_v1.2~beta")) // true
+//	fmt.Println(IsURLSafe("a b"))                // false
+//	fmt.Println(IsURLSafe("a%20b"))              // false
+//	fmt.Println(IsURLSafe(""))                   // false
+func IsURLSafe(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsURLSafe", time.Now(), &passed)
+	}
+	s := toString(a)
+	return s != "" && !needsURLEncoding(s)
+}
+
+// NeedsURLEncoding checks whether a given value contains a character outside the unreserved characters of RFC
+// 3986, which must be percent-encoded before the value is placed in a URL component. The value is taken
+// literally, so an already encoded "%20" needs encoding too, as its '%' would otherwise be decoded. Empty strings
+// need no encoding.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked for characters to be encoded.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value must be percent-encoded.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(NeedsURLEncoding("São Paulo")) // true
+//	fmt.Println(NeedsURLEncoding("a/b"))       // true
+//	fmt.Println(NeedsURLEncoding("a%20b"))     // true
+//	fmt.Println(NeedsURLEncoding("order-42"))  // false
+func NeedsURLEncoding(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("NeedsURLEncoding", time.Now(), &passed)
+	}
+	return needsURLEncoding(a)
+}
+
+// needsURLEncoding is the unobserved implementation of NeedsURLEncoding.
+func needsURLEncoding(a any) bool {
+	s := toString(a)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		unreserved := ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~'
+		if !unreserved {
+			return true
+		}
+	}
+	return false
 }
 
 // IsHTTPMethod checks if a given value matches a known HTTP method. It first converts the value to a string, then
@@ -106,7 +199,10 @@ func IsURLPath(a any) bool {
 //	fmt.Println(IsHTTPMethod(x)) // true
 //	fmt.Println(IsHTTPMethod(&y)) // true
 //	fmt.Println(IsHTTPMethod(z)) // false
-func IsHTTPMethod(a any) bool {
+func IsHTTPMethod(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsHTTPMethod", time.Now(), &passed)
+	}
 	switch toString(a) {
 	case http.MethodGet, http.MethodPost, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodConnect,
 		http.MethodOptions, http.MethodTrace, http.MethodPatch:
@@ -141,10 +237,13 @@ func IsHTTPMethod(a any) bool {
 //	fmt.Println(IsAlpha(123)) // panic
 //	fmt.Println(IsAlpha([]int{1, 2, 3})) // panic
 //	fmt.Println(IsAlpha(nil)) // panic
-func IsAlpha(a any) bool {
+func IsAlpha(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAlpha", time.Now(), &passed)
+	}
 	s := toString(a)
 	regex := regexp.MustCompile("^\\p{L}+$")
-	return IsNotEmpty(s) && regex.MatchString(s)
+	return isNotEmpty(s) && regex.MatchString(s)
 }
 
 // IsAlphaSpace checks the given value, converts it to string and determines whether it
@@ -169,10 +268,13 @@ func IsAlpha(a any) bool {
 //	fmt.Println(IsAlphaSpace(123)) // panic
 //	fmt.Println(IsAlphaSpace([]int{1, 2, 3})) // panic
 //	fmt.Println(IsAlphaSpace(nil)) // panic
-func IsAlphaSpace(a any) bool {
+func IsAlphaSpace(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAlphaSpace", time.Now(), &passed)
+	}
 	s := toString(a)
 	regex := regexp.MustCompile("^[\\p{L} ]+$")
-	return IsNotEmpty(s) && regex.MatchString(s)
+	return isNotEmpty(s) && regex.MatchString(s)
 }
 
 // IsNumeric checks the given value, converts it to a string, and determines whether it
@@ -198,10 +300,18 @@ func IsAlphaSpace(a any) bool {
 //	fmt.Println(IsNumeric(123)) // panic
 //	fmt.Println(IsNumeric([]int{1, 2, 3})) // panic
 //	fmt.Println(IsNumeric(nil)) // panic
-func IsNumeric(a any) bool {
+func IsNumeric(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNumeric", time.Now(), &passed)
+	}
+	return isNumeric(a)
+}
+
+// isNumeric is the unobserved implementation of IsNumeric.
+func isNumeric(a any) bool {
 	s := toString(a)
 	regex := regexp.MustCompile("^[-.+0-9]+$")
-	return IsNotEmpty(s) && regex.MatchString(s)
+	return isNotEmpty(s) && regex.MatchString(s)
 }
 
 // IsNotNumeric checks whether a given value consists of non-numeric characters.
@@ -225,8 +335,11 @@ func IsNumeric(a any) bool {
 //	fmt.Println(IsNotNumeric(123)) // panic
 //	fmt.Println(IsNotNumeric([]int{1, 2, 3})) // panic
 //	fmt.Println(IsNotNumeric(nil)) // panic
-func IsNotNumeric(a any) bool {
-	return !IsNumeric(a)
+func IsNotNumeric(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNotNumeric", time.Now(), &passed)
+	}
+	return !isNumeric(a)
 }
 
 // IsNumericSpace determines whether a given value is a numeric string or a string containing spaces only.
@@ -247,10 +360,127 @@ func IsNotNumeric(a any) bool {
 //	fmt.Println(IsNumericSpace(a)) // true
 //	fmt.Println(IsNumericSpace(b)) // true
 //	fmt.Println(IsNumericSpace(c)) // false
-func IsNumericSpace(a any) bool {
+func IsNumericSpace(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNumericSpace", time.Now(), &passed)
+	}
 	s := toString(a)
 	regex := regexp.MustCompile("^[0-9 ]+$")
-	return IsNotEmpty(s) && regex.MatchString(s)
+	return isNotEmpty(s) && regex.MatchString(s)
+}
+
+// IsStrictNumeric checks the given value, converts it to a string, and determines whether it consists of ASCII
+// digits only, without sign, decimal separator or spaces. Unlike IsNumeric, values such as "+-+" or "1.2.3" are
+// rejected.
+//
+// Parameters:
+//   - a: Any value to be checked if it consists of digits only.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the given value consists of digits only.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsStrictNumeric("0123")) // true
+//	fmt.Println(IsStrictNumeric("-123")) // false
+//	fmt.Println(IsStrictNumeric("1.5"))  // false
+func IsStrictNumeric(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsStrictNumeric", time.Now(), &passed)
+	}
+	return isStrictNumeric(a)
+}
+
+// isStrictNumeric is the unobserved implementation of IsStrictNumeric.
+func isStrictNumeric(a any) bool {
+	regex := regexp.MustCompile(`^[0-9]+$`)
+	return regex.MatchString(toString(a))
+}
+
+// IsSignedNumeric checks the given value, converts it to a string, and determines whether it is an integer
+// made of ASCII digits with an optional leading '+' or '-' sign, such as "-123".
+//
+// Parameters:
+//   - a: Any value to be checked if it is a signed integer string.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the given value is a signed integer string.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsSignedNumeric("-123")) // true
+//	fmt.Println(IsSignedNumeric("123"))  // true
+//	fmt.Println(IsSignedNumeric("+-1"))  // false
+func IsSignedNumeric(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSignedNumeric", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^[-+]?[0-9]+$`)
+	return regex.MatchString(toString(a))
+}
+
+// IsDecimalString checks the given value, converts it to a string, and determines whether it is a decimal
+// number with an optional leading sign and at most one dot as decimal separator, such as "-1.5" or ".5". A dot
+// must be followed by at least one digit.
+//
+// Parameters:
+//   - a: Any value to be checked if it is a decimal number string.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the given value is a decimal number string.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsDecimalString("-1.5"))   // true
+//	fmt.Println(IsDecimalString("42"))     // true
+//	fmt.Println(IsDecimalString("1.2.3"))  // false
+//	fmt.Println(IsDecimalString("1.2.3-")) // false
+func IsDecimalString(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsDecimalString", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^[-+]?([0-9]+(\.[0-9]+)?|\.[0-9]+)$`)
+	return regex.MatchString(toString(a))
+}
+
+// IsScientificNotation checks the given value, converts it to a string, and determines whether it is a number
+// in scientific notation: a decimal mantissa, as accepted by IsDecimalString, followed by 'e' or 'E' and a
+// signed integer exponent, such as "6.022e23" or "-1E-9".
+//
+// Parameters:
+//   - a: Any value to be checked if it is in scientific notation.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the given value is in scientific notation.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsScientificNotation("6.022e23")) // true
+//	fmt.Println(IsScientificNotation("-1E-9"))    // true
+//	fmt.Println(IsScientificNotation("1.5"))      // false
+//	fmt.Println(IsScientificNotation("e10"))      // false
+func IsScientificNotation(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsScientificNotation", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^[-+]?([0-9]+(\.[0-9]+)?|\.[0-9]+)[eE][-+]?[0-9]+$`)
+	return regex.MatchString(toString(a))
 }
 
 // IsEmail determines whether a given value is a valid email. It uses the toString function
@@ -274,7 +504,15 @@ func IsNumericSpace(a any) bool {
 //	fmt.Println(IsEmail(y)) // false
 //	fmt.Println(IsEmail([]int{1, 2, 3})) // panic
 //	fmt.Println(IsEmail(nil)) // panic
-func IsEmail(a any) bool {
+func IsEmail(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsEmail", time.Now(), &passed)
+	}
+	return isEmail(a)
+}
+
+// isEmail is the unobserved implementation of IsEmail.
+func isEmail(a any) bool {
 	regex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 	return regex.MatchString(toString(a))
 }
@@ -300,8 +538,90 @@ func IsEmail(a any) bool {
 //	fmt.Println(IsNotEmail(y)) // true
 //	fmt.Println(IsNotEmail([]int{1, 2, 3})) // panic
 //	fmt.Println(IsNotEmail(nil)) // panic
-func IsNotEmail(a any) bool {
-	return !IsEmail(a)
+func IsNotEmail(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNotEmail", time.Now(), &passed)
+	}
+	return !isEmail(a)
+}
+
+// IsSameEmail checks whether two values are valid emails, as checked by IsEmail, that reach the same mailbox.
+// Surrounding whitespace is ignored and domains are compared case-insensitively, as DNS names are, while local
+// parts must match exactly. Use IsSameEmailWithOptions to also fold provider aliases, such as in duplicate
+// account checks.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and compared as an email.
+//   - b: Any value to be converted into a string and compared as an email.
+//
+// Returns:
+//   - bool: A boolean value indicating whether both values are emails of the same mailbox.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsSameEmail("user@Example.COM", " user@example.com ")) // true
+//	fmt.Println(IsSameEmail("User@example.com", "user@example.com"))   // false
+//	fmt.Println(IsSameEmail("invalid", "invalid"))                     // false
+func IsSameEmail(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSameEmail", time.Now(), &passed)
+	}
+	return isSameEmailWithOptions(a, b, EmailOptions{})
+}
+
+// EmailOptions configures how IsSameEmailWithOptions folds the local parts of emails before comparing them. The
+// zero value matches IsSameEmail.
+type EmailOptions struct {
+	// IgnoreLocalCase compares the local parts case-insensitively, as most providers deliver "User@" and "user@"
+	// to the same mailbox.
+	IgnoreLocalCase bool
+	// FoldPlusAlias ignores the "+tag" suffix of the local parts, so "user+promo@" and "user@" are the same.
+	FoldPlusAlias bool
+	// FoldGmailDots ignores the dots and the case of the local parts of Gmail addresses, and treats the
+	// googlemail.com domain as gmail.com, as Gmail delivers "u.s.e.r@googlemail.com" to "user@gmail.com".
+	FoldGmailDots bool
+}
+
+// IsSameEmailWithOptions checks whether two values are valid emails, as checked by IsEmail, that reach the same
+// mailbox after the folding configured by the options, like IsSameEmail does with its zero value.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and compared as an email.
+//   - b: Any value to be converted into a string and compared as an email.
+//   - opts: The folding applied to the local parts.
+//
+// Returns:
+//   - bool: A boolean value indicating whether both values are emails of the same mailbox.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	opts := EmailOptions{FoldPlusAlias: true, FoldGmailDots: true}
+//	fmt.Println(IsSameEmailWithOptions("User+promo@gmail.com", "user@gmail.com", opts))     // true
+//	fmt.Println(IsSameEmailWithOptions("j.doe@googlemail.com", "jdoe@gmail.com", opts))     // true
+//	fmt.Println(IsSameEmailWithOptions("j.doe@example.com", "jdoe@example.com", opts))      // false
+//	fmt.Println(IsSameEmailWithOptions("user+promo@example.com", "user@example.com", opts)) // true
+func IsSameEmailWithOptions(a, b any, opts EmailOptions) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSameEmailWithOptions", time.Now(), &passed)
+	}
+	return isSameEmailWithOptions(a, b, opts)
+}
+
+// isSameEmailWithOptions is the unobserved implementation of IsSameEmailWithOptions.
+func isSameEmailWithOptions(a, b any, opts EmailOptions) bool {
+	x, okA := canonicalEmail(toString(a), opts)
+	y, okB := canonicalEmail(toString(b), opts)
+	return okA && okB && x == y
 }
 
 // IsDocument determines the type of document (CPF or CNPJ) and checks the value based on the document type.
@@ -334,12 +654,15 @@ func IsNotEmail(a any) bool {
 //	fmt.Println(IsDocument(docTypeCPF, z)) // false
 //	fmt.Println(IsDocument(docTypeCNPJ, z)) // false
 //	fmt.Println(Document(0),w) // panic: unknown document type: CNH
-func IsDocument(d Document, a any) bool {
+func IsDocument(d Document, a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsDocument", time.Now(), &passed)
+	}
 	switch d {
 	case DocumentCPF:
-		return IsCPF(a)
+		return isCPF(a)
 	case DocumentCNPJ:
-		return IsCNPJ(a)
+		return isCNPJ(a)
 	default:
 		panic("unknown document type: " + d)
 	}
@@ -367,7 +690,15 @@ func IsDocument(d Document, a any) bool {
 //	fmt.Println(IsCPF(w)) // true
 //	fmt.Println(IsCPF(x)) // false
 //	fmt.Println(IsCPF(nil)) // panic
-func IsCPF(a any) bool {
+func IsCPF(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsCPF", time.Now(), &passed)
+	}
+	return isCPF(a)
+}
+
+// isCPF is the unobserved implementation of IsCPF.
+func isCPF(a any) bool {
 	s := removeNonDigits(toString(a))
 	if len(s) != 11 || allDigitsEqual(s) {
 		return false
@@ -402,7 +733,15 @@ func IsCPF(a any) bool {
 //	  fmt.Println(IsCNPJ(w)) // true
 //	  fmt.Println(IsCNPJ(x)) // false
 //		 fmt.Println(IsCNPJ(nil)) // panic
-func IsCNPJ(a any) bool {
+func IsCNPJ(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsCNPJ", time.Now(), &passed)
+	}
+	return isCNPJ(a)
+}
+
+// isCNPJ is the unobserved implementation of IsCNPJ.
+func isCNPJ(a any) bool {
 	s := removeNonDigits(toString(a))
 	if len(s) != 14 || allDigitsEqual(s) {
 		return false
@@ -438,8 +777,11 @@ func IsCNPJ(a any) bool {
 //	fmt.Println(IsCPFOrCNPJ("12345678901234")) // true
 //	fmt.Println(IsCPFOrCNPJ(x)) // false
 //	fmt.Println(IsCPFOrCNPJ(nil)) // panic
-func IsCPFOrCNPJ(a any) bool {
-	return IsCPF(a) || IsCNPJ(a)
+func IsCPFOrCNPJ(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsCPFOrCNPJ", time.Now(), &passed)
+	}
+	return isCPF(a) || isCNPJ(a)
 }
 
 // IsBase64 checks whether a given value is a Base64 encoded string. It uses string and regex manipulation
@@ -462,9 +804,12 @@ func IsCPFOrCNPJ(a any) bool {
 //	var invalidBase64 = "Hello world!"
 //	fmt.Println(IsBase64(validBase64)) // true
 //	fmt.Println(IsBase64(invalidBase64)) // false
-func IsBase64(a any) bool {
+func IsBase64(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBase64", time.Now(), &passed)
+	}
 	s := toString(a)
-	if IsEmpty(s) {
+	if isEmpty(s) {
 		return false
 	}
 
@@ -472,17 +817,28 @@ func IsBase64(a any) bool {
 	return err == nil
 }
 
-// IsBearer checks whether a given value carries a Bearer authentication scheme.
-// It uses the toString function to convert the given value to a string.
-// It then uses the Split method from the string package to divide the
-// string in relation to "Bearer ", and finally checks if the result satisfies
-// the Bearer authentication scheme criteria.
+// Base64Options configures the variant of Base64 accepted by IsBase64WithOptions. Zero values keep the behavior
+// of IsBase64: the standard alphabet with "=" padding.
+type Base64Options struct {
+	// URLSafe uses the URL-safe alphabet, with "-" and "_" instead of "+" and "/".
+	URLSafe bool
+	// NoPadding requires the value to be unpadded (raw), as in JWTs, instead of padded with "=".
+	NoPadding bool
+	// Canonical rejects non-canonical encodings, whose unused trailing bits are not zero, so every decoded value
+	// has a single accepted encoding.
+	Canonical bool
+}
+
+// IsBase64WithOptions checks whether a given value is a Base64 encoded string of the variant configured by the
+// options, such as the URL-safe unpadded encoding used by JWTs and webhook signatures. Empty strings are
+// rejected, like IsBase64 does, and so are line breaks, which the decoder would otherwise skip.
 //
 // Parameters:
-//   - a: Any interface value to be processed and checked for a Bearer authentication scheme.
+//   - a: Any value to be converted into a string and checked for Base64 encoding.
+//   - opts: The alphabet, padding and canonical encoding rules to be applied.
 //
 // Returns:
-//   - bool: A boolean value indicating whether the value adheres to the Bearer authentication scheme.
+//   - bool: A boolean value indicating whether the value is Base64 encoded with the configured variant.
 //
 // Panic:
 //   - The function will panic if an unsupported value is passed.
@@ -491,52 +847,74 @@ func IsBase64(a any) bool {
 //
 // Example:
 //
-//	var x string = "Bearer token"
-//	y := 12345
-//	fmt.Println(IsBearer(x)) // true
-//	fmt.Println(IsBearer(y)) // false
-func IsBearer(a any) bool {
-	const bearer = "Bearer"
-	split := strings.Split(toString(a), " ")
-	return len(split) > 0 && split[0] == bearer
+//	fmt.Println(IsBase64WithOptions("PDw_Pz4-", Base64Options{URLSafe: true})) // true
+//	fmt.Println(IsBase64WithOptions("SGk", Base64Options{NoPadding: true}))    // true
+//	fmt.Println(IsBase64WithOptions("SGk=", Base64Options{NoPadding: true}))   // false
+//	fmt.Println(IsBase64WithOptions("SGl=", Base64Options{Canonical: true}))   // false
+func IsBase64WithOptions(a any, opts Base64Options) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBase64WithOptions", time.Now(), &passed)
+	}
+	return isBase64WithOptions(a, opts)
 }
 
-// IsValidIP checks whether a given value can be parsed as a valid IP address. It uses the net.ParseIP function
-// to parse the value and checks if the returned IP is not nil.
+// isBase64WithOptions is the unobserved implementation of IsBase64WithOptions.
+func isBase64WithOptions(a any, opts Base64Options) bool {
+	s := toString(a)
+	if isEmpty(s) {
+		return false
+	}
+
+	encoding := base64.StdEncoding
+	if opts.URLSafe && opts.NoPadding {
+		encoding = base64.RawURLEncoding
+	} else if opts.URLSafe {
+		encoding = base64.URLEncoding
+	} else if opts.NoPadding {
+		encoding = base64.RawStdEncoding
+	}
+	if opts.Canonical {
+		encoding = encoding.Strict()
+	}
+
+	_, err := encoding.DecodeString(s)
+	return err == nil && !strings.ContainsAny(s, "\r\n")
+}
+
+// IsBase64URL checks whether a given value is a Base64 encoded string with the URL-safe alphabet and "="
+// padding. It uses the IsBase64WithOptions function with the URLSafe option.
 //
 // Parameters:
-//   - a: Any value to be checked for validity as an IP address. The value is first converted to a string using the toString function.
+//   - a: Any value to be checked for URL-safe Base64 encoding.
 //
 // Returns:
-//   - bool: A boolean value indicating whether the value can be parsed as a valid IP address.
+//   - bool: A boolean value indicating whether the value is a URL-safe Base64 encoded string.
 //
-// Panics:
-//   - If the provided value cannot be converted to a string using the toString function, a panic is thrown.
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
 //
 // Example:
 //
-//	var x string = "192.0.2.0"
-//	var y int = 1234567890
-//	fmt.Println(IsValidIP(x)) // true
-//	fmt.Println(IsValidIP(y)) // false
-func IsValidIP(a any) bool {
-	return net.ParseIP(toString(a)) != nil
+//	fmt.Println(IsBase64URL("PDw_Pz4-")) // true
+//	fmt.Println(IsBase64URL("PDw/Pz4+")) // false
+//	fmt.Println(IsBase64URL("SGk"))      // false
+func IsBase64URL(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBase64URL", time.Now(), &passed)
+	}
+	return isBase64WithOptions(a, Base64Options{URLSafe: true})
 }
 
-// IsPrivateIP determines whether the provided IP address is a private IP address.
-// The function considers both IPv4 and IPv6 ranges for the check.
-// It uses net.ParseCIDR to get the IP blocks of reserved private and local IPs.
-// The argument is then parsed into an IP using the net.ParseIP function.
-// The parsed IP object is examined for being loop back, link-local uni-cast, or link-local multicast.
-// If it is not, the function checks whether the IP is within any of the defined private IP blocks.
-// If any check is positive, the function returns true, indicating that it is a private IP.
-// If every check is negative, the function returns false, indicating that the IP isn't private.
+// IsBase64RawStd checks whether a given value is a Base64 encoded string with the standard alphabet and without
+// padding. It uses the IsBase64WithOptions function with the NoPadding option.
 //
 // Parameters:
-//   - a: The interface value to be checked for being a private IP. Expected to be an IP address in string form.
+//   - a: Any value to be checked for unpadded standard Base64 encoding.
 //
 // Returns:
-//   - bool: A boolean value indicating whether the IP address is private.
+//   - bool: A boolean value indicating whether the value is an unpadded standard Base64 encoded string.
 //
 // Panic:
 //   - The function will panic if an unsupported value is passed.
@@ -545,53 +923,25 @@ func IsValidIP(a any) bool {
 //
 // Example:
 //
-//	x := "192.0.2.1"      // IPv4 public
-//	y := "192.168.0.1"    // IPv4 private (RFC1918)
-//	z := "2001:db8::1"    // IPv6 public
-//	w := "fc00::1"        // IPv6 private (ULA)
-//
-//	fmt.Println(IsPrivateIP(x)) // false
-//	fmt.Println(IsPrivateIP(y)) // true
-//	fmt.Println(IsPrivateIP(z)) // false
-//	fmt.Println(IsPrivateIP(w)) // true
-func IsPrivateIP(a any) bool {
-	var privateIPBlocks []*net.IPNet
-	for _, cidr := range []string{
-		"127.0.0.0/8",    // IPv4
-		"10.0.0.0/8",     // RFC1918
-		"172.16.0.0/12",  // RFC1918
-		"192.168.0.0/16", // RFC1918
-		"169.254.0.0/16", // RFC3927 link-local
-		"::1/128",        // IPv6
-		"fe80::/10",      // IPv6 link-local
-		"fc00::/7",       // IPv6 unique local addr
-	} {
-		_, block, _ := net.ParseCIDR(cidr)
-		if block != nil {
-			privateIPBlocks = append(privateIPBlocks, block)
-		}
-	}
-
-	ip := net.ParseIP(toString(a))
-	result := ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast()
-	for _, block := range privateIPBlocks {
-		if block.Contains(ip) {
-			result = true
-			break
-		}
+//	fmt.Println(IsBase64RawStd("SGk"))  // true
+//	fmt.Println(IsBase64RawStd("SGk=")) // false
+//	fmt.Println(IsBase64RawStd("S-k"))  // false
+func IsBase64RawStd(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBase64RawStd", time.Now(), &passed)
 	}
-	return result
+	return isBase64WithOptions(a, Base64Options{NoPadding: true})
 }
 
-// IsFullName validates if a given value matches a pattern for full names. It treats the given value as a string
-// and checks whether it entirely matches the regular expression '^[\p{L}\s'-]+$'. The regular expression indicates
-// a full name consisting of unicode letters, spaces, single quotes, or hyphens.
+// IsBase64RawURL checks whether a given value is a Base64 encoded string with the URL-safe alphabet and without
+// padding, as used by the segments of JWTs. It uses the IsBase64WithOptions function with the URLSafe and
+// NoPadding options.
 //
 // Parameters:
-//   - a: any. A value of any type that is to be checked and validated against the pattern for full names.
+//   - a: Any value to be checked for unpadded URL-safe Base64 encoding.
 //
 // Returns:
-//   - bool: A boolean value. If the given value matches the pattern for full names, true is returned. Otherwise, false is returned.
+//   - bool: A boolean value indicating whether the value is an unpadded URL-safe Base64 encoded string.
 //
 // Panic:
 //   - The function will panic if an unsupported value is passed.
@@ -600,15 +950,367 @@ func IsPrivateIP(a any) bool {
 //
 // Example:
 //
-//	name := "John Doe"
-//	invalidName := "John123"
-//	fmt.Println(IsFullName(name)) // true
-//	fmt.Println(IsFullName(invalidName)) // false
-func IsFullName(a any) bool {
-	s := toString(a)
-	split := strings.Fields(s)
-	regex := regexp.MustCompile(`^[\p{L}\s'-]+$`)
-	return len(split) > 1 && IsNotEmpty(s) && regex.MatchString(s)
+//	fmt.Println(IsBase64RawURL("eyJhbGciOiJIUzI1NiJ9")) // true
+//	fmt.Println(IsBase64RawURL("PDw_Pz4-"))             // true
+//	fmt.Println(IsBase64RawURL("SGk="))                 // false
+func IsBase64RawURL(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBase64RawURL", time.Now(), &passed)
+	}
+	return isBase64WithOptions(a, Base64Options{URLSafe: true, NoPadding: true})
+}
+
+// IsBase64OfLength checks whether a given value is a Base64 encoded string, in any of the standard and URL-safe
+// alphabets, with or without padding, that decodes to exactly the given number of bytes, such as a 32-byte key or
+// a 16-byte nonce.
+//
+// Parameters:
+//   - a: Any value to be checked for Base64 encoding.
+//   - decodedLen: The expected number of decoded bytes.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is Base64 encoded and decodes to the given length.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsBase64OfLength("AAECAwQFBgcICQoLDA0ODw==", 16)) // true
+//	fmt.Println(IsBase64OfLength("AAECAwQFBgcICQoLDA0ODw", 16))   // true
+//	fmt.Println(IsBase64OfLength("AAECAwQFBgcICQoLDA0ODw==", 32)) // false
+func IsBase64OfLength(a any, decodedLen int) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBase64OfLength", time.Now(), &passed)
+	}
+	s := toString(a)
+	if isEmpty(s) || strings.ContainsAny(s, "\r\n") {
+		return false
+	}
+
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding,
+		base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(s); err == nil {
+			return len(decoded) == decodedLen
+		}
+	}
+	return false
+}
+
+// IsBearer checks whether a given value carries a Bearer authentication scheme.
+// It uses the toString function to convert the given value to a string.
+// It then uses the Split method from the string package to divide the
+// string in relation to "Bearer ", and finally checks if the result satisfies
+// the Bearer authentication scheme criteria.
+//
+// Parameters:
+//   - a: Any interface value to be processed and checked for a Bearer authentication scheme.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value adheres to the Bearer authentication scheme.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	var x string = "Bearer token"
+//	y := 12345
+//	fmt.Println(IsBearer(x)) // true
+//	fmt.Println(IsBearer(y)) // false
+func IsBearer(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBearer", time.Now(), &passed)
+	}
+	const bearer = "Bearer"
+	split := strings.Split(toString(a), " ")
+	return len(split) > 0 && split[0] == bearer
+}
+
+// IsTraceParent checks whether a given value is a valid W3C Trace Context "traceparent" header, made of the
+// version, trace-id, parent-id and trace-flags fields in lowercase hexadecimal, such as
+// "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01". The version "ff" and the all-zero trace-id and
+// parent-id are invalid. Version 00 headers must have exactly four fields, while later versions may carry extra
+// fields after the trace-flags, as the specification requires for forward compatibility.
+//
+// Parameters:
+//   - a: Any interface value to be checked as a traceparent header.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid traceparent header.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")) // true
+//	fmt.Println(IsTraceParent("00-00000000000000000000000000000000-00f067aa0ba902b7-01")) // false
+//	fmt.Println(IsTraceParent("00-4BF92F3577B34DA6A3CE929D0E0E4736-00F067AA0BA902B7-01")) // false
+func IsTraceParent(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsTraceParent", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^([0-9a-f]{2})-([0-9a-f]{32})-([0-9a-f]{16})-[0-9a-f]{2}(-.*)?$`)
+	matches := regex.FindStringSubmatch(toString(a))
+	if matches == nil || matches[1] == "ff" || (matches[1] == "00" && matches[4] != "") {
+		return false
+	}
+	return isNonZeroHex(matches[2]) && isNonZeroHex(matches[3])
+}
+
+// IsTraceID checks whether a given value is a W3C Trace Context trace-id: 32 lowercase hexadecimal characters,
+// not all zeros, such as "4bf92f3577b34da6a3ce929d0e0e4736".
+//
+// Parameters:
+//   - a: Any interface value to be checked as a trace-id.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid trace-id.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsTraceID("4bf92f3577b34da6a3ce929d0e0e4736")) // true
+//	fmt.Println(IsTraceID("00000000000000000000000000000000")) // false
+//	fmt.Println(IsTraceID("4bf92f3577b34da6"))                 // false
+func IsTraceID(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsTraceID", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^[0-9a-f]{32}$`)
+	return regex.MatchString(s) && isNonZeroHex(s)
+}
+
+// IsSpanID checks whether a given value is a W3C Trace Context span id, the parent-id of the traceparent header:
+// 16 lowercase hexadecimal characters, not all zeros, such as "00f067aa0ba902b7".
+//
+// Parameters:
+//   - a: Any interface value to be checked as a span id.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a valid span id.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsSpanID("00f067aa0ba902b7")) // true
+//	fmt.Println(IsSpanID("0000000000000000")) // false
+//	fmt.Println(IsSpanID("00f067aa0ba902"))   // false
+func IsSpanID(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSpanID", time.Now(), &passed)
+	}
+	s := toString(a)
+	regex := regexp.MustCompile(`^[0-9a-f]{16}$`)
+	return regex.MatchString(s) && isNonZeroHex(s)
+}
+
+// IsRequestIDHeaderSafe checks whether a given value can be safely echoed as a request id header, such as
+// X-Request-ID, and written to logs: between 1 and 200 visible ASCII characters, without spaces or control
+// characters that would allow header injection or log forging.
+//
+// Parameters:
+//   - a: Any interface value to be checked as a request id.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a header-safe request id.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsRequestIDHeaderSafe("f47ac10b-58cc-4372-a567-0e02b2c3d479")) // true
+//	fmt.Println(IsRequestIDHeaderSafe("abc\r\nSet-Cookie: x=1"))               // false
+//	fmt.Println(IsRequestIDHeaderSafe(""))                                     // false
+func IsRequestIDHeaderSafe(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsRequestIDHeaderSafe", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^[\x21-\x7E]{1,200}$`)
+	return regex.MatchString(toString(a))
+}
+
+// IsHeaderValueSafe checks whether a given value can be written as an HTTP header field value as defined by RFC
+// 7230: visible ASCII characters, with spaces and horizontal tabs allowed between them but not at either end.
+// Control characters, such as the CR and LF used for header injection, are rejected, and so are non-ASCII
+// characters, which must be encoded first, such as with the RFC 8187 encoding or percent-encoding. Empty values
+// are accepted.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a header value.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a safe header field value.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsHeaderValueSafe("text/html; charset=utf-8")) // true
+//	fmt.Println(IsHeaderValueSafe("abc\r\nSet-Cookie: x=1"))   // false
+//	fmt.Println(IsHeaderValueSafe(" padded"))                  // false
+//	fmt.Println(IsHeaderValueSafe("São Paulo"))                // false
+func IsHeaderValueSafe(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsHeaderValueSafe", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^([\x21-\x7E]([\x21-\x7E \t]*[\x21-\x7E])?)?$`)
+	return regex.MatchString(toString(a))
+}
+
+// isNonZeroHex checks whether the hexadecimal string s has at least one digit other than zero.
+func isNonZeroHex(s string) bool {
+	return strings.Trim(s, "0") != ""
+}
+
+// IsValidIP checks whether a given value can be parsed as a valid IP address. It uses the net.ParseIP function
+// to parse the value and checks if the returned IP is not nil.
+//
+// Parameters:
+//   - a: Any value to be checked for validity as an IP address. The value is first converted to a string using the toString function.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value can be parsed as a valid IP address.
+//
+// Panics:
+//   - If the provided value cannot be converted to a string using the toString function, a panic is thrown.
+//
+// Example:
+//
+//	var x string = "192.0.2.0"
+//	var y int = 1234567890
+//	fmt.Println(IsValidIP(x)) // true
+//	fmt.Println(IsValidIP(y)) // false
+func IsValidIP(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsValidIP", time.Now(), &passed)
+	}
+	return net.ParseIP(toString(a)) != nil
+}
+
+// privateIPBlocks holds the private and loopback address blocks checked by IsPrivateIP, built on first use.
+var privateIPBlocks = lazy(func() []*net.IPNet {
+	var blocks []*net.IPNet
+	for _, cidr := range []string{
+		"127.0.0.0/8",    // IPv4
+		"10.0.0.0/8",     // RFC1918
+		"172.16.0.0/12",  // RFC1918
+		"192.168.0.0/16", // RFC1918
+		"169.254.0.0/16", // RFC3927 link-local
+		"::1/128",        // IPv6
+		"fe80::/10",      // IPv6 link-local
+		"fc00::/7",       // IPv6 unique local addr
+	} {
+		_, block, _ := net.ParseCIDR(cidr)
+		if block != nil {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+})
+
+// IsPrivateIP determines whether the provided IP address is a private IP address.
+// The function considers both IPv4 and IPv6 ranges for the check.
+// It uses net.ParseCIDR to get the IP blocks of reserved private and local IPs.
+// The argument is then parsed into an IP using the net.ParseIP function.
+// The parsed IP object is examined for being loop back, link-local uni-cast, or link-local multicast.
+// If it is not, the function checks whether the IP is within any of the defined private IP blocks.
+// If any check is positive, the function returns true, indicating that it is a private IP.
+// If every check is negative, the function returns false, indicating that the IP isn't private.
+//
+// Parameters:
+//   - a: The interface value to be checked for being a private IP. Expected to be an IP address in string form.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the IP address is private.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	x := "192.0.2.1"      // IPv4 public
+//	y := "192.168.0.1"    // IPv4 private (RFC1918)
+//	z := "2001:db8::1"    // IPv6 public
+//	w := "fc00::1"        // IPv6 private (ULA)
+//
+//	fmt.Println(IsPrivateIP(x)) // false
+//	fmt.Println(IsPrivateIP(y)) // true
+//	fmt.Println(IsPrivateIP(z)) // false
+//	fmt.Println(IsPrivateIP(w)) // true
+func IsPrivateIP(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsPrivateIP", time.Now(), &passed)
+	}
+	ip := net.ParseIP(toString(a))
+	result := ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast()
+	for _, block := range privateIPBlocks() {
+		if block.Contains(ip) {
+			result = true
+			break
+		}
+	}
+	return result
+}
+
+// IsFullName validates if a given value matches a pattern for full names. It treats the given value as a string
+// and checks whether it entirely matches the regular expression '^[\p{L}\s'-]+$'. The regular expression indicates
+// a full name consisting of unicode letters, spaces, single quotes, or hyphens.
+//
+// Parameters:
+//   - a: any. A value of any type that is to be checked and validated against the pattern for full names.
+//
+// Returns:
+//   - bool: A boolean value. If the given value matches the pattern for full names, true is returned. Otherwise, false is returned.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	name := "John Doe"
+//	invalidName := "John123"
+//	fmt.Println(IsFullName(name)) // true
+//	fmt.Println(IsFullName(invalidName)) // false
+func IsFullName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsFullName", time.Now(), &passed)
+	}
+	return isFullName(a)
+}
+
+// isFullName is the unobserved implementation of IsFullName.
+func isFullName(a any) bool {
+	s := toString(a)
+	split := strings.Fields(s)
+	regex := regexp.MustCompile(`^[\p{L}\s'-]+$`)
+	return len(split) > 1 && isNotEmpty(s) && regex.MatchString(s)
 }
 
 // IsNotFullName checks if a given value is not considered a full name. It uses the IsFullName function
@@ -631,8 +1333,316 @@ func IsFullName(a any) bool {
 //	invalidName := "John"
 //	fmt.Println(IsNotFullName(name)) // false
 //	fmt.Println(IsNotFullName(invalidName)) // true
-func IsNotFullName(a any) bool {
-	return !IsFullName(a)
+func IsNotFullName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNotFullName", time.Now(), &passed)
+	}
+	return !isFullName(a)
+}
+
+// NameOptions configures the rules applied by IsFullNameWithOptions. Zero values keep the behavior of IsFullName:
+// at least two words and no limit on the number of words or on the length.
+type NameOptions struct {
+	// MinWords is the minimum number of words, 2 when zero.
+	MinWords int
+	// MaxWords is the maximum number of words, unlimited when zero.
+	MaxWords int
+	// AllowSingle accepts names made of a single word, overriding MinWords.
+	AllowSingle bool
+	// MaxLen is the maximum number of characters, unlimited when zero.
+	MaxLen int
+}
+
+// IsFullNameWithOptions validates if a given value is a name made of unicode letters, spaces, single quotes or
+// hyphens, like IsFullName, while letting the caller configure the number of words and the maximum length. Words
+// are counted with strings.Fields and the length is counted in characters after trimming the surrounding spaces.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a name.
+//   - opts: The word count and length rules to be applied.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a name respecting the options.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsFullNameWithOptions("Suharto", NameOptions{AllowSingle: true}))       // true
+//	fmt.Println(IsFullNameWithOptions("Suharto", NameOptions{}))                        // false
+//	fmt.Println(IsFullNameWithOptions("Ana Maria de Souza", NameOptions{MaxWords: 3}))  // false
+//	fmt.Println(IsFullNameWithOptions("John Doe", NameOptions{MinWords: 2, MaxLen: 5})) // false
+func IsFullNameWithOptions(a any, opts NameOptions) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsFullNameWithOptions", time.Now(), &passed)
+	}
+	return isFullNameWithOptions(a, opts)
+}
+
+// isFullNameWithOptions is the unobserved implementation of IsFullNameWithOptions.
+func isFullNameWithOptions(a any, opts NameOptions) bool {
+	s := strings.TrimSpace(toString(a))
+	regex := regexp.MustCompile(`^[\p{L}\s'-]+$`)
+	if isEmpty(s) || !regex.MatchString(s) || (opts.MaxLen > 0 && utf8.RuneCountInString(s) > opts.MaxLen) {
+		return false
+	}
+
+	minWords := opts.MinWords
+	if opts.AllowSingle {
+		minWords = 1
+	} else if minWords <= 0 {
+		minWords = 2
+	}
+
+	words := len(strings.Fields(s))
+	return words >= minWords && (opts.MaxWords <= 0 || words <= opts.MaxWords)
+}
+
+// IsPersonName validates if a given value is the name of a person, accepting a single given name as well as a
+// full name. It uses the IsFullNameWithOptions function with the AllowSingle option, for markets where legal
+// names made of a single word are legitimate.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a person name.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a person name.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsPersonName("Suharto"))  // true
+//	fmt.Println(IsPersonName("John Doe")) // true
+//	fmt.Println(IsPersonName("John123"))  // false
+func IsPersonName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsPersonName", time.Now(), &passed)
+	}
+	return isFullNameWithOptions(a, NameOptions{AllowSingle: true})
+}
+
+// HasSurname checks if a given name has a surname, that is, at least two words where the last one is not an
+// initial. It uses the toString function to convert the value and strings.Fields to split the words, ignoring
+// trailing commas.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked for a surname.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the name has a surname.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(HasSurname("João da Silva")) // true
+//	fmt.Println(HasSurname("João S."))       // false
+//	fmt.Println(HasSurname("João"))          // false
+func HasSurname(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("HasSurname", time.Now(), &passed)
+	}
+	words := strings.Fields(toString(a))
+	if len(words) < 2 {
+		return false
+	}
+
+	last := strings.TrimRight(words[len(words)-1], ",")
+	regex := regexp.MustCompile(`^\p{L}[\p{L}'-]*\p{L}$`)
+	return regex.MatchString(last)
+}
+
+// ContainsInitial checks if a given name contains an abbreviated word, either a letter followed by a period, such
+// as "J." in "J. Silva", or a single uppercase letter, such as "E" in "Maria E Souza". Lowercase single letters are
+// not considered, since they are usually connectors, such as "e" in Portuguese names.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked for initials.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the name contains an initial.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(ContainsInitial("J. Silva"))           // true
+//	fmt.Println(ContainsInitial("Maria E Souza"))      // true
+//	fmt.Println(ContainsInitial("Pedro e Paulo Lima")) // false
+//	fmt.Println(ContainsInitial("João Silva"))         // false
+func ContainsInitial(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ContainsInitial", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`^(\p{L}\.|\p{Lu}),?$`)
+	for _, word := range strings.Fields(toString(a)) {
+		if regex.MatchString(word) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsHonorific checks if a given name contains an honorific or title, such as "Dr.", "Sra." or "Prof.", in
+// Portuguese or English. The words are compared case-insensitively, with or without the trailing period.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked for honorifics.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the name contains an honorific.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(ContainsHonorific("Dr. João Silva"))  // true
+//	fmt.Println(ContainsHonorific("sra Maria Souza")) // true
+//	fmt.Println(ContainsHonorific("Drummond Lima"))   // false
+func ContainsHonorific(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("ContainsHonorific", time.Now(), &passed)
+	}
+	for _, word := range strings.Fields(toString(a)) {
+		switch strings.ToLower(strings.TrimRight(word, ".,")) {
+		case "dr", "dra", "sr", "sra", "srta", "prof", "profa", "eng", "enga", "mr", "mrs", "ms", "mx", "miss", "rev",
+			"sir", "dame":
+			return true
+		}
+	}
+	return false
+}
+
+// IsRandomLooking checks if a given value looks machine-generated or typed at random, such as the gibberish
+// usernames and names used in fraudulent sign-ups. It is a lightweight heuristic that scores the value, ignoring
+// case and spaces, on the following signals and reports true when the score reaches 2:
+//   - a walk of 4 or more adjacent keys on a QWERTY row, forwards or backwards ("asdf", "4321"): 2 points;
+//   - a run of 6 or more consonants: 2 points, or of 5 consonants: 1 point;
+//   - a vowel proportion below 20% or above 80% of the letters, when there are at least 5 letters: 1 point;
+//   - 4 or more switches between letters and digits ("a8f3k2"): 2 points;
+//   - a normalized Shannon entropy of at least 0.97 in values with 10 or more characters: 1 point.
+//
+// Values with fewer than 4 characters are never considered random-looking.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value looks random.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsRandomLooking("xkqzvbnm"))    // true
+//	fmt.Println(IsRandomLooking("asdfgh"))      // true
+//	fmt.Println(IsRandomLooking("a8f3k2j9"))    // true
+//	fmt.Println(IsRandomLooking("Maria Silva")) // false
+func IsRandomLooking(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsRandomLooking", time.Now(), &passed)
+	}
+	s := strings.ToLower(strings.Join(strings.Fields(toString(a)), ""))
+	runes := []rune(s)
+	if len(runes) < 4 {
+		return false
+	}
+
+	score := 0
+	if hasKeyboardWalk(s, 4) {
+		score += 2
+	}
+
+	var letters, vowels, consonantRun, maxConsonantRun, switches int
+	var previous rune
+	for _, r := range runes {
+		if unicode.IsLetter(r) {
+			letters++
+			if strings.ContainsRune("aeiouyáàâãäéèêëíìîïóòôõöúùûü", r) {
+				vowels++
+				consonantRun = 0
+			} else {
+				consonantRun++
+				maxConsonantRun = max(maxConsonantRun, consonantRun)
+			}
+		} else {
+			consonantRun = 0
+		}
+		if unicode.IsLetter(r) && unicode.IsDigit(previous) || unicode.IsDigit(r) && unicode.IsLetter(previous) {
+			switches++
+		}
+		previous = r
+	}
+
+	if maxConsonantRun >= 6 {
+		score += 2
+	} else if maxConsonantRun == 5 {
+		score++
+	}
+	if letters >= 5 {
+		if ratio := float64(vowels) / float64(letters); ratio < 0.2 || ratio > 0.8 {
+			score++
+		}
+	}
+	if switches >= 4 {
+		score += 2
+	}
+	if len(runes) >= 10 && normalizedEntropy(runes) >= 0.97 {
+		score++
+	}
+	return score >= 2
+}
+
+// hasKeyboardWalk checks if s contains a sequence of at least n adjacent keys of a QWERTY row, in either direction.
+func hasKeyboardWalk(s string, n int) bool {
+	for _, row := range []string{"1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm"} {
+		reversed := []byte(row)
+		slices.Reverse(reversed)
+		for _, line := range []string{row, string(reversed)} {
+			for i := 0; i+n <= len(line); i++ {
+				if strings.Contains(s, line[i:i+n]) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// normalizedEntropy returns the Shannon entropy of the runes divided by the maximum entropy for their length, a
+// value between 0, when all the runes are equal, and 1, when they are all distinct.
+func normalizedEntropy(runes []rune) float64 {
+	counts := map[rune]int{}
+	for _, r := range runes {
+		counts[r]++
+	}
+
+	n := float64(len(runes))
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy / math.Log2(n)
 }
 
 // IsIOSDeviceID determines whether a given value adheres to the standard UUID format typically used in iOS device IDs.
@@ -655,10 +1665,18 @@ func IsNotFullName(a any) bool {
 //	id2 := "incorrect-format"
 //	fmt.Println(IsIOSDeviceID(id1)) // true
 //	fmt.Println(IsIOSDeviceID(id2)) // false
-func IsIOSDeviceID(a any) bool {
+func IsIOSDeviceID(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsIOSDeviceID", time.Now(), &passed)
+	}
+	return isIOSDeviceID(a)
+}
+
+// isIOSDeviceID is the unobserved implementation of IsIOSDeviceID.
+func isIOSDeviceID(a any) bool {
 	s := toString(a)
 	regex := regexp.MustCompile(`^[A-F0-9]{8}-[A-F0-9]{4}-[A-F0-9]{4}-[A-F0-9]{4}-[A-F0-9]{12}$`)
-	return IsNotEmpty(s) && regex.MatchString(s)
+	return isNotEmpty(s) && regex.MatchString(s)
 }
 
 // IsAndroidDeviceID determines whether a given value adheres to the standard format typically used in Android device IDs.
@@ -681,10 +1699,18 @@ func IsIOSDeviceID(a any) bool {
 //	id2 := "incorrect-format"
 //	fmt.Println(IsAndroidDeviceId(id1)) // true
 //	fmt.Println(IsAndroidDeviceId(id2)) // false
-func IsAndroidDeviceID(a any) bool {
+func IsAndroidDeviceID(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAndroidDeviceID", time.Now(), &passed)
+	}
+	return isAndroidDeviceID(a)
+}
+
+// isAndroidDeviceID is the unobserved implementation of IsAndroidDeviceID.
+func isAndroidDeviceID(a any) bool {
 	s := toString(a)
 	regex := regexp.MustCompile(`^[a-fA-F0-9]{16,}$`)
-	return IsNotEmpty(s) && regex.MatchString(s)
+	return isNotEmpty(s) && regex.MatchString(s)
 }
 
 // IsMobileDeviceID determines whether a given value is a valid Mobile Device ID.
@@ -710,8 +1736,11 @@ func IsAndroidDeviceID(a any) bool {
 //	fmt.Println(IsMobileDeviceID(id1)) // true
 //	fmt.Println(IsMobileDeviceID(id2)) // true
 //	fmt.Println(IsMobileDeviceID(id3)) // false
-func IsMobileDeviceID(a any) bool {
-	return IsIOSDeviceID(a) || IsAndroidDeviceID(a)
+func IsMobileDeviceID(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMobileDeviceID", time.Now(), &passed)
+	}
+	return isIOSDeviceID(a) || isAndroidDeviceID(a)
 }
 
 // IsMobilePlatform checks the given value, converts it to lowercase string,
@@ -732,7 +1761,38 @@ func IsMobileDeviceID(a any) bool {
 //
 //	platform := "iOS"
 //	fmt.Println(IsMobilePlatform(platform)) // true
-func IsMobilePlatform(a any) bool {
+func IsMobilePlatform(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMobilePlatform", time.Now(), &passed)
+	}
 	platform := strings.ToLower(toString(a))
 	return platform == "android" || platform == "ios" || platform == "iphone os"
 }
+
+// canonicalEmail trims the email, lowercases its domain and folds its local part as configured by the options,
+// returning false if it is not a valid email.
+func canonicalEmail(s string, opts EmailOptions) (string, bool) {
+	s = strings.TrimSpace(s)
+	if !isEmail(s) {
+		return "", false
+	}
+
+	at := strings.LastIndex(s, "@")
+	local, domain := s[:at], strings.ToLower(s[at+1:])
+	gmail := opts.FoldGmailDots && (domain == "gmail.com" || domain == "googlemail.com")
+	if gmail {
+		domain = "gmail.com"
+	}
+	if opts.FoldPlusAlias {
+		if alias := strings.Index(local, "+"); alias > 0 {
+			local = local[:alias]
+		}
+	}
+	if opts.IgnoreLocalCase || gmail {
+		local = strings.ToLower(local)
+	}
+	if gmail {
+		local = strings.ReplaceAll(local, ".", "")
+	}
+	return local + "@" + domain, true
+}
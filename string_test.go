@@ -2,6 +2,7 @@ package checker
 
 import (
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -128,6 +129,67 @@ func TestIsURLPath(t *testing.T) {
 	}
 }
 
+func TestIsURLSafe(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Unreserved", arg: "order-42_v1.2~beta", want: true},
+		{name: "Letters", arg: "abcXYZ", want: true},
+		{name: "Number", arg: 12345, want: true},
+		{name: "Space", arg: "a b", want: false},
+		{name: "Slash", arg: "a/b", want: false},
+		{name: "Encoded", arg: "a%20b", want: false},
+		{name: "Plus", arg: "a+b", want: false},
+		{name: "NonASCII", arg: "São", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsURLSafe(tc.arg); got != tc.want {
+				t.Errorf("IsURLSafe(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNeedsURLEncoding(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Unreserved", arg: "order-42", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Space", arg: "São Paulo", want: true},
+		{name: "Slash", arg: "a/b", want: true},
+		{name: "Encoded", arg: "a%20b", want: true},
+		{name: "Query", arg: "a=b&c", want: true},
+		{name: "Hash", arg: "#top", want: true},
+		{name: "Bool", arg: true, want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := NeedsURLEncoding(tc.arg); got != tc.want {
+				t.Errorf("NeedsURLEncoding(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
 func TestIsHTTPMethod(t *testing.T) {
 	testCases := []baseCase{
 		{
@@ -423,6 +485,87 @@ func TestIsEmail(t *testing.T) {
 	}
 }
 
+func TestIsSameEmail(t *testing.T) {
+	testCases := []struct {
+		name  string
+		a     any
+		b     any
+		want  bool
+		panic bool
+	}{
+		{name: "Equal", a: "user@example.com", b: "user@example.com", want: true},
+		{name: "DomainCase", a: "user@Example.COM", b: "user@example.com", want: true},
+		{name: "Whitespace", a: " user@example.com\n", b: "user@example.com", want: true},
+		{name: "LocalCase", a: "User@example.com", b: "user@example.com", want: false},
+		{name: "PlusAlias", a: "user+promo@gmail.com", b: "user@gmail.com", want: false},
+		{name: "GmailDots", a: "u.ser@gmail.com", b: "user@gmail.com", want: false},
+		{name: "DifferentDomain", a: "user@example.com", b: "user@example.org", want: false},
+		{name: "InvalidEmails", a: "invalid", b: "invalid", want: false},
+		{name: "InvalidSecond", a: "user@example.com", b: "user@", want: false},
+		{name: "Nil", a: nil, b: "user@example.com", panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsSameEmail(tc.a, tc.b); got != tc.want {
+				t.Errorf("IsSameEmail(%v, %v) = %v, want %v", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsSameEmailWithOptions(t *testing.T) {
+	folding := EmailOptions{FoldPlusAlias: true, FoldGmailDots: true}
+	testCases := []struct {
+		name  string
+		a     any
+		b     any
+		opts  EmailOptions
+		want  bool
+		panic bool
+	}{
+		{name: "ZeroValue", a: "User@example.com", b: "user@example.com", opts: EmailOptions{}, want: false},
+		{name: "IgnoreLocalCase", a: "User@example.com", b: "user@example.com",
+			opts: EmailOptions{IgnoreLocalCase: true}, want: true},
+		{name: "PlusAlias", a: "user+promo@example.com", b: "user@example.com",
+			opts: EmailOptions{FoldPlusAlias: true}, want: true},
+		{name: "PlusAliasDisabled", a: "user+promo@example.com", b: "user@example.com",
+			opts: EmailOptions{IgnoreLocalCase: true}, want: false},
+		{name: "PlusAliasOnly", a: "+promo@example.com", b: "+other@example.com",
+			opts: EmailOptions{FoldPlusAlias: true}, want: false},
+		{name: "GmailPlusAliasAndCase", a: "User+promo@gmail.com", b: "user@gmail.com", opts: folding, want: true},
+		{name: "GmailDots", a: "j.o.h.n@gmail.com", b: "john@GMAIL.com", opts: folding, want: true},
+		{name: "Googlemail", a: "j.doe@googlemail.com", b: "jdoe@gmail.com", opts: folding, want: true},
+		{name: "DotsOutsideGmail", a: "j.doe@example.com", b: "jdoe@example.com", opts: folding, want: false},
+		{name: "GmailCaseWithoutFolding", a: "User@gmail.com", b: "user@gmail.com",
+			opts: EmailOptions{FoldPlusAlias: true}, want: false},
+		{name: "InvalidEmail", a: "user", b: "user", opts: folding, want: false},
+		{name: "Nil", a: "user@gmail.com", b: nil, opts: folding, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsSameEmailWithOptions(tc.a, tc.b, tc.opts); got != tc.want {
+				t.Errorf("IsSameEmailWithOptions(%v, %v, %+v) = %v, want %v", tc.a, tc.b, tc.opts, got, tc.want)
+			}
+		})
+	}
+}
+
 func TestIsDocument(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -653,6 +796,158 @@ func TestIsBase64(t *testing.T) {
 	}
 }
 
+func TestIsBase64URL(t *testing.T) {
+	testCases := []baseCase{
+		{name: "URLSafe", arg: "PDw_Pz4-", want: true},
+		{name: "Padded", arg: "SGk=", want: true},
+		{name: "StandardAlphabet", arg: "PDw/Pz4+", want: false},
+		{name: "Unpadded", arg: "SGk", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsBase64URL(tc.arg); got != tc.want {
+				t.Errorf("IsBase64URL(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBase64RawStd(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Unpadded", arg: "SGk", want: true},
+		{name: "StandardAlphabet", arg: "PDw/Pz4+", want: true},
+		{name: "Padded", arg: "SGk=", want: false},
+		{name: "URLAlphabet", arg: "S-k", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsBase64RawStd(tc.arg); got != tc.want {
+				t.Errorf("IsBase64RawStd(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBase64RawURL(t *testing.T) {
+	testCases := []baseCase{
+		{name: "JWTHeader", arg: "eyJhbGciOiJIUzI1NiJ9", want: true},
+		{name: "URLSafe", arg: "PDw_Pz4-", want: true},
+		{name: "Padded", arg: "SGk=", want: false},
+		{name: "StandardAlphabet", arg: "PDw/Pz4+", want: false},
+		{name: "LineBreak", arg: "SGVs\nbG8", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsBase64RawURL(tc.arg); got != tc.want {
+				t.Errorf("IsBase64RawURL(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBase64WithOptions(t *testing.T) {
+	testCases := []struct {
+		name  string
+		arg   any
+		opts  Base64Options
+		want  bool
+		panic bool
+	}{
+		{name: "Default", arg: "SGk=", opts: Base64Options{}, want: true},
+		{name: "URLSafe", arg: "PDw_Pz4-", opts: Base64Options{URLSafe: true}, want: true},
+		{name: "NoPadding", arg: "SGk", opts: Base64Options{NoPadding: true}, want: true},
+		{name: "NoPaddingRejectsPadding", arg: "SGk=", opts: Base64Options{NoPadding: true}, want: false},
+		{name: "Canonical", arg: "SGk=", opts: Base64Options{Canonical: true}, want: true},
+		{name: "NonCanonical", arg: "SGl=", opts: Base64Options{Canonical: true}, want: false},
+		{name: "NonCanonicalAllowed", arg: "SGl=", opts: Base64Options{}, want: true},
+		{name: "CanonicalRawURL", arg: "SGl", opts: Base64Options{URLSafe: true, NoPadding: true, Canonical: true},
+			want: false},
+		{name: "Empty", arg: "", opts: Base64Options{}, want: false},
+		{name: "Nil", arg: nil, opts: Base64Options{}, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsBase64WithOptions(tc.arg, tc.opts); got != tc.want {
+				t.Errorf("IsBase64WithOptions(%v, %+v) = %v, want %v", tc.arg, tc.opts, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBase64OfLength(t *testing.T) {
+	testCases := []struct {
+		name       string
+		arg        any
+		decodedLen int
+		want       bool
+		panic      bool
+	}{
+		{name: "Padded", arg: "AAECAwQFBgcICQoLDA0ODw==", decodedLen: 16, want: true},
+		{name: "Unpadded", arg: "AAECAwQFBgcICQoLDA0ODw", decodedLen: 16, want: true},
+		{name: "URLSafe", arg: "-_-_", decodedLen: 3, want: true},
+		{name: "WrongLength", arg: "AAECAwQFBgcICQoLDA0ODw==", decodedLen: 32, want: false},
+		{name: "NotBase64", arg: "not base64!", decodedLen: 8, want: false},
+		{name: "Empty", arg: "", decodedLen: 0, want: false},
+		{name: "Nil", arg: nil, decodedLen: 16, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsBase64OfLength(tc.arg, tc.decodedLen); got != tc.want {
+				t.Errorf("IsBase64OfLength(%v, %v) = %v, want %v", tc.arg, tc.decodedLen, got, tc.want)
+			}
+		})
+	}
+}
+
 func TestIsBearer(t *testing.T) {
 	tests := []baseCase{
 		{
@@ -898,3 +1193,494 @@ func TestIsMobilePlatform(t *testing.T) {
 		})
 	}
 }
+
+func FuzzIsCPF(f *testing.F) {
+	for _, seed := range []string{"12101721007", "121.017.210-07", "111.111.111-11", "", "abc", "1210172100７"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if IsCPF(s) && len(removeNonDigits(s)) != 11 {
+			t.Errorf("IsCPF(%q) = true for a value without 11 digits", s)
+		}
+	})
+}
+
+func FuzzIsURL(f *testing.F) {
+	for _, seed := range []string{"https://example.com", "http://localhost:8080/path?q=1", "/relative", "", "http://exa%mple.com"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if IsURL(s) != IsURL(&s) {
+			t.Errorf("IsURL(%q) differs from IsURL(&%q)", s, s)
+		}
+	})
+}
+
+func TestIsFullNameWithOptions(t *testing.T) {
+	testCases := []struct {
+		name  string
+		arg   any
+		opts  NameOptions
+		want  bool
+		panic bool
+	}{
+		{name: "DefaultTwoWords", arg: "John Doe", opts: NameOptions{}, want: true},
+		{name: "DefaultSingleWord", arg: "Suharto", opts: NameOptions{}, want: false},
+		{name: "AllowSingle", arg: "Suharto", opts: NameOptions{AllowSingle: true}, want: true},
+		{name: "AllowSingleOverridesMinWords", arg: "Suharto", opts: NameOptions{MinWords: 3, AllowSingle: true}, want: true},
+		{name: "MinWords", arg: "Ana Maria Souza", opts: NameOptions{MinWords: 3}, want: true},
+		{name: "BelowMinWords", arg: "Ana Souza", opts: NameOptions{MinWords: 3}, want: false},
+		{name: "MaxWords", arg: "Ana Maria Souza", opts: NameOptions{MaxWords: 3}, want: true},
+		{name: "AboveMaxWords", arg: "Ana Maria de Souza", opts: NameOptions{MaxWords: 3}, want: false},
+		{name: "MaxLen", arg: "José Silva", opts: NameOptions{MaxLen: 10}, want: true},
+		{name: "AboveMaxLen", arg: "John Doe", opts: NameOptions{MaxLen: 5}, want: false},
+		{name: "SurroundingSpaces", arg: "  John Doe  ", opts: NameOptions{MaxLen: 8}, want: true},
+		{name: "Hyphen", arg: "Mary-Jane O'Neil", opts: NameOptions{}, want: true},
+		{name: "Digits", arg: "John2 Doe", opts: NameOptions{AllowSingle: true}, want: false},
+		{name: "Empty", arg: "   ", opts: NameOptions{AllowSingle: true}, want: false},
+		{name: "Nil", arg: nil, opts: NameOptions{}, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsFullNameWithOptions(tc.arg, tc.opts); got != tc.want {
+				t.Errorf("IsFullNameWithOptions(%v, %+v) = %v, want %v", tc.arg, tc.opts, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsPersonName(t *testing.T) {
+	testCases := []baseCase{
+		{name: "SingleName", arg: "Suharto", want: true},
+		{name: "FullName", arg: "John Doe", want: true},
+		{name: "Accented", arg: "Zoë", want: true},
+		{name: "Digits", arg: "John123", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsPersonName(tc.arg); got != tc.want {
+				t.Errorf("IsPersonName(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHasSurname(t *testing.T) {
+	testCases := []baseCase{
+		{name: "FullName", arg: "João da Silva", want: true},
+		{name: "Hyphenated", arg: "Ana Souza-Lima", want: true},
+		{name: "TrailingComma", arg: "Maria Souza,", want: true},
+		{name: "InitialSurname", arg: "João S.", want: false},
+		{name: "SingleLetterSurname", arg: "João S", want: false},
+		{name: "SingleName", arg: "João", want: false},
+		{name: "Digits", arg: "João 123", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := HasSurname(tc.arg); got != tc.want {
+				t.Errorf("HasSurname(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContainsInitial(t *testing.T) {
+	testCases := []baseCase{
+		{name: "InitialWithPeriod", arg: "J. Silva", want: true},
+		{name: "MiddleInitial", arg: "John F. Kennedy", want: true},
+		{name: "UppercaseLetter", arg: "Maria E Souza", want: true},
+		{name: "InitialWithComma", arg: "Silva, J.,", want: true},
+		{name: "Connector", arg: "Pedro e Paulo Lima", want: false},
+		{name: "NoInitial", arg: "João Silva", want: false},
+		{name: "Abbreviation", arg: "Dr. João", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := ContainsInitial(tc.arg); got != tc.want {
+				t.Errorf("ContainsInitial(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContainsHonorific(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Doctor", arg: "Dr. João Silva", want: true},
+		{name: "LowerCaseWithoutPeriod", arg: "sra Maria Souza", want: true},
+		{name: "English", arg: "Mrs. Jane Doe", want: true},
+		{name: "Suffix", arg: "Maria Souza, Profa.", want: true},
+		{name: "Prefix", arg: "Drummond Lima", want: false},
+		{name: "NoHonorific", arg: "João Silva", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := ContainsHonorific(tc.arg); got != tc.want {
+				t.Errorf("ContainsHonorific(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsRandomLooking(t *testing.T) {
+	testCases := []baseCase{
+		{name: "ConsonantMash", arg: "xkqzvbnm", want: true},
+		{name: "KeyboardWalk", arg: "asdfgh", want: true},
+		{name: "ReversedKeyboardWalk", arg: "Poiu Lkjh", want: true},
+		{name: "NumberWalk", arg: "user1234", want: true},
+		{name: "LettersAndDigits", arg: "a8f3k2j9", want: true},
+		{name: "HighEntropyAndConsonantRun", arg: "uiaeokzqjx", want: true},
+		{name: "FullName", arg: "Maria Silva", want: false},
+		{name: "AccentedName", arg: "João Pereira", want: false},
+		{name: "ConsonantCluster", arg: "Strength", want: false},
+		{name: "LongName", arg: "Christopher Schwarzenegger", want: false},
+		{name: "UsernameWithYear", arg: "joao2024", want: false},
+		{name: "Short", arg: "xkq", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsRandomLooking(tc.arg); got != tc.want {
+				t.Errorf("IsRandomLooking(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsStrictNumeric(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Digits", arg: "0123", want: true},
+		{name: "Int", arg: 123, want: true},
+		{name: "Negative", arg: "-123", want: false},
+		{name: "Decimal", arg: "1.5", want: false},
+		{name: "Garbage", arg: "+-+", want: false},
+		{name: "Space", arg: "12 3", want: false},
+		{name: "UnicodeDigit", arg: "١٢٣", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsStrictNumeric(tc.arg); got != tc.want {
+				t.Errorf("IsStrictNumeric(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsSignedNumeric(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Negative", arg: "-123", want: true},
+		{name: "Positive", arg: "+123", want: true},
+		{name: "Unsigned", arg: "123", want: true},
+		{name: "NegativeInt", arg: -5, want: true},
+		{name: "DoubleSign", arg: "+-1", want: false},
+		{name: "OnlySign", arg: "-", want: false},
+		{name: "TrailingSign", arg: "1-", want: false},
+		{name: "Decimal", arg: "1.5", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsSignedNumeric(tc.arg); got != tc.want {
+				t.Errorf("IsSignedNumeric(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsDecimalString(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Decimal", arg: "-1.5", want: true},
+		{name: "Integer", arg: "42", want: true},
+		{name: "LeadingDot", arg: ".5", want: true},
+		{name: "Float", arg: 3.25, want: true},
+		{name: "TrailingDot", arg: "1.", want: false},
+		{name: "TwoDots", arg: "1.2.3", want: false},
+		{name: "Garbage", arg: "1.2.3-", want: false},
+		{name: "OnlyDot", arg: ".", want: false},
+		{name: "Comma", arg: "1,5", want: false},
+		{name: "Exponent", arg: "1e5", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsDecimalString(tc.arg); got != tc.want {
+				t.Errorf("IsDecimalString(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsScientificNotation(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Lower", arg: "6.022e23", want: true},
+		{name: "UpperNegative", arg: "-1E-9", want: true},
+		{name: "IntegerMantissa", arg: "1e10", want: true},
+		{name: "PositiveExponent", arg: "2.5e+3", want: true},
+		{name: "LargeFloat", arg: 1e21, want: true},
+		{name: "NoExponent", arg: "1.5", want: false},
+		{name: "NoMantissa", arg: "e10", want: false},
+		{name: "DecimalExponent", arg: "1e1.5", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsScientificNotation(tc.arg); got != tc.want {
+				t.Errorf("IsScientificNotation(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsTraceParent(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Sampled", arg: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", want: true},
+		{name: "NotSampled", arg: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00", want: true},
+		{name: "FutureVersionWithExtraField", arg: "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", want: true},
+		{name: "VersionZeroWithExtraField", arg: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", want: false},
+		{name: "InvalidVersion", arg: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", want: false},
+		{name: "ZeroTraceID", arg: "00-00000000000000000000000000000000-00f067aa0ba902b7-01", want: false},
+		{name: "ZeroParentID", arg: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01", want: false},
+		{name: "UpperCase", arg: "00-4BF92F3577B34DA6A3CE929D0E0E4736-00F067AA0BA902B7-01", want: false},
+		{name: "ShortTraceID", arg: "00-4bf92f3577b34da6-00f067aa0ba902b7-01", want: false},
+		{name: "MissingFlags", arg: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsTraceParent(tc.arg); got != tc.want {
+				t.Errorf("IsTraceParent(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsTraceID(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Valid", arg: "4bf92f3577b34da6a3ce929d0e0e4736", want: true},
+		{name: "AllZeros", arg: "00000000000000000000000000000000", want: false},
+		{name: "UpperCase", arg: "4BF92F3577B34DA6A3CE929D0E0E4736", want: false},
+		{name: "Short", arg: "4bf92f3577b34da6", want: false},
+		{name: "NonHex", arg: "4bf92f3577b34da6a3ce929d0e0e473g", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsTraceID(tc.arg); got != tc.want {
+				t.Errorf("IsTraceID(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsSpanID(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Valid", arg: "00f067aa0ba902b7", want: true},
+		{name: "AllZeros", arg: "0000000000000000", want: false},
+		{name: "Short", arg: "00f067aa0ba902", want: false},
+		{name: "TraceID", arg: "4bf92f3577b34da6a3ce929d0e0e4736", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsSpanID(tc.arg); got != tc.want {
+				t.Errorf("IsSpanID(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsRequestIDHeaderSafe(t *testing.T) {
+	testCases := []baseCase{
+		{name: "UUID", arg: "f47ac10b-58cc-4372-a567-0e02b2c3d479", want: true},
+		{name: "Symbols", arg: "req_01HZX:abc/def+ghi=", want: true},
+		{name: "Int", arg: 12345, want: true},
+		{name: "MaxLength", arg: strings.Repeat("a", 200), want: true},
+		{name: "TooLong", arg: strings.Repeat("a", 201), want: false},
+		{name: "HeaderInjection", arg: "abc\r\nSet-Cookie: x=1", want: false},
+		{name: "Space", arg: "abc def", want: false},
+		{name: "NonASCII", arg: "pedido-açaí", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsRequestIDHeaderSafe(tc.arg); got != tc.want {
+				t.Errorf("IsRequestIDHeaderSafe(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsHeaderValueSafe(t *testing.T) {
+	testCases := []baseCase{
+		{name: "MediaType", arg: "text/html; charset=utf-8", want: true},
+		{name: "Token", arg: "Bearer abc.def", want: true},
+		{name: "InnerTab", arg: "a\tb", want: true},
+		{name: "Empty", arg: "", want: true},
+		{name: "SingleChar", arg: "x", want: true},
+		{name: "CRLFInjection", arg: "abc\r\nSet-Cookie: x=1", want: false},
+		{name: "LineFeed", arg: "a\nb", want: false},
+		{name: "NullByte", arg: "a\x00b", want: false},
+		{name: "Delete", arg: "a\x7fb", want: false},
+		{name: "LeadingSpace", arg: " padded", want: false},
+		{name: "TrailingTab", arg: "padded\t", want: false},
+		{name: "NonASCII", arg: "São Paulo", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsHeaderValueSafe(tc.arg); got != tc.want {
+				t.Errorf("IsHeaderValueSafe(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// PlaceholderStyle represents a custom type for the placeholder syntaxes used in templates.
+type PlaceholderStyle string
+
+const (
+	// PlaceholderStyleMustache represents a constant of type PlaceholderStyle that indicates "{{name}}" placeholders.
+	PlaceholderStyleMustache PlaceholderStyle = "MUSTACHE"
+	// PlaceholderStyleBrace represents a constant of type PlaceholderStyle that indicates "{name}" placeholders.
+	PlaceholderStyleBrace PlaceholderStyle = "BRACE"
+	// PlaceholderStyleDollar represents a constant of type PlaceholderStyle that indicates "${name}" placeholders.
+	PlaceholderStyleDollar PlaceholderStyle = "DOLLAR"
+)
+
+// IsEnumValid returns whether the placeholder style is one of the PlaceholderStyle constants.
+func (p PlaceholderStyle) IsEnumValid() bool {
+	switch p {
+	case PlaceholderStyleMustache, PlaceholderStyleBrace, PlaceholderStyleDollar:
+		return true
+	}
+	return false
+}
+
+// HasBalancedBraces checks if every opening brace of a given value is closed by a later closing brace and every
+// closing brace matches an earlier opening one, allowing nesting, as in "Hello {{name}}".
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked for balanced braces.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the braces of the value are balanced.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(HasBalancedBraces("Hello {{name}}, you have {count} messages")) // true
+//	fmt.Println(HasBalancedBraces("Hello {{name}"))                             // false
+//	fmt.Println(HasBalancedBraces("Hello }name{"))                              // false
+func HasBalancedBraces(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("HasBalancedBraces", time.Now(), &passed)
+	}
+	return hasBalancedBraces(a)
+}
+
+// hasBalancedBraces is the unobserved implementation of HasBalancedBraces.
+func hasBalancedBraces(a any) bool {
+	depth := 0
+	for _, r := range toString(a) {
+		if r == '{' {
+			depth++
+		} else if r == '}' {
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+	}
+	return depth == 0
+}
+
+// ListPlaceholders returns the names of the placeholders of the given style found in a given value, in order of
+// first appearance and without duplicates. Whitespace around a name is ignored, so "{{ name }}" and "{{name}}"
+// both yield "name". With PlaceholderStyleBrace, the braces of mustache placeholders are not mistaken for
+// single brace placeholders.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and searched for placeholders.
+//   - style: The PlaceholderStyle of the placeholders to list.
+//
+// Returns:
+//   - []string: The names of the placeholders found, or nil if there are none.
+//
+// Panic:
+//   - The function will panic if the style is not one of the PlaceholderStyle constants.
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(ListPlaceholders("Hello {{name}}, {{ name }} owes {{amount}}", PlaceholderStyleMustache)) // [name amount]
+//	fmt.Println(ListPlaceholders("Hello {name}", PlaceholderStyleBrace))                                  // [name]
+//	fmt.Println(ListPlaceholders("Hello ${user.name}", PlaceholderStyleDollar))                           // [user.name]
+func ListPlaceholders(a any, style PlaceholderStyle) []string {
+	var regex *regexp.Regexp
+	switch style {
+	case PlaceholderStyleMustache:
+		regex = regexp.MustCompile(`\{\{\s*([^{}\s]+)\s*\}\}`)
+	case PlaceholderStyleBrace:
+		regex = regexp.MustCompile(`\{\s*([^{}\s]+)\s*\}`)
+	case PlaceholderStyleDollar:
+		regex = regexp.MustCompile(`\$\{\s*([^{}\s]+)\s*\}`)
+	default:
+		panic(fmt.Sprintf("Unsupported placeholder style: %s", style))
+	}
+
+	s := toString(a)
+	var names []string
+	for _, match := range regex.FindAllStringSubmatchIndex(s, -1) {
+		if style == PlaceholderStyleBrace &&
+			((match[0] > 0 && strings.ContainsRune("{$", rune(s[match[0]-1]))) || (match[1] < len(s) && s[match[1]] == '}')) {
+			continue
+		}
+		name := s[match[2]:match[3]]
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// PlaceholdersSubsetOf checks if a given template has balanced braces (see HasBalancedBraces) and only uses
+// "{{name}}" placeholders (see ListPlaceholders) whose names are in the allowed list. Any other "{{" span, such as
+// "{{name | upper}}" or "{{ user password }}", fails the check, as template engines may still evaluate it. A
+// template without placeholders is accepted.
+//
+// Parameters:
+//   - template: Any value to be converted into a string and checked as a template.
+//   - allowed: The placeholder names the template may use.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the template only uses allowed placeholders.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(PlaceholdersSubsetOf("Hello {{name}}", "name", "email"))  // true
+//	fmt.Println(PlaceholdersSubsetOf("Hello {{token}}", "name", "email")) // false
+//	fmt.Println(PlaceholdersSubsetOf("Hello {{name}", "name"))            // false
+//	fmt.Println(PlaceholdersSubsetOf("Hello {{name | upper}}", "name"))   // false
+func PlaceholdersSubsetOf(template any, allowed ...string) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("PlaceholdersSubsetOf", time.Now(), &passed)
+	}
+	if !hasBalancedBraces(template) {
+		return false
+	}
+
+	s := toString(template)
+	regex := regexp.MustCompile(`^\{\{\s*([^{}\s]+)\s*\}\}`)
+	for i := strings.Index(s, "{{"); i >= 0; i = strings.Index(s, "{{") {
+		match := regex.FindStringSubmatch(s[i:])
+		if match == nil || !slices.Contains(allowed, match[1]) {
+			return false
+		}
+		s = s[i+len(match[0]):]
+	}
+	return true
+}
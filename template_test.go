@@ -0,0 +1,119 @@
+package checker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHasBalancedBraces(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Mustache", arg: "Hello {{name}}", want: true},
+		{name: "Mixed", arg: "Hello {{name}}, you have {count} messages", want: true},
+		{name: "NoBraces", arg: "Hello", want: true},
+		{name: "Unclosed", arg: "Hello {{name}", want: false},
+		{name: "ClosedFirst", arg: "Hello }name{", want: false},
+		{name: "Empty", arg: "", want: true},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := HasBalancedBraces(tc.arg); got != tc.want {
+				t.Errorf("HasBalancedBraces(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestListPlaceholders(t *testing.T) {
+	testCases := []struct {
+		name  string
+		arg   any
+		style PlaceholderStyle
+		want  []string
+		panic bool
+	}{
+		{name: "Mustache", arg: "Hello {{name}}, {{ name }} owes {{amount}}", style: PlaceholderStyleMustache,
+			want: []string{"name", "amount"}},
+		{name: "Brace", arg: "Hello {name}, you have {count} messages", style: PlaceholderStyleBrace,
+			want: []string{"name", "count"}},
+		{name: "BraceIgnoresMustache", arg: "Hello {{name}} and {other}", style: PlaceholderStyleBrace,
+			want: []string{"other"}},
+		{name: "BraceIgnoresDollar", arg: "Hello ${name}", style: PlaceholderStyleBrace, want: nil},
+		{name: "Dollar", arg: "Hello ${user.name}", style: PlaceholderStyleDollar, want: []string{"user.name"}},
+		{name: "None", arg: "Hello", style: PlaceholderStyleMustache, want: nil},
+		{name: "InvalidStyle", arg: "Hello", style: PlaceholderStyle("PERCENT"), panic: true},
+		{name: "Nil", arg: nil, style: PlaceholderStyleMustache, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := ListPlaceholders(tc.arg, tc.style); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ListPlaceholders(%v, %v) = %v, want %v", tc.arg, tc.style, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPlaceholdersSubsetOf(t *testing.T) {
+	testCases := []struct {
+		name     string
+		template any
+		allowed  []string
+		want     bool
+		panic    bool
+	}{
+		{name: "Allowed", template: "Hello {{name}}", allowed: []string{"name", "email"}, want: true},
+		{name: "NoPlaceholders", template: "Hello", allowed: nil, want: true},
+		{name: "NotAllowed", template: "Hello {{token}}", allowed: []string{"name", "email"}, want: false},
+		{name: "Unbalanced", template: "Hello {{name}", allowed: []string{"name"}, want: false},
+		{name: "NoneAllowed", template: "Hello {{name}}", allowed: nil, want: false},
+		{name: "Spaced", template: "Hi {{ name }} and {{email}}", allowed: []string{"name", "email"}, want: true},
+		{name: "SingleBraces", template: "Hi {name}", allowed: nil, want: true},
+		{name: "Filter", template: "Hi {{secret | upper}}", allowed: []string{"secret"}, want: false},
+		{name: "Expression", template: "{{ user password }}", allowed: []string{"user", "password"}, want: false},
+		{name: "Empty", template: "Hi {{}}", allowed: []string{"name"}, want: false},
+		{name: "Nested", template: "Hi {{a{b}c}}", allowed: []string{"a", "b", "c"}, want: false},
+		{name: "Triple", template: "Hi {{{name}}}", allowed: []string{"name"}, want: false},
+		{name: "Nil", template: nil, allowed: []string{"name"}, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := PlaceholdersSubsetOf(tc.template, tc.allowed...); got != tc.want {
+				t.Errorf("PlaceholdersSubsetOf(%v, %v) = %v, want %v", tc.template, tc.allowed, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPlaceholderStyleIsEnumValid(t *testing.T) {
+	if !IsEnumValid(PlaceholderStyleDollar) {
+		t.Errorf("IsEnumValid(%v) = false, want true", PlaceholderStyleDollar)
+	}
+	if IsEnumValid(PlaceholderStyle("PERCENT")) {
+		t.Errorf("IsEnumValid(PERCENT) = true, want false")
+	}
+}
@@ -22,6 +22,11 @@
 
 package checker
 
+import (
+	"fmt"
+	"time"
+)
+
 // IsBeforeNow determines whether a given time is before the current time. It uses
 // the toTime function to convert the provided value to a time.Time object, and
 // compares the result with the current time (obtained via timeNow). If the
@@ -45,14 +50,18 @@ package checker
 //	var b string = "2045-04-23T18:25:43.511Z" // RFC3339 formatted string
 //	fmt.Println(IsBeforeNow(a)) // Will return true if the current time is after January 1, 2021 00:00:00
 //	fmt.Println(IsBeforeNow(b)) // Will return true if the current time is before April 23, 2045 18:25:43.511 UTC
-func IsBeforeNow(a any) bool {
+func IsBeforeNow(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBeforeNow", time.Now(), &passed)
+	}
 	return toTime(a).Before(timeNow())
 }
 
 // IsBeforeToday determines whether a given time is before the current date without considering time components. It uses
 // the toDate function to convert the provided value to a time.Time object and adjusts it to midnight,
 // then compares the result with the current date (obtained via dateNow). If the converted date is before the current date,
-// IsBeforeToday returns true.
+// IsBeforeToday returns true. Dates are compared by calendar day, the date of the value in its own location against
+// the current date in the local time zone, so "2024-01-01" is before today from 2024-01-02 local time onwards.
 //
 // Parameters:
 //   - a: Any value to be converted into a time.Time object and compared with the current date.
@@ -71,8 +80,11 @@ func IsBeforeNow(a any) bool {
 //	var b string = "2045-04-23T18:25:43.511Z" // RFC3339 formatted string
 //	fmt.Println(IsBeforeToday(a)) // Will return true if the current date is after January 1, 2021
 //	fmt.Println(IsBeforeToday(b)) // Will return true if the current date is before April 23, 2045
-func IsBeforeToday(a any) bool {
-	return toDate(a).Before(dateNow())
+func IsBeforeToday(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBeforeToday", time.Now(), &passed)
+	}
+	return calendarDate(toTime(a)).Before(dateNow())
 }
 
 // IsBeforeDate determines whether the date represented by the first argument is before the date
@@ -96,7 +108,10 @@ func IsBeforeToday(a any) bool {
 //	t2 := time.Now()
 //	fmt.Println(IsBeforeDate(t1, t2)) // true if t1 is before the current date
 //	fmt.Println(IsBeforeDate(t2, t1)) // false if the current date is before t1
-func IsBeforeDate(a, b any) bool {
+func IsBeforeDate(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBeforeDate", time.Now(), &passed)
+	}
 	return toDate(a).Before(toDate(b))
 }
 
@@ -125,7 +140,15 @@ func IsBeforeDate(a, b any) bool {
 //	fmt.Println(IsBefore(t1, t2))   // Will return true if January 1, 2020 is before January 1, 2022
 //	fmt.Println(IsBefore(t2, t3))   // Will return true if the provided date is before the current time
 //	fmt.Println(IsBefore(t1, t3))   // Will return true if January 1, 2020 is before the current time
-func IsBefore(a, b any) bool {
+func IsBefore(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBefore", time.Now(), &passed)
+	}
+	return isBefore(a, b)
+}
+
+// isBefore is the unobserved implementation of IsBefore.
+func isBefore(a, b any) bool {
 	return toTime(a).Before(toTime(b))
 }
 
@@ -151,14 +174,17 @@ func IsBefore(a, b any) bool {
 //	t := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 //	fmt.Println(IsAfterNow(t)) // Example output: true
 //	fmt.Println(IsAfterNow(time.Now().Add(-time.Hour))) // Example output: false
-func IsAfterNow(a any) bool {
+func IsAfterNow(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAfterNow", time.Now(), &passed)
+	}
 	return toTime(a).After(timeNow())
 }
 
 // IsAfterToday determines whether a given time is after today. It uses the toDate function
 // to convert the provided value to a time.Time object at midnight, and compares the result
 // with the current date (obtained via dateNow function). If the converted time is after
-// today, IsAfterToday returns true.
+// today, IsAfterToday returns true. Dates are compared by calendar day, as IsToday does.
 // Parameters:
 //   - a: Any value to be converted into a time.Time object for comparison.
 //
@@ -176,8 +202,11 @@ func IsAfterNow(a any) bool {
 //	var b string = "2021-02-28T18:00:00Z" // RFC3339 formatted string
 //	fmt.Println(IsAfterToday(a)) // Will return true if the current date is after February 28, 2021
 //	fmt.Println(IsAfterToday(b)) // Will return false if the current date is before or on February 28, 2021
-func IsAfterToday(a any) bool {
-	return toDate(a).After(dateNow())
+func IsAfterToday(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAfterToday", time.Now(), &passed)
+	}
+	return calendarDate(toTime(a)).After(dateNow())
 }
 
 // IsAfterDate determines whether the first provided date is after the second provided date.
@@ -199,7 +228,10 @@ func IsAfterToday(a any) bool {
 //	var a int64 = 1609459200 // Unix timestamp for January 1, 2021 00:00:00
 //	var b string = "2045-04-23T18:25:43.511Z" // RFC3339 formatted string
 //	fmt.Println(IsAfterDate(a, b)) // Will return true if January 1, 2021 00:00:00 is after April 23, 2045 18:25:43.511 UTC
-func IsAfterDate(a, b any) bool {
+func IsAfterDate(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAfterDate", time.Now(), &passed)
+	}
 	return toDate(a).After(toDate(b))
 }
 
@@ -224,13 +256,23 @@ func IsAfterDate(a, b any) bool {
 //	var a int64 = 1609459200 // Unix timestamp for January 1, 2021 00:00:00
 //	var b string = "2045-04-23T18:25:43.511Z" // RFC3339 formatted string
 //	fmt.Println(IsAfter(a, b)) // Will return true if the first time is after April 23, 2045 18:25:43.511 UTC
-func IsAfter(a, b any) bool {
+func IsAfter(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAfter", time.Now(), &passed)
+	}
+	return isAfter(a, b)
+}
+
+// isAfter is the unobserved implementation of IsAfter.
+func isAfter(a, b any) bool {
 	return toTime(a).After(toTime(b))
 }
 
 // IsToday checks whether the provided value represents the current date. This function
 // converts the passed value to a time.Time format by calling toDate function and then
-// compares that date with the current date using Equal function from time package.
+// compares that date with the current date. Dates are compared by calendar day, the date of the value in its own
+// location against the current date in the local time zone, so "2024-01-01", parsed as UTC, is today during the
+// whole local day of January 1, 2024, whatever the UTC offset.
 //
 // Parameters:
 //   - a: This parameter can be any value that can be converted into a time.Time format.
@@ -250,6 +292,386 @@ func IsAfter(a, b any) bool {
 //
 //	t2 := time.Now().Add(-24 * time.Hour)
 //	fmt.Println(IsToday(t2)) // false
-func IsToday(a any) bool {
-	return toDate(a).Equal(dateNow())
+func IsToday(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsToday", time.Now(), &passed)
+	}
+	return calendarDate(toTime(a)).Equal(dateNow())
+}
+
+// TimeOptions configures how IsBeforeNowWithOptions and IsTodayWithOptions treat unset times. Zero values keep
+// the behavior of IsBeforeNow and IsToday, which compare the zero time.Time as 0001-01-01 00:00:00 UTC.
+type TimeOptions struct {
+	// ZeroIsUnset makes the zero time.Time, such as an optional date that was never filled, and nil values, such
+	// as a nil *time.Time or an invalid sql.NullTime, count as unset and fail the check instead of being compared
+	// or panicking.
+	ZeroIsUnset bool
+}
+
+// IsZeroTime checks whether the provided value represents the zero time.Time, 0001-01-01 00:00:00 UTC, the value
+// of a time.Time field that was never set. Numbers are converted as UnixMilli timestamps, so 0 is 1970-01-01 and
+// is not the zero time. Values implementing driver.Valuer, such as a valid sql.NullTime, are checked through the
+// time they hold.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the provided value is the zero time.
+//
+// Panic:
+//
+//	This function will panic if it's unable to convert the provided value into a time.Time format.
+//
+// Example:
+//
+//	fmt.Println(IsZeroTime(time.Time{}))               // true
+//	fmt.Println(IsZeroTime("0001-01-01T00:00:00Z"))    // true
+//	fmt.Println(IsZeroTime(time.Now()))                // false
+//	fmt.Println(IsZeroTime(0))                         // false
+//	fmt.Println(IsZeroTime(sql.NullTime{Valid: true})) // true
+func IsZeroTime(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsZeroTime", time.Now(), &passed)
+	}
+	return toTime(a).IsZero()
+}
+
+// IsBeforeNowWithOptions determines whether a given time is before the current time, like IsBeforeNow, treating
+// unset times as configured by opts.
+//
+// Parameters:
+//   - a: Any value to be converted into a time.Time object for comparison.
+//   - opts: The options controlling how unset times are treated.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the provided time is set, when required by opts, and before the
+//     current time.
+//
+// Panic:
+//
+//	This function will panic if the provided value cannot be converted to a time.Time
+//	object through the toTime() function and is not unset under opts.
+//
+// Example:
+//
+//	fmt.Println(IsBeforeNowWithOptions(time.Time{}, TimeOptions{}))                        // true
+//	fmt.Println(IsBeforeNowWithOptions(time.Time{}, TimeOptions{ZeroIsUnset: true}))       // false
+//	fmt.Println(IsBeforeNowWithOptions((*time.Time)(nil), TimeOptions{ZeroIsUnset: true})) // false
+func IsBeforeNowWithOptions(a any, opts TimeOptions) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBeforeNowWithOptions", time.Now(), &passed)
+	}
+	return !isUnsetTime(a, opts) && toTime(a).Before(timeNow())
+}
+
+// IsTodayWithOptions checks whether the provided value represents the current date, like IsToday, treating unset
+// times as configured by opts.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format.
+//   - opts: The options controlling how unset times are treated.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the provided time is set, when required by opts, and on the current
+//     date.
+//
+// Panic:
+//
+//	This function will panic if it's unable to convert the provided value into a time.Time format and the value is
+//	not unset under opts.
+//
+// Example:
+//
+//	fmt.Println(IsTodayWithOptions(time.Now(), TimeOptions{ZeroIsUnset: true}))     // true
+//	fmt.Println(IsTodayWithOptions(time.Time{}, TimeOptions{ZeroIsUnset: true}))    // false
+//	fmt.Println(IsTodayWithOptions(sql.NullTime{}, TimeOptions{ZeroIsUnset: true})) // false
+func IsTodayWithOptions(a any, opts TimeOptions) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsTodayWithOptions", time.Now(), &passed)
+	}
+	return !isUnsetTime(a, opts) && calendarDate(toTime(a)).Equal(dateNow())
+}
+
+// isUnsetTime reports whether opts makes a given value count as unset, being nil or the zero time.Time.
+func isUnsetTime(a any, opts TimeOptions) bool {
+	return opts.ZeroIsUnset && (isNil(a) || toTime(a).IsZero())
+}
+
+// BirthDateOptions configures IsPlausibleBirthDateWithOptions. The zero value matches IsPlausibleBirthDate.
+type BirthDateOptions struct {
+	// MinAge is the minimum age in full years the person must have reached today, such as 18 for adult-only
+	// services. It is not checked when zero.
+	MinAge int
+}
+
+// IsPlausibleBirthDate checks whether the provided value is a plausible date of birth of a living person: not
+// after today and not more than 130 years ago. Only the calendar date is considered, so a person born today is
+// accepted whatever the time of birth.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the provided date is a plausible date of birth.
+//
+// Panic:
+//
+//	This function will panic if it's unable to convert the provided value into a time.Time format through the
+//	toDate function.
+//
+// Example:
+//
+//	fmt.Println(IsPlausibleBirthDate("1990-05-17"))                // true
+//	fmt.Println(IsPlausibleBirthDate(time.Now()))                  // true
+//	fmt.Println(IsPlausibleBirthDate(time.Now().AddDate(0, 0, 1))) // false
+//	fmt.Println(IsPlausibleBirthDate("1850-01-01"))                // false
+func IsPlausibleBirthDate(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsPlausibleBirthDate", time.Now(), &passed)
+	}
+	return isPlausibleBirthDateWithOptions(a, BirthDateOptions{})
+}
+
+// IsPlausibleBirthDateWithOptions checks whether the provided value is a plausible date of birth, like
+// IsPlausibleBirthDate, of a person who has reached the minimum age configured by opts. Ages are counted in full
+// years, so a person born on February 29 reaches a new age on March 1 of common years.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format.
+//   - opts: The options of the check, such as the minimum age.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the provided date is a plausible date of birth of a person of at
+//     least the minimum age.
+//
+// Panic:
+//
+//	This function will panic if it's unable to convert the provided value into a time.Time format through the
+//	toDate function.
+//
+// Example:
+//
+//	adult := BirthDateOptions{MinAge: 18}
+//	fmt.Println(IsPlausibleBirthDateWithOptions("1990-05-17", adult))                  // true
+//	fmt.Println(IsPlausibleBirthDateWithOptions(time.Now().AddDate(-17, 0, 0), adult)) // false
+//	fmt.Println(IsPlausibleBirthDateWithOptions(time.Now().AddDate(-18, 0, 0), adult)) // true
+func IsPlausibleBirthDateWithOptions(a any, opts BirthDateOptions) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsPlausibleBirthDateWithOptions", time.Now(), &passed)
+	}
+	return isPlausibleBirthDateWithOptions(a, opts)
+}
+
+// isPlausibleBirthDateWithOptions is the unobserved implementation of IsPlausibleBirthDateWithOptions.
+func isPlausibleBirthDateWithOptions(a any, opts BirthDateOptions) bool {
+	today := dateNow()
+	birth := calendarDate(toTime(a))
+
+	return !birth.After(today) && !birth.Before(today.AddDate(-130, 0, 0)) &&
+		!birth.AddDate(opts.MinAge, 0, 0).After(today)
+}
+
+// IsMultipleOfDuration checks whether the given duration is a whole multiple of the unit, such as a meeting length
+// that must be a multiple of 15 minutes. The value is converted with toDuration, so it may be a time.Duration, a
+// string such as "45m" or a number of nanoseconds. Zero and negative multiples pass.
+//
+// Parameters:
+//   - d: Any value that can be converted into a time.Duration.
+//   - unit: The positive duration the value must be a multiple of.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the duration is a multiple of the unit.
+//
+// Panic:
+//   - The function will panic if the unit is not positive, or if the value cannot be converted into a
+//     time.Duration through the toDuration function.
+//
+// Example:
+//
+//	fmt.Println(IsMultipleOfDuration("45m", 15*time.Minute))     // true
+//	fmt.Println(IsMultipleOfDuration(90*time.Minute, time.Hour)) // false
+//	fmt.Println(IsMultipleOfDuration("1h30m", 30*time.Minute))   // true
+func IsMultipleOfDuration(d any, unit time.Duration) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMultipleOfDuration", time.Now(), &passed)
+	}
+	if unit <= 0 {
+		panic(fmt.Sprintf("Invalid duration unit: %s", unit))
+	}
+	return toDuration(d)%unit == 0
+}
+
+// AlignsToInterval checks whether the given time falls on a boundary of the interval, such as the start of a
+// 5-minute bucket of a metrics rollup or a slot of a schedule. Boundaries are counted from the zero time, as
+// time.Truncate does, so intervals that divide a day fall on UTC midnights, whatever the location of the time.
+//
+// Parameters:
+//   - t: Any value that can be converted into a time.Time format.
+//   - interval: The positive length of the intervals.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the time is on an interval boundary.
+//
+// Panic:
+//   - The function will panic if the interval is not positive, or if the value cannot be converted into a
+//     time.Time through the toTime function.
+//
+// Example:
+//
+//	fmt.Println(AlignsToInterval("2024-01-01T10:15:00Z", 5*time.Minute)) // true
+//	fmt.Println(AlignsToInterval("2024-01-01T10:17:00Z", 5*time.Minute)) // false
+//	fmt.Println(AlignsToInterval("2024-01-01T10:00:00.5Z", time.Second)) // false
+func AlignsToInterval(t any, interval time.Duration) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("AlignsToInterval", time.Now(), &passed)
+	}
+	if interval <= 0 {
+		panic(fmt.Sprintf("Invalid interval: %s", interval))
+	}
+	value := toTime(t)
+	return value.Truncate(interval).Equal(value)
+}
+
+// IsTodayIn checks whether the provided value represents the current date in the location loc, such as the time
+// zone of a customer, instead of the local time zone of the server as IsToday does. Strings without time zone
+// information, such as "2024-01-01", are interpreted in loc, and the other values, such as a time.Time or an
+// RFC 3339 string with an offset, are converted to loc before their calendar date is taken. A customer in
+// São Paulo submitting "2024-01-01" at 23:30 local time then passes, although it is already January 2 in UTC.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format.
+//   - loc: The location the current date and the value are evaluated in.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is on the current date in loc.
+//
+// Panic:
+//
+//	This function will panic if loc is nil, or if it's unable to convert the provided value into a time.Time
+//	format.
+//
+// Example:
+//
+//	saoPaulo, _ := time.LoadLocation("America/Sao_Paulo")
+//	fmt.Println(IsTodayIn(time.Now(), saoPaulo))                   // true
+//	fmt.Println(IsTodayIn(time.Now().In(time.UTC), saoPaulo))      // true
+//	fmt.Println(IsTodayIn(time.Now().AddDate(0, 0, -1), saoPaulo)) // false
+func IsTodayIn(a any, loc *time.Location) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsTodayIn", time.Now(), &passed)
+	}
+	return calendarDate(toTimeIn(a, loc)).Equal(dateNowIn(loc))
+}
+
+// IsBeforeTodayIn checks whether the provided value is on a date before the current date in the location loc,
+// converting the value as IsTodayIn does.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format.
+//   - loc: The location the current date and the value are evaluated in.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is before the current date in loc.
+//
+// Panic:
+//
+//	This function will panic if loc is nil, or if it's unable to convert the provided value into a time.Time
+//	format.
+//
+// Example:
+//
+//	saoPaulo, _ := time.LoadLocation("America/Sao_Paulo")
+//	fmt.Println(IsBeforeTodayIn(time.Now().AddDate(0, 0, -1), saoPaulo)) // true
+//	fmt.Println(IsBeforeTodayIn(time.Now(), saoPaulo))                   // false
+func IsBeforeTodayIn(a any, loc *time.Location) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBeforeTodayIn", time.Now(), &passed)
+	}
+	return calendarDate(toTimeIn(a, loc)).Before(dateNowIn(loc))
+}
+
+// IsAfterTodayIn checks whether the provided value is on a date after the current date in the location loc,
+// converting the value as IsTodayIn does.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format.
+//   - loc: The location the current date and the value are evaluated in.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is after the current date in loc.
+//
+// Panic:
+//
+//	This function will panic if loc is nil, or if it's unable to convert the provided value into a time.Time
+//	format.
+//
+// Example:
+//
+//	saoPaulo, _ := time.LoadLocation("America/Sao_Paulo")
+//	fmt.Println(IsAfterTodayIn(time.Now().AddDate(0, 0, 1), saoPaulo)) // true
+//	fmt.Println(IsAfterTodayIn(time.Now(), saoPaulo))                  // false
+func IsAfterTodayIn(a any, loc *time.Location) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsAfterTodayIn", time.Now(), &passed)
+	}
+	return calendarDate(toTimeIn(a, loc)).After(dateNowIn(loc))
+}
+
+// IsSameInstant checks whether two values represent the same instant once converted with toTime, whatever their
+// time zones or formats, so "2024-01-01T10:00:00Z" and "2024-01-01T07:00:00-03:00" are the same instant. The
+// comparison uses time.Time.Equal, which ignores the monotonic clock reading of times returned by time.Now.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format.
+//   - b: Any value that can be converted into a time.Time format.
+//
+// Returns:
+//   - bool: A boolean value indicating whether both values are the same instant.
+//
+// Panic:
+//
+//	This function will panic if it's unable to convert the provided values into a time.Time format.
+//
+// Example:
+//
+//	fmt.Println(IsSameInstant("2024-01-01T10:00:00Z", "2024-01-01T07:00:00-03:00")) // true
+//	fmt.Println(IsSameInstant("2024-01-01T10:00:00Z", int64(1704103200000)))        // true
+//	fmt.Println(IsSameInstant("2024-01-01T10:00:00Z", "2024-01-01T10:00:01Z"))      // false
+func IsSameInstant(a, b any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSameInstant", time.Now(), &passed)
+	}
+	return toTime(a).Equal(toTime(b))
+}
+
+// IsWithinDurationOf checks whether two values, converted with toTime, are at most d apart in either direction,
+// such as the timestamp of a signed request and the current time in anti-replay checks.
+//
+// Parameters:
+//   - a: Any value that can be converted into a time.Time format.
+//   - b: Any value that can be converted into a time.Time format.
+//   - d: The largest distance allowed between the values, inclusive.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the values are within d of each other.
+//
+// Panic:
+//
+//	This function will panic if d is negative, or if it's unable to convert the provided values into a time.Time
+//	format.
+//
+// Example:
+//
+//	fmt.Println(IsWithinDurationOf("2024-01-01T10:04:00Z", "2024-01-01T10:00:00Z", 5*time.Minute)) // true
+//	fmt.Println(IsWithinDurationOf("2024-01-01T09:56:00Z", "2024-01-01T10:00:00Z", 5*time.Minute)) // true
+//	fmt.Println(IsWithinDurationOf("2024-01-01T10:06:00Z", "2024-01-01T10:00:00Z", 5*time.Minute)) // false
+func IsWithinDurationOf(a, b any, d time.Duration) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsWithinDurationOf", time.Now(), &passed)
+	}
+	if d < 0 {
+		panic(fmt.Sprintf("Invalid duration: %s", d))
+	}
+	return toTime(a).Sub(toTime(b)).Abs() <= d
 }
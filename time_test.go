@@ -1,8 +1,10 @@
 package checker
 
 import (
+	"database/sql"
 	"testing"
 	"time"
+	_ "time/tzdata"
 )
 
 type timeCase struct {
@@ -393,3 +395,488 @@ func TestIsToday(t *testing.T) {
 		})
 	}
 }
+
+func FuzzToTime(f *testing.F) {
+	for _, seed := range []string{"2024-01-02", "2024-01-02 15:04:05", "2020-07-14T04:12:02Z", "3:04PM", "invalid", ""} {
+		f.Add(seed, int64(1609459200000))
+	}
+	f.Fuzz(func(t *testing.T, s string, millis int64) {
+		converted, err := toTimeWithErr(s)
+		if IsTime(s) != (err == nil) {
+			t.Errorf("IsTime(%q) = %v, but toTimeWithErr returned error %v", s, IsTime(s), err)
+		}
+		if convertedPointer, errPointer := toTimeWithErr(&s); !convertedPointer.Equal(converted) || (errPointer == nil) != (err == nil) {
+			t.Errorf("toTimeWithErr(&%q) = %v, %v, want %v, %v", s, convertedPointer, errPointer, converted, err)
+		}
+		if _, err := toTimeWithErr(millis); err != nil {
+			t.Errorf("toTimeWithErr(%d) returned an error: %v", millis, err)
+		}
+	})
+}
+
+func TestIsZeroTime(t *testing.T) {
+	var zero time.Time
+	tests := []baseCase{
+		{name: "Zero", arg: time.Time{}, want: true},
+		{name: "ZeroPointer", arg: &zero, want: true},
+		{name: "ZeroString", arg: "0001-01-01T00:00:00Z", want: true},
+		{name: "Now", arg: time.Now(), want: false},
+		{name: "UnixEpoch", arg: 0, want: false},
+		{name: "Date", arg: "2024-06-01", want: false},
+		{name: "NullTimeZero", arg: sql.NullTime{Valid: true}, want: true},
+		{name: "NullTimeValid", arg: sql.NullTime{Time: time.Now(), Valid: true}, want: false},
+		{name: "NullTimePointer", arg: &sql.NullTime{Time: time.Now(), Valid: true}, want: false},
+		{name: "NullTimeInvalid", arg: sql.NullTime{}, panic: true},
+		{name: "NilNullTimePointer", arg: (*sql.NullTime)(nil), panic: true},
+		{name: "NilPointer", arg: (*time.Time)(nil), panic: true},
+		{name: "Unsupported", arg: true, panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsZeroTime(tt.arg); got != tt.want {
+				t.Errorf("IsZeroTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type timeOptionsCase struct {
+	name  string
+	arg   any
+	opts  TimeOptions
+	want  bool
+	panic bool
+}
+
+func runTimeOptionsCases(t *testing.T, name string, fn func(any, TimeOptions) bool, tests []timeOptionsCase) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := fn(tt.arg, tt.opts); got != tt.want {
+				t.Errorf("%s(%v, %+v) = %v, want %v", name, tt.arg, tt.opts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsBeforeNowWithOptions(t *testing.T) {
+	unset := TimeOptions{ZeroIsUnset: true}
+	runTimeOptionsCases(t, "IsBeforeNowWithOptions", IsBeforeNowWithOptions, []timeOptionsCase{
+		{name: "Past", arg: time.Now().Add(-time.Minute), want: true},
+		{name: "PastUnset", arg: time.Now().Add(-time.Minute), opts: unset, want: true},
+		{name: "FutureUnset", arg: time.Now().Add(time.Minute), opts: unset, want: false},
+		{name: "Zero", arg: time.Time{}, want: true},
+		{name: "ZeroUnset", arg: time.Time{}, opts: unset, want: false},
+		{name: "ZeroStringUnset", arg: "0001-01-01T00:00:00Z", opts: unset, want: false},
+		{name: "NilPointerUnset", arg: (*time.Time)(nil), opts: unset, want: false},
+		{name: "NullTimeUnset", arg: sql.NullTime{}, opts: unset, want: false},
+		{name: "NullTimePast", arg: sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}, want: true},
+		{name: "NullTimePastUnset", arg: sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}, opts: unset,
+			want: true},
+		{name: "NullTimeFutureUnset", arg: sql.NullTime{Time: time.Now().Add(time.Minute), Valid: true}, opts: unset,
+			want: false},
+		{name: "NullTimeZeroUnset", arg: sql.NullTime{Valid: true}, opts: unset, want: false},
+		{name: "NilPointer", arg: (*time.Time)(nil), panic: true},
+		{name: "UnsupportedUnset", arg: true, opts: unset, panic: true},
+	})
+}
+
+func TestIsTodayWithOptions(t *testing.T) {
+	unset := TimeOptions{ZeroIsUnset: true}
+	runTimeOptionsCases(t, "IsTodayWithOptions", IsTodayWithOptions, []timeOptionsCase{
+		{name: "Now", arg: time.Now(), want: true},
+		{name: "NowUnset", arg: time.Now(), opts: unset, want: true},
+		{name: "YesterdayUnset", arg: time.Now().AddDate(0, 0, -1), opts: unset, want: false},
+		{name: "Zero", arg: time.Time{}, want: false},
+		{name: "ZeroUnset", arg: time.Time{}, opts: unset, want: false},
+		{name: "NilPointerUnset", arg: (*time.Time)(nil), opts: unset, want: false},
+		{name: "NullTimeUnset", arg: sql.NullTime{}, opts: unset, want: false},
+		{name: "NullTimeNow", arg: sql.NullTime{Time: time.Now(), Valid: true}, want: true},
+		{name: "NullTimeNowUnset", arg: sql.NullTime{Time: time.Now(), Valid: true}, opts: unset, want: true},
+		{name: "NullTimeYesterdayUnset", arg: sql.NullTime{Time: time.Now().AddDate(0, 0, -1), Valid: true},
+			opts: unset, want: false},
+		{name: "NilPointer", arg: (*time.Time)(nil), panic: true},
+	})
+}
+
+func TestIsPlausibleBirthDate(t *testing.T) {
+	now := time.Now()
+	tests := []baseCase{
+		{name: "Adult", arg: "1990-05-17", want: true},
+		{name: "Today", arg: now, want: true},
+		{name: "Tomorrow", arg: now.AddDate(0, 0, 1), want: false},
+		{name: "NextYear", arg: now.AddDate(1, 0, 0), want: false},
+		{name: "Exactly130Years", arg: now.AddDate(-130, 0, 0), want: true},
+		{name: "Over130Years", arg: now.AddDate(-130, 0, -1), want: false},
+		{name: "LongAgo", arg: "1850-01-01", want: false},
+		{name: "Unsupported", arg: true, panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsPlausibleBirthDate(tt.arg); got != tt.want {
+				t.Errorf("IsPlausibleBirthDate(%v) = %v, want %v", tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+type birthDateCase struct {
+	name  string
+	arg   any
+	opts  BirthDateOptions
+	want  bool
+	panic bool
+}
+
+func TestIsPlausibleBirthDateWithOptions(t *testing.T) {
+	now := time.Now()
+	adult := BirthDateOptions{MinAge: 18}
+	tests := []birthDateCase{
+		{name: "NoMinAge", arg: now, want: true},
+		{name: "Adult", arg: "1990-05-17", opts: adult, want: true},
+		{name: "EighteenToday", arg: now.AddDate(-18, 0, 0), opts: adult, want: true},
+		{name: "EighteenTomorrow", arg: now.AddDate(-18, 0, 1), opts: adult, want: false},
+		{name: "Minor", arg: now.AddDate(-17, 0, 0), opts: adult, want: false},
+		{name: "Future", arg: now.AddDate(0, 0, 1), opts: adult, want: false},
+		{name: "TooOld", arg: "1850-01-01", opts: adult, want: false},
+		{name: "Unsupported", arg: true, opts: adult, panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsPlausibleBirthDateWithOptions(tt.arg, tt.opts); got != tt.want {
+				t.Errorf("IsPlausibleBirthDateWithOptions(%v, %+v) = %v, want %v", tt.arg, tt.opts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsMultipleOfDuration(t *testing.T) {
+	tests := []struct {
+		name  string
+		arg   any
+		unit  time.Duration
+		want  bool
+		panic bool
+	}{
+		{name: "String", arg: "45m", unit: 15 * time.Minute, want: true},
+		{name: "Duration", arg: 90 * time.Minute, unit: 30 * time.Minute, want: true},
+		{name: "NotMultiple", arg: 90 * time.Minute, unit: time.Hour, want: false},
+		{name: "CompoundString", arg: "1h30m", unit: 30 * time.Minute, want: true},
+		{name: "Nanoseconds", arg: int64(time.Second), unit: time.Millisecond, want: true},
+		{name: "Pointer", arg: func() *time.Duration { d := 20 * time.Minute; return &d }(), unit: 15 * time.Minute,
+			want: false},
+		{name: "Zero", arg: "0s", unit: 15 * time.Minute, want: true},
+		{name: "Negative", arg: "-30m", unit: 15 * time.Minute, want: true},
+		{name: "Smaller", arg: "10m", unit: 15 * time.Minute, want: false},
+		{name: "ZeroUnit", arg: "45m", unit: 0, panic: true},
+		{name: "NegativeUnit", arg: "45m", unit: -time.Minute, panic: true},
+		{name: "NotDuration", arg: "soon", unit: time.Minute, panic: true},
+		{name: "Unsupported", arg: 1.5, unit: time.Minute, panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsMultipleOfDuration(tt.arg, tt.unit); got != tt.want {
+				t.Errorf("IsMultipleOfDuration(%v, %v) = %v, want %v", tt.arg, tt.unit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlignsToInterval(t *testing.T) {
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+	tests := []struct {
+		name     string
+		arg      any
+		interval time.Duration
+		want     bool
+		panic    bool
+	}{
+		{name: "FiveMinutes", arg: "2024-01-01T10:15:00Z", interval: 5 * time.Minute, want: true},
+		{name: "OffFiveMinutes", arg: "2024-01-01T10:17:00Z", interval: 5 * time.Minute, want: false},
+		{name: "SubSecond", arg: "2024-01-01T10:00:00.5Z", interval: time.Second, want: false},
+		{name: "Hour", arg: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), interval: time.Hour, want: true},
+		{name: "UTCMidnight", arg: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), interval: 24 * time.Hour,
+			want: true},
+		{name: "LocalMidnight", arg: time.Date(2024, 1, 1, 0, 0, 0, 0, saoPaulo), interval: 24 * time.Hour,
+			want: false},
+		{name: "LocalQuarterHour", arg: time.Date(2024, 1, 1, 9, 45, 0, 0, saoPaulo), interval: 15 * time.Minute,
+			want: true},
+		{name: "ZeroInterval", arg: "2024-01-01T10:15:00Z", interval: 0, panic: true},
+		{name: "NotTime", arg: "soon", interval: time.Minute, panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := AlignsToInterval(tt.arg, tt.interval); got != tt.want {
+				t.Errorf("AlignsToInterval(%v, %v) = %v, want %v", tt.arg, tt.interval, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeCheckersGolden(t *testing.T) {
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+	tokyo := time.FixedZone("JST", 9*60*60)
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("time.LoadLocation() error = %v", err)
+	}
+	adult := BirthDateOptions{MinAge: 18}
+	stripeHeader := "t=1492774577,v1=7656fc2882a7ca0a651666b36bf2f2f22ee204f54f608f227498a2419f2890b2"
+	springForward := time.Date(2024, 3, 10, 0, 30, 0, 0, newYork)
+	fallBack := time.Date(2024, 11, 3, 0, 30, 0, 0, newYork)
+
+	tests := []struct {
+		name  string
+		now   time.Time
+		check func() bool
+		want  bool
+	}{
+		// Year boundary in UTC.
+		{name: "LastInstantOfYearIsToday", now: time.Date(2023, 12, 31, 23, 59, 59, 999999999, time.UTC),
+			check: func() bool { return IsToday("2023-12-31") }, want: true},
+		{name: "NewYearIsAfterToday", now: time.Date(2023, 12, 31, 23, 59, 59, 999999999, time.UTC),
+			check: func() bool { return IsAfterToday("2024-01-01") }, want: true},
+		{name: "NewYearIsNotBeforeNow", now: time.Date(2023, 12, 31, 23, 59, 59, 999999999, time.UTC),
+			check: func() bool { return IsBeforeNow("2024-01-01T00:00:00Z") }, want: false},
+		{name: "MidnightIsToday", now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			check: func() bool { return IsToday("2024-01-01") }, want: true},
+		{name: "LastYearIsBeforeToday", now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			check: func() bool { return IsBeforeToday("2023-12-31") }, want: true},
+		{name: "NowIsNotBeforeNow", now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			check: func() bool { return IsBeforeNow("2024-01-01T00:00:00Z") }, want: false},
+		{name: "NowIsNotAfterNow", now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			check: func() bool { return IsAfterNow("2024-01-01T00:00:00Z") }, want: false},
+
+		// Day boundary in a zone behind UTC, when UTC is already on the next day.
+		{name: "LocalEveningIsToday", now: time.Date(2024, 1, 1, 22, 0, 0, 0, saoPaulo),
+			check: func() bool { return IsToday("2024-01-01") }, want: true},
+		{name: "UTCDateIsAfterToday", now: time.Date(2024, 1, 1, 22, 0, 0, 0, saoPaulo),
+			check: func() bool { return IsAfterToday("2024-01-02") }, want: true},
+		{name: "LocalEveningIsNotBeforeToday", now: time.Date(2024, 1, 1, 22, 0, 0, 0, saoPaulo),
+			check: func() bool { return IsBeforeToday("2024-01-01") }, want: false},
+		{name: "LocalEveningWithOptions", now: time.Date(2024, 1, 1, 22, 0, 0, 0, saoPaulo),
+			check: func() bool { return IsTodayWithOptions("2024-01-01", TimeOptions{ZeroIsUnset: true}) }, want: true},
+
+		// Day boundary in a zone ahead of UTC, when UTC is still on the previous day.
+		{name: "LocalMorningIsToday", now: time.Date(2024, 1, 2, 0, 30, 0, 0, tokyo),
+			check: func() bool { return IsToday("2024-01-02") }, want: true},
+		{name: "UTCDateIsBeforeToday", now: time.Date(2024, 1, 2, 0, 30, 0, 0, tokyo),
+			check: func() bool { return IsBeforeToday("2024-01-01") }, want: true},
+		{name: "SameInstantInUTCIsOwnDate", now: time.Date(2024, 1, 2, 0, 30, 0, 0, tokyo),
+			check: func() bool { return IsToday(time.Date(2024, 1, 1, 15, 30, 0, 0, time.UTC)) }, want: false},
+
+		// DST transitions, with days of 23 and 25 hours.
+		{name: "SpringForwardDayIsToday", now: springForward,
+			check: func() bool { return IsToday(springForward.Add(22 * time.Hour)) }, want: true},
+		{name: "SpringForwardDayHas23Hours", now: springForward,
+			check: func() bool { return IsAfterToday(springForward.Add(23 * time.Hour)) }, want: true},
+		{name: "SpringForwardYesterday", now: springForward.Add(22 * time.Hour),
+			check: func() bool { return IsBeforeToday("2024-03-09") }, want: true},
+		{name: "FallBackDayHas25Hours", now: fallBack,
+			check: func() bool { return IsToday(fallBack.Add(24 * time.Hour)) }, want: true},
+		{name: "FallBackNextDay", now: fallBack,
+			check: func() bool { return IsAfterToday(fallBack.Add(25 * time.Hour)) }, want: true},
+
+		// Birth dates and leap years.
+		{name: "EighteenthBirthday", now: time.Date(2024, 3, 1, 0, 0, 0, 0, saoPaulo),
+			check: func() bool { return IsPlausibleBirthDateWithOptions("2006-03-01", adult) }, want: true},
+		{name: "DayBeforeEighteenthBirthday", now: time.Date(2024, 2, 29, 23, 59, 0, 0, saoPaulo),
+			check: func() bool { return IsPlausibleBirthDateWithOptions("2006-03-01", adult) }, want: false},
+		{name: "LeapDayBirthdayInCommonYear", now: time.Date(2022, 2, 28, 12, 0, 0, 0, time.UTC),
+			check: func() bool { return IsPlausibleBirthDateWithOptions("2004-02-29", adult) }, want: false},
+		{name: "LeapDayBirthdayOnMarchFirst", now: time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC),
+			check: func() bool { return IsPlausibleBirthDateWithOptions("2004-02-29", BirthDateOptions{MinAge: 19}) },
+			want:  true},
+		{name: "BornLocalToday", now: time.Date(2024, 1, 1, 22, 0, 0, 0, saoPaulo),
+			check: func() bool { return IsPlausibleBirthDate("2024-01-01") }, want: true},
+		{name: "BornUTCTomorrow", now: time.Date(2024, 1, 1, 22, 0, 0, 0, saoPaulo),
+			check: func() bool { return IsPlausibleBirthDate("2024-01-02") }, want: false},
+
+		// Other checkers that read the clock.
+		{name: "StripeSignatureWithinTolerance", now: time.Unix(1492774577, 0).Add(4 * time.Minute),
+			check: func() bool {
+				return IsValidStripeSignature(`{"id":"evt_1"}`, stripeHeader, []byte("whsec_test"), 5*time.Minute)
+			}, want: true},
+		{name: "StripeSignatureExpired", now: time.Unix(1492774577, 0).Add(6 * time.Minute),
+			check: func() bool {
+				return IsValidStripeSignature(`{"id":"evt_1"}`, stripeHeader, []byte("whsec_test"), 5*time.Minute)
+			}, want: false},
+		{name: "FiscalKeyOfCurrentMonth", now: time.Date(2024, 6, 1, 0, 0, 0, 0, saoPaulo),
+			check: func() bool { return IsCTeKey("35240612345678000195570010000012341123456780") }, want: true},
+		{name: "FiscalKeyOfNextMonth", now: time.Date(2024, 5, 31, 23, 59, 0, 0, saoPaulo),
+			check: func() bool { return IsCTeKey("35240612345678000195570010000012341123456780") }, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got bool
+			WithFrozenTime(tt.now, func() { got = tt.check() })
+			if got != tt.want {
+				t.Errorf("%s at %v = %v, want %v", tt.name, tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTodayInChecks(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("time.LoadLocation() error = %v", err)
+	}
+	tokyo := time.FixedZone("JST", 9*60*60)
+	// 23:30 in São Paulo, when it is already 02:30 of January 2 in UTC.
+	lateEvening := time.Date(2024, 1, 1, 23, 30, 0, 0, saoPaulo)
+
+	tests := []struct {
+		name  string
+		fn    func(any, *time.Location) bool
+		arg   any
+		loc   *time.Location
+		want  bool
+		panic bool
+	}{
+		{name: "TodayDateString", fn: IsTodayIn, arg: "2024-01-01", loc: saoPaulo, want: true},
+		{name: "TodayUTCDateString", fn: IsTodayIn, arg: "2024-01-02", loc: time.UTC, want: true},
+		{name: "TomorrowDateString", fn: IsTodayIn, arg: "2024-01-02", loc: saoPaulo, want: false},
+		{name: "TodayLocalDateTime", fn: IsTodayIn, arg: "2024-01-01 23:00:00", loc: saoPaulo, want: true},
+		{name: "TodayUTCInstant", fn: IsTodayIn, arg: "2024-01-02T02:00:00Z", loc: saoPaulo, want: true},
+		{name: "TodayUTCTime", fn: IsTodayIn, arg: lateEvening.UTC(), loc: saoPaulo, want: true},
+		{name: "TodayOtherZoneTime", fn: IsTodayIn, arg: time.Date(2024, 1, 2, 11, 0, 0, 0, tokyo), loc: saoPaulo,
+			want: true},
+		{name: "TodayUnixMillis", fn: IsTodayIn, arg: lateEvening.UnixMilli(), loc: saoPaulo, want: true},
+		{name: "TodayInTokyo", fn: IsTodayIn, arg: "2024-01-02", loc: tokyo, want: true},
+		{name: "YesterdayInTokyo", fn: IsTodayIn, arg: "2024-01-01", loc: tokyo, want: false},
+		{name: "BeforeYesterday", fn: IsBeforeTodayIn, arg: "2023-12-31", loc: saoPaulo, want: true},
+		{name: "BeforeToday", fn: IsBeforeTodayIn, arg: "2024-01-01", loc: saoPaulo, want: false},
+		{name: "BeforeTodayUTC", fn: IsBeforeTodayIn, arg: "2024-01-01", loc: time.UTC, want: true},
+		{name: "BeforeUTCInstant", fn: IsBeforeTodayIn, arg: "2024-01-01T02:59:59Z", loc: saoPaulo, want: true},
+		{name: "AfterTomorrow", fn: IsAfterTodayIn, arg: "2024-01-02", loc: saoPaulo, want: true},
+		{name: "AfterToday", fn: IsAfterTodayIn, arg: "2024-01-01", loc: saoPaulo, want: false},
+		{name: "AfterTomorrowInUTC", fn: IsAfterTodayIn, arg: "2024-01-02", loc: time.UTC, want: false},
+		{name: "NilLocation", fn: IsTodayIn, arg: "2024-01-01", panic: true},
+		{name: "NotTime", fn: IsBeforeTodayIn, arg: "yesterday", loc: saoPaulo, panic: true},
+		{name: "Nil", fn: IsAfterTodayIn, arg: nil, loc: saoPaulo, panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			var got bool
+			WithFrozenTime(lateEvening, func() { got = tt.fn(tt.arg, tt.loc) })
+			if got != tt.want {
+				t.Errorf("%s(%v, %v) = %v, want %v", tt.name, tt.arg, tt.loc, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSameInstant(t *testing.T) {
+	now := time.Now()
+	tests := []timeCase{
+		{name: "SameString", a: "2024-01-01T10:00:00Z", b: "2024-01-01T10:00:00Z", want: true},
+		{name: "OtherOffset", a: "2024-01-01T10:00:00Z", b: "2024-01-01T07:00:00-03:00", want: true},
+		{name: "UnixMillis", a: "2024-01-01T10:00:00Z", b: int64(1704103200000), want: true},
+		{name: "OtherZoneTime", a: now, b: now.In(time.FixedZone("JST", 9*60*60)), want: true},
+		{name: "MonotonicClock", a: now, b: now.Round(0), want: true},
+		{name: "OneSecondApart", a: "2024-01-01T10:00:00Z", b: "2024-01-01T10:00:01Z", want: false},
+		{name: "OneNanosecondApart", a: now, b: now.Add(time.Nanosecond), want: false},
+		{name: "NotTime", a: "now", b: now, panic: true},
+		{name: "Nil", a: now, b: nil, panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsSameInstant(tt.a, tt.b); got != tt.want {
+				t.Errorf("IsSameInstant(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsWithinDurationOf(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  any
+		d     time.Duration
+		want  bool
+		panic bool
+	}{
+		{name: "After", a: "2024-01-01T10:04:00Z", b: "2024-01-01T10:00:00Z", d: 5 * time.Minute, want: true},
+		{name: "Before", a: "2024-01-01T09:56:00Z", b: "2024-01-01T10:00:00Z", d: 5 * time.Minute, want: true},
+		{name: "Boundary", a: "2024-01-01T10:05:00Z", b: "2024-01-01T10:00:00Z", d: 5 * time.Minute, want: true},
+		{name: "TooLate", a: "2024-01-01T10:06:00Z", b: "2024-01-01T10:00:00Z", d: 5 * time.Minute, want: false},
+		{name: "TooEarly", a: "2024-01-01T09:54:59Z", b: "2024-01-01T10:00:00Z", d: 5 * time.Minute, want: false},
+		{name: "OtherOffset", a: "2024-01-01T07:01:00-03:00", b: "2024-01-01T10:00:00Z", d: time.Minute,
+			want: true},
+		{name: "ZeroSame", a: "2024-01-01T10:00:00Z", b: int64(1704103200000), d: 0, want: true},
+		{name: "ZeroApart", a: "2024-01-01T10:00:00Z", b: "2024-01-01T10:00:01Z", d: 0, want: false},
+		{name: "NegativeDuration", a: "2024-01-01T10:00:00Z", b: "2024-01-01T10:00:00Z", d: -time.Second,
+			panic: true},
+		{name: "NotTime", a: "soon", b: "2024-01-01T10:00:00Z", d: time.Minute, panic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsWithinDurationOf(tt.a, tt.b, tt.d); got != tt.want {
+				t.Errorf("IsWithinDurationOf(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.d, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"crypto/tls"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// IsTLSVersion checks if a given value names a TLS protocol version. The version number "1.0", "1.1", "1.2" or
+// "1.3" may be written alone or prefixed by "TLS", "TLS " or "TLSv", the prefix being case-insensitive. SSL
+// versions are not accepted.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value names a TLS version.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsTLSVersion("1.2"))     // true
+//	fmt.Println(IsTLSVersion("TLSv1.3")) // true
+//	fmt.Println(IsTLSVersion("TLS 1.3")) // true
+//	fmt.Println(IsTLSVersion("1.4"))     // false
+//	fmt.Println(IsTLSVersion("SSLv3"))   // false
+func IsTLSVersion(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsTLSVersion", time.Now(), &passed)
+	}
+	regex := regexp.MustCompile(`(?i)^(tls ?v?)?1\.[0-3]$`)
+	return regex.MatchString(toString(a))
+}
+
+// IsCipherSuiteName checks if a given value is the name of a cipher suite implemented by the crypto/tls package,
+// as returned by tls.CipherSuiteName, such as "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256". Both the secure suites of
+// tls.CipherSuites and the insecure ones of tls.InsecureCipherSuites are accepted. The comparison is case-sensitive.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value names a cipher suite known to crypto/tls.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsCipherSuiteName("TLS_AES_128_GCM_SHA256"))                // true
+//	fmt.Println(IsCipherSuiteName("TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256")) // true
+//	fmt.Println(IsCipherSuiteName("tls_aes_128_gcm_sha256"))                // false
+//	fmt.Println(IsCipherSuiteName("TLS_FAKE_WITH_NOTHING"))                 // false
+func IsCipherSuiteName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsCipherSuiteName", time.Now(), &passed)
+	}
+	s := toString(a)
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		if suite.Name == s {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCurveName checks if a given value names an elliptic curve or key exchange group supported by crypto/tls. Both
+// the tls.CurveID names ("CurveP256", "CurveP384", "CurveP521", "X25519", "X25519MLKEM768") and the standard NIST
+// names ("P-256", "P-384", "P-521") are accepted. The comparison is case-insensitive.
+//
+// Parameters:
+//   - a: The value to be checked. This can be any type, and will first be converted to a string.
+//
+// Returns:
+//   - bool: A boolean indicating whether the given value names a supported curve.
+//
+// Panic:
+//   - This function can potentially panic if its dependency function 'toString' encounters an unexpected type or value.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsCurveName("X25519"))    // true
+//	fmt.Println(IsCurveName("P-256"))     // true
+//	fmt.Println(IsCurveName("curvep384")) // true
+//	fmt.Println(IsCurveName("P-192"))     // false
+func IsCurveName(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsCurveName", time.Now(), &passed)
+	}
+	switch strings.ToUpper(toString(a)) {
+	case "CURVEP256", "CURVEP384", "CURVEP521", "X25519", "X25519MLKEM768", "P-256", "P-384", "P-521":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package checker
+
+import "testing"
+
+func TestIsTLSVersion(t *testing.T) {
+	version := "1.2"
+	testCases := []baseCase{
+		{name: "Version10", arg: "1.0", want: true},
+		{name: "Version13", arg: "1.3", want: true},
+		{name: "Prefixed", arg: "TLS1.2", want: true},
+		{name: "PrefixedWithSpace", arg: "TLS 1.3", want: true},
+		{name: "PrefixedWithV", arg: "tlsv1.1", want: true},
+		{name: "Pointer", arg: &version, want: true},
+		{name: "Float", arg: 1.2, want: true},
+		{name: "Unknown", arg: "1.4", want: false},
+		{name: "SSL", arg: "SSLv3", want: false},
+		{name: "MissingMinor", arg: "TLS1", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsTLSVersion(tc.arg); got != tc.want {
+				t.Errorf("IsTLSVersion(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsCipherSuiteName(t *testing.T) {
+	testCases := []baseCase{
+		{name: "TLS13Suite", arg: "TLS_AES_128_GCM_SHA256", want: true},
+		{name: "TLS12Suite", arg: "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", want: true},
+		{name: "InsecureSuite", arg: "TLS_RSA_WITH_RC4_128_SHA", want: true},
+		{name: "LowerCase", arg: "tls_aes_128_gcm_sha256", want: false},
+		{name: "Unknown", arg: "TLS_FAKE_WITH_NOTHING", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsCipherSuiteName(tc.arg); got != tc.want {
+				t.Errorf("IsCipherSuiteName(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsCurveName(t *testing.T) {
+	testCases := []baseCase{
+		{name: "X25519", arg: "X25519", want: true},
+		{name: "Hybrid", arg: "X25519MLKEM768", want: true},
+		{name: "CurveID", arg: "CurveP521", want: true},
+		{name: "NISTName", arg: "P-256", want: true},
+		{name: "CaseInsensitive", arg: "curvep384", want: true},
+		{name: "Unsupported", arg: "P-192", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsCurveName(tc.arg); got != tc.want {
+				t.Errorf("IsCurveName(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
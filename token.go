@@ -0,0 +1,127 @@
+//	MIT License
+//
+//	Copyright (c) 2024 Tech4Works
+//
+//	Permission is hereby granted, free of charge, to any person obtaining a copy
+//	of this software and associated documentation files (the "Software"), to deal
+//	in the Software without restriction, including without limitation the rights
+//	to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//	copies of the Software, and to permit persons to whom the Software is
+//	furnished to do so, subject to the following conditions:
+//
+//	The above copyright notice and this permission notice shall be included in all
+//	copies or substantial portions of the Software.
+//
+//	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//	IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//	AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//	LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//	OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//	SOFTWARE.
+
+package checker
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// IsIdempotencyKey checks if a given value is an idempotency key, as sent in the Idempotency-Key header of
+// mutation requests. UUIDs in the canonical 8-4-4-4-12 form and ULIDs are always accepted, case-insensitively.
+// Other keys must have between 16 and 255 characters among ASCII letters, digits, '-', '_', '.', ':' and '~'.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as an idempotency key.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an idempotency key.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsIdempotencyKey("f47ac10b-58cc-4372-a567-0e02b2c3d479")) // true
+//	fmt.Println(IsIdempotencyKey("01ARZ3NDEKTSV4RRFFQ69G5FAV"))           // true
+//	fmt.Println(IsIdempotencyKey("order-2024-000123"))                    // true
+//	fmt.Println(IsIdempotencyKey("abc"))                                  // false
+func IsIdempotencyKey(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsIdempotencyKey", time.Now(), &passed)
+	}
+	s := toString(a)
+	uuid := regexp.MustCompile(`^(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	ulid := regexp.MustCompile(`^(?i)[0-7][0-9a-hjkmnp-tv-z]{25}$`)
+	opaque := regexp.MustCompile(`^[A-Za-z0-9._:~-]{16,255}$`)
+	return uuid.MatchString(s) || ulid.MatchString(s) || opaque.MatchString(s)
+}
+
+// IsNonce checks if a given value is a nonce carrying at least the given number of bits of entropy. The entropy
+// is estimated from the length of the value and the smallest alphabet it is written in: decimal digits (3.3 bits
+// per character), hexadecimal in a single case (4 bits), base32 (5 bits), alphanumeric (5.95 bits) or base64 and
+// base64url (6 bits, padding excluded). Values outside these alphabets, and values that repeat too few distinct
+// characters to be random, such as "abababab", are rejected.
+//
+// Parameters:
+//   - a: Any value to be converted into a string and checked as a nonce.
+//   - minEntropyBits: The minimum estimated entropy, such as 128.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is a nonce with enough entropy.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsNonce("9f86d081884c7d659a2feaa0c55ad015", 128)) // true
+//	fmt.Println(IsNonce("4f9a2b7c", 128))                         // false
+//	fmt.Println(IsNonce("00000000000000000000000000000000", 64))  // false
+func IsNonce(a any, minEntropyBits float64) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNonce", time.Now(), &passed)
+	}
+	s := toString(a)
+	alphabet := nonceAlphabetSize(s)
+	if alphabet == 0 {
+		return false
+	}
+	s = strings.TrimRight(s, "=")
+
+	distinct := map[rune]struct{}{}
+	for _, r := range s {
+		distinct[r] = struct{}{}
+	}
+	if len(distinct)*2 < min(len(s), alphabet) {
+		return false
+	}
+	return float64(len(s))*math.Log2(float64(alphabet)) >= minEntropyBits
+}
+
+// nonceAlphabetSize returns the size of the smallest alphabet s is written in, or 0 if s is empty or is not
+// written in any of the alphabets accepted by IsNonce.
+func nonceAlphabetSize(s string) int {
+	alphabets := []struct {
+		pattern string
+		size    int
+	}{
+		{`^[0-9]+$`, 10},
+		{`^([0-9a-f]+|[0-9A-F]+)$`, 16},
+		{`^[A-Z2-7]+=*$`, 32},
+		{`^[A-Za-z0-9]+$`, 62},
+		{`^([A-Za-z0-9+/]+|[A-Za-z0-9_-]+)=*$`, 64},
+	}
+	for _, alphabet := range alphabets {
+		if regexp.MustCompile(alphabet.pattern).MatchString(s) {
+			return alphabet.size
+		}
+	}
+	return 0
+}
@@ -0,0 +1,80 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsIdempotencyKey(t *testing.T) {
+	testCases := []baseCase{
+		{name: "UUID", arg: "f47ac10b-58cc-4372-a567-0e02b2c3d479", want: true},
+		{name: "UpperUUID", arg: "F47AC10B-58CC-4372-A567-0E02B2C3D479", want: true},
+		{name: "ULID", arg: "01ARZ3NDEKTSV4RRFFQ69G5FAV", want: true},
+		{name: "LowerULID", arg: "01arz3ndektsv4rrffq69g5fav", want: true},
+		{name: "Opaque", arg: "order-2024-000123", want: true},
+		{name: "OpaqueSymbols", arg: "tenant:42.order_7~retry", want: true},
+		{name: "MaxLength", arg: strings.Repeat("k", 255), want: true},
+		{name: "TooShort", arg: "abc", want: false},
+		{name: "TooLong", arg: strings.Repeat("k", 256), want: false},
+		{name: "Space", arg: "order 2024 000123", want: false},
+		{name: "InvalidCharacter", arg: "order/2024/000123", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsIdempotencyKey(tc.arg); got != tc.want {
+				t.Errorf("IsIdempotencyKey(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsNonce(t *testing.T) {
+	testCases := []struct {
+		name           string
+		arg            any
+		minEntropyBits float64
+		want           bool
+		panic          bool
+	}{
+		{name: "Hex128", arg: "9f86d081884c7d659a2feaa0c55ad015", minEntropyBits: 128, want: true},
+		{name: "UpperHex", arg: "9F86D081884C7D659A2FEAA0C55AD015", minEntropyBits: 128, want: true},
+		{name: "Base64URL", arg: "n4bQgYhMfWWaL-qgxVrQFQ", minEntropyBits: 128, want: true},
+		{name: "Base64Padded", arg: "n4bQgYhMfWWaL+qgxVrQFQ==", minEntropyBits: 128, want: true},
+		{name: "Base32", arg: "T6DNBAMIJR6WLGRP5KQMKWWQCU", minEntropyBits: 128, want: true},
+		{name: "Digits", arg: "8240917365", minEntropyBits: 32, want: true},
+		{name: "DigitsNotEnough", arg: "8240917365", minEntropyBits: 64, want: false},
+		{name: "TooShort", arg: "4f9a2b7c", minEntropyBits: 128, want: false},
+		{name: "Repeated", arg: "00000000000000000000000000000000", minEntropyBits: 64, want: false},
+		{name: "Pattern", arg: "abababababababababababababababab", minEntropyBits: 64, want: false},
+		{name: "MixedCaseHexIsAlphanumeric", arg: "9f86D081884c7d659A2feaa0c55ad015", minEntropyBits: 180, want: true},
+		{name: "InvalidCharacter", arg: "9f86d081884c7d65 9a2feaa0c55ad015", minEntropyBits: 64, want: false},
+		{name: "Empty", arg: "", minEntropyBits: 0, want: false},
+		{name: "Nil", arg: nil, minEntropyBits: 64, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsNonce(tc.arg, tc.minEntropyBits); got != tc.want {
+				t.Errorf("IsNonce(%v, %v) = %v, want %v", tc.arg, tc.minEntropyBits, got, tc.want)
+			}
+		})
+	}
+}
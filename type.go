@@ -24,6 +24,8 @@ package checker
 
 import (
 	"encoding/json"
+	"fmt"
+	"math/big"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -32,6 +34,7 @@ import (
 
 // IsJSON checks if a given value can be a map or a slice in JSON format. It uses the IsMap and
 // IsSlice functions to check the given value and returns true if either function returns true.
+// Top-level scalars, which RFC 8259 also allows, fail; use IsJSONValue to accept any valid JSON text.
 //
 // Parameters:
 //   - a: The value of any type to be checked if it can be presented in JSON as map or slice
@@ -48,8 +51,16 @@ import (
 //	fmt.Println(IsJSON(jsonMap)) // Outputs: true
 //	fmt.Println(IsJSON(jsonArray)) // Outputs: true
 //	fmt.Println(IsJSON(notJson)) // Outputs: false
-func IsJSON(a any) bool {
-	return IsMap(a) || IsSlice(a)
+func IsJSON(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsJSON", time.Now(), &passed)
+	}
+	return isJSON(a)
+}
+
+// isJSON is the unobserved implementation of IsJSON.
+func isJSON(a any) bool {
+	return isMap(a) || isSlice(a)
 }
 
 // IsNotJSON checks if the given value cannot be a JSON format, either a map or a slice.
@@ -70,12 +81,17 @@ func IsJSON(a any) bool {
 //	fmt.Println(IsNotJSON(jsonMap)) // Outputs: false
 //	fmt.Println(IsNotJSON(jsonArray)) // Outputs: false
 //	fmt.Println(IsNotJSON(notJson)) // Outputs: true
-func IsNotJSON(a any) bool {
-	return !IsJSON(a)
+func IsNotJSON(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsNotJSON", time.Now(), &passed)
+	}
+	return !isJSON(a)
 }
 
 // IsMap determines whether a given value is a map type.
 // It does this by attempting to unmarshal JSON from the given value's byte representation.
+// The JSON literal "null" passes, as json.Unmarshal accepts it as a nil map; use IsMapWithOptions with
+// JSONOptions.RejectNull to reject it, or IsJSONNull to detect it.
 //
 // Parameters:
 //   - `a`: The value of any type to be checked if it's a map.
@@ -92,7 +108,16 @@ func IsNotJSON(a any) bool {
 //	num := 1234
 //	fmt.Println(IsMap(str)) // true
 //	fmt.Println(IsMap(num)) // false
-func IsMap(a any) bool {
+//	fmt.Println(IsMap("null")) // true
+func IsMap(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMap", time.Now(), &passed)
+	}
+	return isMap(a)
+}
+
+// isMap is the unobserved implementation of IsMap.
+func isMap(a any) bool {
 	var js map[string]any
 	return json.Unmarshal(toBytes(a), &js) == nil
 }
@@ -100,6 +125,8 @@ func IsMap(a any) bool {
 // IsSlice checks if a given value is a slice. It uses toBytes function to convert the given
 // value into a byte slice. It then uses json.Unmarshal function to unmarshal the byte
 // slice into a slice and, if the unmarshal operation is successful, returns true.
+// The JSON literal "null" passes, as json.Unmarshal accepts it as a nil slice; use IsSliceWithOptions with
+// JSONOptions.RejectNull to reject it, or IsJSONNull to detect it.
 //
 // Parameters:
 //   - a: Any value which should be checked end evaluated if it is a slice.
@@ -117,7 +144,16 @@ func IsMap(a any) bool {
 //	y := "Not a slice"
 //	fmt.Println(IsSlice(x)) // Outputs: true
 //	fmt.Println(IsSlice(y)) // Outputs: false
-func IsSlice(a any) bool {
+//	fmt.Println(IsSlice("null")) // Outputs: true
+func IsSlice(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSlice", time.Now(), &passed)
+	}
+	return isSlice(a)
+}
+
+// isSlice is the unobserved implementation of IsSlice.
+func isSlice(a any) bool {
 	var slice []any
 	return json.Unmarshal(toBytes(a), &slice) == nil
 }
@@ -145,7 +181,10 @@ func IsSlice(a any) bool {
 //	x = append(x, map[string]int{"foo": 42})
 //	fmt.Println(IsSliceOfMaps(x)) // true
 //	fmt.Println(IsSliceOfMaps(y)) // false
-func IsSliceOfMaps(a any) bool {
+func IsSliceOfMaps(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSliceOfMaps", time.Now(), &passed)
+	}
 	var slice []map[string]any
 	return json.Unmarshal(toBytes(a), &slice) == nil
 }
@@ -170,11 +209,132 @@ func IsSliceOfMaps(a any) bool {
 //	b := "hello"
 //	fmt.Println(IsInt(a)) // true
 //	fmt.Println(IsInt(b)) // false
-func IsInt(a any) bool {
+func IsInt(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsInt", time.Now(), &passed)
+	}
 	_, err := strconv.Atoi(toString(a))
 	return err == nil
 }
 
+// IsInt64String determines whether a given value can be converted to a 64-bit signed integer, regardless of the
+// platform. It uses the toString function to convert the value to a string and strconv.ParseInt to parse it in
+// base 10, so values beyond the int64 range, such as "9223372036854775808", are rejected.
+//
+// Parameters:
+//   - a: Any interface value to be checked for its convertibility to an int64.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value can be converted to an int64.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsInt64String("9223372036854775807")) // true
+//	fmt.Println(IsInt64String("-42"))                 // true
+//	fmt.Println(IsInt64String("9223372036854775808")) // false
+func IsInt64String(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsInt64String", time.Now(), &passed)
+	}
+	_, err := strconv.ParseInt(toString(a), 10, 64)
+	return err == nil
+}
+
+// IsUint64String determines whether a given value can be converted to a 64-bit unsigned integer, regardless of
+// the platform. It uses the toString function to convert the value to a string and strconv.ParseUint to parse it
+// in base 10, so signs and values beyond the uint64 range are rejected.
+//
+// Parameters:
+//   - a: Any interface value to be checked for its convertibility to an uint64.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value can be converted to an uint64.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsUint64String("18446744073709551615")) // true
+//	fmt.Println(IsUint64String("-1"))                   // false
+//	fmt.Println(IsUint64String("18446744073709551616")) // false
+func IsUint64String(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsUint64String", time.Now(), &passed)
+	}
+	_, err := strconv.ParseUint(toString(a), 10, 64)
+	return err == nil
+}
+
+// IsIntInBase determines whether a given value is a 64-bit signed integer written in the given base, such as
+// "ff" in base 16 or "1010" in base 2. It uses the toString function to convert the value to a string and
+// strconv.ParseInt to parse it. As in strconv.ParseInt, base 0 infers the base from the prefix of the value:
+// "0b" for base 2, "0o" or "0" for base 8 and "0x" for base 16, and accepts underscores between digits.
+//
+// Parameters:
+//   - a: Any interface value to be checked.
+//   - base: The base of the integer, 0 or between 2 and 36.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an integer in the given base.
+//
+// Panic:
+//   - The function will panic if the base is not 0 or between 2 and 36.
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsIntInBase("ff", 16))  // true
+//	fmt.Println(IsIntInBase("1012", 2)) // false
+//	fmt.Println(IsIntInBase("0x1F", 0)) // true
+func IsIntInBase(a any, base int) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsIntInBase", time.Now(), &passed)
+	}
+	if base != 0 && (base < 2 || base > 36) {
+		panic(fmt.Sprintf("Invalid base: %d", base))
+	}
+	_, err := strconv.ParseInt(toString(a), base, 64)
+	return err == nil
+}
+
+// IsBigInt determines whether a given value is a base 10 integer of arbitrary precision, optionally signed, such
+// as the identifiers too large for an int64. It uses the toString function to convert the value to a string and
+// math/big to parse it.
+//
+// Parameters:
+//   - a: Any interface value to be checked.
+//
+// Returns:
+//   - bool: A boolean value indicating whether the value is an integer of arbitrary precision.
+//
+// Panic:
+//   - The function will panic if an unsupported value is passed.
+//     If the value is not of a string, numeric, bool, array, slice, map, struct,
+//     interface, or pointer type.
+//
+// Example:
+//
+//	fmt.Println(IsBigInt("123456789012345678901234567890")) // true
+//	fmt.Println(IsBigInt("-42"))                            // true
+//	fmt.Println(IsBigInt("1e10"))                           // false
+func IsBigInt(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBigInt", time.Now(), &passed)
+	}
+	_, ok := new(big.Int).SetString(toString(a), 10)
+	return ok
+}
+
 // IsBool determines whether a given value can be converted to a boolean.
 // It takes a value of any type as its parameter, converts it to a string using the toString function,
 // and tries to parse the string as a boolean using strconv.ParseBool.
@@ -202,7 +362,10 @@ func IsInt(a any) bool {
 //	fmt.Println(IsBool(z)) // false
 //	fmt.Println(IsBool(a)) // true
 //	fmt.Println(IsBool(b)) // true
-func IsBool(a any) bool {
+func IsBool(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBool", time.Now(), &passed)
+	}
 	_, err := strconv.ParseBool(toString(a))
 	return err == nil
 }
@@ -228,7 +391,10 @@ func IsBool(a any) bool {
 //	fmt.Println(IsFloat(x)) // true
 //	fmt.Println(IsFloat(y)) // false
 //	fmt.Println(IsFloat(z)) // true
-func IsFloat(a any) bool {
+func IsFloat(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsFloat", time.Now(), &passed)
+	}
 	_, err := strconv.ParseFloat(toString(a), 64)
 	return err == nil
 }
@@ -253,7 +419,10 @@ func IsFloat(a any) bool {
 //	fmt.Println(IsTime(y)) // true
 //	z := "This is a string, not a timestamp"
 //	fmt.Println(IsTime(z)) // false
-func IsTime(a any) bool {
+func IsTime(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsTime", time.Now(), &passed)
+	}
 	_, err := toTimeWithErr(a)
 	return err == nil
 }
@@ -277,7 +446,10 @@ func IsTime(a any) bool {
 //	var randomString string = "abc123"
 //	fmt.Println(IsDuration(durationString)) // true
 //	fmt.Println(IsDuration(randomString)) // false
-func IsDuration(a any) bool {
+func IsDuration(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsDuration", time.Now(), &passed)
+	}
 	_, err := time.ParseDuration(toString(a))
 	return err == nil
 }
@@ -306,7 +478,10 @@ func IsDuration(a any) bool {
 //	fmt.Println(IsByteUnit(bu2)) // true
 //	fmt.Println(IsByteUnit(bu3)) // true
 //	fmt.Println(IsByteUnit(bu4)) // false
-func IsByteUnit(a any) bool {
+func IsByteUnit(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsByteUnit", time.Now(), &passed)
+	}
 	regex := regexp.MustCompile(`^(\d+)(B|KB|MB|GB|TB|PB)$`)
 	return regex.MatchString(toString(a))
 }
@@ -330,7 +505,10 @@ func IsByteUnit(a any) bool {
 //
 // Please note that the function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsPointerType(a any) bool {
+func IsPointerType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsPointerType", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Pointer
 }
 
@@ -352,7 +530,10 @@ func IsPointerType(a any) bool {
 //
 // Please note that the function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsFuncType(a any) bool {
+func IsFuncType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsFuncType", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Func
 }
 
@@ -374,7 +555,10 @@ func IsFuncType(a any) bool {
 //
 // Please note that the function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsChanType(a any) bool {
+func IsChanType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsChanType", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Chan
 }
 
@@ -396,7 +580,10 @@ func IsChanType(a any) bool {
 //
 // Please note that the function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsMapType(a any) bool {
+func IsMapType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsMapType", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Map
 }
 
@@ -425,7 +612,10 @@ func IsMapType(a any) bool {
 // Please note that the function does not handle nil pointers and can panic if a
 // nil pointer is passed in.
 // Always check for nil before passing pointers.
-func IsStructType(a any) bool {
+func IsStructType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsStructType", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Struct
 }
 
@@ -448,7 +638,15 @@ func IsStructType(a any) bool {
 //
 // Please note that the function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsSliceType(a any) bool {
+func IsSliceType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSliceType", time.Now(), &passed)
+	}
+	return isSliceType(a)
+}
+
+// isSliceType is the unobserved implementation of IsSliceType.
+func isSliceType(a any) bool {
 	return reflect.ValueOf(a).Kind() == reflect.Slice
 }
 
@@ -471,7 +669,15 @@ func IsSliceType(a any) bool {
 //
 // Please note that this function does not handle nil pointers and can panic if a nil pointer is passed in.
 // It is always necessary to check for nil before passing pointers.
-func IsArrayType(a any) bool {
+func IsArrayType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsArrayType", time.Now(), &passed)
+	}
+	return isArrayType(a)
+}
+
+// isArrayType is the unobserved implementation of IsArrayType.
+func isArrayType(a any) bool {
 	return reflect.ValueOf(a).Kind() == reflect.Array
 }
 
@@ -496,8 +702,11 @@ func IsArrayType(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsSliceOrArrayType(a any) bool {
-	return IsSliceType(a) || IsArrayType(a)
+func IsSliceOrArrayType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsSliceOrArrayType", time.Now(), &passed)
+	}
+	return isSliceType(a) || isArrayType(a)
 }
 
 // IsStringType determines whether a given value is of type string.
@@ -518,7 +727,10 @@ func IsSliceOrArrayType(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsStringType(a any) bool {
+func IsStringType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsStringType", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.String
 }
 
@@ -541,7 +753,10 @@ func IsStringType(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsIntType(a any) bool {
+func IsIntType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsIntType", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Int
 }
 
@@ -564,7 +779,10 @@ func IsIntType(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsInt8Type(a any) bool {
+func IsInt8Type(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsInt8Type", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Int8
 }
 
@@ -587,7 +805,10 @@ func IsInt8Type(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsInt16Type(a any) bool {
+func IsInt16Type(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsInt16Type", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Int16
 }
 
@@ -610,7 +831,10 @@ func IsInt16Type(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsInt32Type(a any) bool {
+func IsInt32Type(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsInt32Type", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Int32
 }
 
@@ -632,7 +856,10 @@ func IsInt32Type(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsInt64Type(a any) bool {
+func IsInt64Type(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsInt64Type", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Int64
 }
 
@@ -654,7 +881,10 @@ func IsInt64Type(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsUintType(a any) bool {
+func IsUintType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsUintType", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Uint
 }
 
@@ -676,7 +906,10 @@ func IsUintType(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsUint8Type(a any) bool {
+func IsUint8Type(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsUint8Type", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Uint8
 }
 
@@ -699,7 +932,10 @@ func IsUint8Type(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsUint16Type(a any) bool {
+func IsUint16Type(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsUint16Type", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Uint16
 }
 
@@ -722,7 +958,10 @@ func IsUint16Type(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsUint32Type(a any) bool {
+func IsUint32Type(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsUint32Type", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Uint32
 }
 
@@ -744,7 +983,10 @@ func IsUint32Type(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsUint64Type(a any) bool {
+func IsUint64Type(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsUint64Type", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Uint64
 }
 
@@ -766,7 +1008,10 @@ func IsUint64Type(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsFloat32Type(a any) bool {
+func IsFloat32Type(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsFloat32Type", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Float32
 }
 
@@ -788,7 +1033,10 @@ func IsFloat32Type(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsFloat64Type(a any) bool {
+func IsFloat64Type(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsFloat64Type", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Float64
 }
 
@@ -811,7 +1059,10 @@ func IsFloat64Type(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsBoolType(a any) bool {
+func IsBoolType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBoolType", time.Now(), &passed)
+	}
 	return reflect.ValueOf(a).Kind() == reflect.Bool
 }
 
@@ -833,7 +1084,10 @@ func IsBoolType(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsTimeType(a any) bool {
+func IsTimeType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsTimeType", time.Now(), &passed)
+	}
 	return reflect.TypeOf(a) == reflect.TypeOf(time.Time{})
 }
 
@@ -856,7 +1110,10 @@ func IsTimeType(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsDurationType(a any) bool {
+func IsDurationType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsDurationType", time.Now(), &passed)
+	}
 	return reflect.TypeOf(a) == reflect.TypeOf(time.Duration(0))
 }
 
@@ -878,7 +1135,10 @@ func IsDurationType(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsBytesType(a any) bool {
+func IsBytesType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsBytesType", time.Now(), &passed)
+	}
 	return reflect.TypeOf(a) == reflect.TypeOf([]byte{})
 }
 
@@ -903,7 +1163,10 @@ func IsBytesType(a any) bool {
 //
 // Note: This function does not handle nil pointers and can panic if a
 // nil pointer is passed in. Always check for nil before passing pointers.
-func IsErrorType(a any) bool {
+func IsErrorType(a any) (passed bool) {
+	if observer := loadObserver(); observer != nil {
+		defer observer.observe("IsErrorType", time.Now(), &passed)
+	}
 	_, ok := a.(error)
 	return ok
 }
@@ -1,6 +1,7 @@
 package checker
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -339,6 +340,7 @@ func TestIsFloat(t *testing.T) {
 }
 
 func TestIsTime(t *testing.T) {
+	now := time.Now()
 	testCases := []baseCase{
 		{
 			name: "ValidTime",
@@ -370,6 +372,21 @@ func TestIsTime(t *testing.T) {
 			arg:  true,
 			want: false,
 		},
+		{
+			name: "Nil",
+			arg:  nil,
+			want: false,
+		},
+		{
+			name: "NilPointer",
+			arg:  (*time.Time)(nil),
+			want: false,
+		},
+		{
+			name: "TimePointer",
+			arg:  &now,
+			want: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1468,3 +1485,141 @@ func TestIsErrorType(t *testing.T) {
 		})
 	}
 }
+
+func FuzzIsJSON(f *testing.F) {
+	for _, seed := range []string{`{"key": "value"}`, `[1, 2, 3]`, `null`, `"text"`, `{`, ``, `[{"a":[{}]}]`} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if IsJSON(s) && !json.Valid([]byte(s)) {
+			t.Errorf("IsJSON(%q) = true for invalid JSON", s)
+		}
+	})
+}
+
+func TestIsInt64String(t *testing.T) {
+	testCases := []baseCase{
+		{name: "MaxInt64", arg: "9223372036854775807", want: true},
+		{name: "MinInt64", arg: "-9223372036854775808", want: true},
+		{name: "Int64", arg: int64(42), want: true},
+		{name: "Overflow", arg: "9223372036854775808", want: false},
+		{name: "Float", arg: "1.5", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsInt64String(tc.arg); got != tc.want {
+				t.Errorf("IsInt64String(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsUint64String(t *testing.T) {
+	testCases := []baseCase{
+		{name: "MaxUint64", arg: "18446744073709551615", want: true},
+		{name: "Zero", arg: "0", want: true},
+		{name: "Uint64", arg: uint64(18446744073709551615), want: true},
+		{name: "Negative", arg: "-1", want: false},
+		{name: "Signed", arg: "+1", want: false},
+		{name: "Overflow", arg: "18446744073709551616", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsUint64String(tc.arg); got != tc.want {
+				t.Errorf("IsUint64String(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBigInt(t *testing.T) {
+	testCases := []baseCase{
+		{name: "Huge", arg: "123456789012345678901234567890", want: true},
+		{name: "Negative", arg: "-42", want: true},
+		{name: "Signed", arg: "+42", want: true},
+		{name: "Int", arg: 42, want: true},
+		{name: "Exponent", arg: "1e10", want: false},
+		{name: "Decimal", arg: "1.0", want: false},
+		{name: "Underscore", arg: "1_000", want: false},
+		{name: "Empty", arg: "", want: false},
+		{name: "Nil", arg: nil, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+
+			if got := IsBigInt(tc.arg); got != tc.want {
+				t.Errorf("IsBigInt(%v) = %v, want %v", tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsIntInBase(t *testing.T) {
+	testCases := []struct {
+		name  string
+		arg   any
+		base  int
+		want  bool
+		panic bool
+	}{
+		{name: "Hexadecimal", arg: "ff", base: 16, want: true},
+		{name: "UpperHexadecimal", arg: "-7FFFFFFFFFFFFFFF", base: 16, want: true},
+		{name: "Binary", arg: "1010", base: 2, want: true},
+		{name: "InvalidBinaryDigit", arg: "1012", base: 2, want: false},
+		{name: "Base36", arg: "zz", base: 36, want: true},
+		{name: "InferredHexadecimal", arg: "0x1F", base: 0, want: true},
+		{name: "InferredOctal", arg: "0o17", base: 0, want: true},
+		{name: "InferredWithUnderscore", arg: "1_000", base: 0, want: true},
+		{name: "PrefixWithExplicitBase", arg: "0x1F", base: 16, want: false},
+		{name: "Overflow", arg: "8000000000000000", base: 16, want: false},
+		{name: "Empty", arg: "", base: 10, want: false},
+		{name: "InvalidBase", arg: "10", base: 1, panic: true},
+		{name: "BaseTooLarge", arg: "10", base: 37, panic: true},
+		{name: "Nil", arg: nil, base: 10, panic: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.panic {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("The code did not panic")
+					}
+				}()
+			}
+			if got := IsIntInBase(tc.arg, tc.base); got != tc.want {
+				t.Errorf("IsIntInBase(%v, %v) = %v, want %v", tc.arg, tc.base, got, tc.want)
+			}
+		})
+	}
+}
@@ -23,47 +23,69 @@
 package checker
 
 import (
+	"encoding"
 	"encoding/json"
 	"fmt"
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // toFloat converts a value of any type to a float64.
 // If the value is of a numeric type, it is directly converted to float64.
 // If the value is of an interface or pointer type, the function recursively calls itself with the dereferenced value.
+// If the value is of an array, slice, map, or struct type with a textual form (see toText), such as a
+// decimal.Decimal, that text is parsed as a string.
 // If the value is not of a numeric, interface, or pointer type, a panic is thrown.
+// Nil values panic with an error wrapping ErrNilValue and unsupported types with an error wrapping
+// ErrUnsupportedType.
 //
 // Returns: The converted float64 value.
 func toFloat(a any) float64 {
+	f, err := toFloatWithErr(a)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// toFloatWithErr converts a value of any type to a float64 as toFloat does, returning the error toFloat panics
+// with instead of panicking, for the checkers that fail on values they cannot convert, such as untrusted input.
+//
+// Returns: The converted float64 value and a possible error.
+func toFloatWithErr(a any) (float64, error) {
+	if text, ok := toText(a); ok {
+		return toFloatWithErr(text)
+	}
 	reflectValue := reflect.ValueOf(a)
 
 	switch reflectValue.Kind() {
 	case reflect.String:
 		f, err := strconv.ParseFloat(reflectValue.String(), 64)
 		if err != nil {
-			panic(fmt.Sprintf("Error getting float by string: %s", reflectValue.String()))
+			return 0, fmt.Errorf("error getting float: %w", err)
 		}
-		return f
+		return f, nil
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return float64(reflectValue.Int())
+		return float64(reflectValue.Int()), nil
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		return float64(reflectValue.Uint())
+		return float64(reflectValue.Uint()), nil
 	case reflect.Float32, reflect.Float64:
-		return reflectValue.Float()
+		return reflectValue.Float(), nil
 	case reflect.Complex64, reflect.Complex128:
 		c := reflectValue.Complex()
-		return real(c) + imag(c)
+		return real(c) + imag(c), nil
 	case reflect.Interface, reflect.Pointer:
 		if reflectValue.IsNil() {
-			panic("Error convert interface/pointer to float, it is null!")
-		} else {
-			return toFloat(reflectValue.Elem().Interface())
+			return 0, fmt.Errorf("error getting float: %w", ErrNilValue)
 		}
+		return toFloatWithErr(reflectValue.Elem().Interface())
+	case reflect.Invalid:
+		return 0, fmt.Errorf("error getting float: %w", ErrNilValue)
 	default:
-		panic(fmt.Sprintf("Error getting float, type %s not supported!", reflectValue.Kind().String()))
+		return 0, fmt.Errorf("error getting float: %w", ErrUnsupportedType{Kind: reflectValue.Kind()})
 	}
 }
 
@@ -75,7 +97,8 @@ func toFloat(a any) float64 {
 // If the value is of an interface or pointer type, the function recursively calls itself with the dereferenced value.
 // If the value is not of a supported type, a panic is thrown.
 // Returns: The length or size of the value as an integer.
-// Panics: If the value is of unsupported types or if the channel, interface, or pointer is nil.
+// Panics: If the value is of unsupported types or if the channel, interface, or pointer is nil, with an error
+// wrapping ErrUnsupportedType or ErrNilValue respectively.
 func toLength(a any) int {
 	reflectValue := reflect.ValueOf(a)
 
@@ -94,12 +117,14 @@ func toLength(a any) int {
 		return int(real(reflectValue.Complex()))
 	case reflect.Interface, reflect.Pointer:
 		if reflectValue.IsNil() {
-			panic("Error getting the interface/pointer size, it is null!")
+			panic(fmt.Errorf("error getting size: %w", ErrNilValue))
 		} else {
 			return toLength(reflectValue.Elem().Interface())
 		}
+	case reflect.Invalid:
+		panic(fmt.Errorf("error getting size: %w", ErrNilValue))
 	default:
-		panic(fmt.Sprintf("Error getting %s size, type not supported!", reflectValue.Kind().String()))
+		panic(fmt.Errorf("error getting size: %w", ErrUnsupportedType{Kind: reflectValue.Kind()}))
 	}
 }
 
@@ -108,48 +133,97 @@ func toLength(a any) int {
 // If the value is of a numeric type (int, uint, float, complex), it is converted to a string using
 // strconv package functions: strconv.FormatInt, strconv.FormatUint, strconv.FormatFloat, strconv.FormatComplex.
 // If the value is of a bool type, it is converted to a string using strconv.FormatBool.
-// If the value is of an array, slice, map, or struct type, it is marshaled to JSON using json.Marshal
-// and then converted to a string.
+// If the value is of an array, slice, map, or struct type with a textual form (see toText), such as a uuid.UUID
+// or a net.IP, that text is returned. Otherwise, it is marshaled to JSON using json.Marshal and then converted to a
+// string.
 // If the value is of an interface or pointer type, the function recursively calls itself with the dereferenced value.
 // If the value is not of a string, numeric, bool, array, slice, map, struct, interface, or pointer type,
-// a panic is thrown.
+// a panic is thrown with an error wrapping ErrUnsupportedType, or wrapping ErrNilValue if the value is nil.
 //
 // Returns: The converted string value.
 func toString(a any) string {
+	s, err := toStringWithErr(a)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// toStringWithErr converts a value of any type to a string as toString does, returning the error toString panics
+// with instead of panicking.
+//
+// Returns: The converted string value and a possible error.
+func toStringWithErr(a any) (string, error) {
+	if text, ok := toText(a); ok {
+		return text, nil
+	}
 	reflectValue := reflect.ValueOf(a)
 	switch reflectValue.Kind() {
 	case reflect.String:
-		return reflectValue.String()
+		return reflectValue.String(), nil
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return strconv.FormatInt(reflectValue.Int(), 10)
+		return strconv.FormatInt(reflectValue.Int(), 10), nil
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		return strconv.FormatUint(reflectValue.Uint(), 10)
+		return strconv.FormatUint(reflectValue.Uint(), 10), nil
 	case reflect.Float32, reflect.Float64:
-		return strconv.FormatFloat(reflectValue.Float(), 'g', -1, 64)
+		return strconv.FormatFloat(reflectValue.Float(), 'g', -1, 64), nil
 	case reflect.Complex64, reflect.Complex128:
-		return strconv.FormatComplex(reflectValue.Complex(), 'g', -1, 64)
+		return strconv.FormatComplex(reflectValue.Complex(), 'g', -1, 64), nil
 	case reflect.Bool:
-		return strconv.FormatBool(reflectValue.Bool())
+		return strconv.FormatBool(reflectValue.Bool()), nil
 	case reflect.Array, reflect.Slice:
 		if reflectValue.Type().Elem().Kind() == reflect.Uint8 {
-			return string(reflectValue.Bytes())
-		} else {
-			marshal, _ := json.Marshal(reflectValue.Interface())
-			return string(marshal)
+			b := make([]byte, reflectValue.Len())
+			for i := range b {
+				b[i] = byte(reflectValue.Index(i).Uint())
+			}
+			return string(b), nil
 		}
+		marshal, _ := json.Marshal(reflectValue.Interface())
+		return string(marshal), nil
 	case reflect.Map, reflect.Struct:
 		marshal, _ := json.Marshal(reflectValue.Interface())
-		return string(marshal)
+		return string(marshal), nil
 	case reflect.Ptr, reflect.Interface:
 		if reflectValue.IsNil() {
-			panic("Error getting a string, it is null!")
+			return "", fmt.Errorf("error getting a string: %w", ErrNilValue)
 		}
-		return toString(reflectValue.Elem().Interface())
+		return toStringWithErr(reflectValue.Elem().Interface())
+	case reflect.Invalid:
+		return "", fmt.Errorf("error getting a string: %w", ErrNilValue)
 	default:
-		panic(fmt.Sprintf("Error getting a string, unsupported type %s!", reflectValue.Kind().String()))
+		return "", fmt.Errorf("error getting a string: %w", ErrUnsupportedType{Kind: reflectValue.Kind()})
 	}
 }
 
+// toText returns the textual form of a value of an array, slice, map, or struct type, or of a non-nil pointer to
+// one, that implements encoding.TextMarshaler or fmt.Stringer, in this order of preference, so that rich domain
+// types such as uuid.UUID, decimal.Decimal or net.IP are converted by their text instead of by their layout.
+// Values of basic kinds, such as time.Duration, keep being converted by their kind.
+//
+// Returns: The textual form, and false if the value has none or its MarshalText method fails.
+func toText(a any) (string, bool) {
+	reflectValue := reflect.ValueOf(a)
+	if reflectValue.Kind() == reflect.Pointer && !reflectValue.IsNil() {
+		reflectValue = reflectValue.Elem()
+	}
+	switch reflectValue.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map, reflect.Struct:
+	default:
+		return "", false
+	}
+
+	if marshaler, ok := a.(encoding.TextMarshaler); ok {
+		if text, err := marshaler.MarshalText(); err == nil {
+			return string(text), true
+		}
+	}
+	if stringer, ok := a.(fmt.Stringer); ok {
+		return stringer.String(), true
+	}
+	return "", false
+}
+
 // toBytes converts a value of any type to a byte slice.
 // It first converts the value to a string using the toString function,
 // and then converts the string to a byte slice using the []byte type conversion.
@@ -164,18 +238,42 @@ func toBytes(a any) []byte {
 // If the value is of a numeric type (int, uint, float), it is converted to a UnixMilli timestamp using
 // time.UnixMilli function.
 // If the value is of a string type, multiple time layouts are tried using time.Parse function.
-// If the value is not of a numeric or string type, an error is returned.
+// If the value is of an interface or pointer type, the function recursively calls itself with the dereferenced value.
+// If the value is of an array, slice, map, or struct type other than time.Time with a textual form (see toText),
+// that text is parsed as a string.
+// If the value is nil or not of a numeric, string or time.Time type, an error is returned instead of panicking.
 //
 // Returns: The converted time.Time value and a possible error.
 func toTimeWithErr(a any) (time.Time, error) {
+	return toTimeInWithErr(a, nil)
+}
+
+// toTimeInWithErr converts a value of any type to a time.Time value as toTimeWithErr does, except that strings
+// without time zone information are interpreted in loc, as time.ParseInLocation does, when loc is not nil.
+// Values implementing driver.Valuer, such as sql.NullTime, are converted through the value they hold, which is
+// nil for an invalid sql.NullTime.
+//
+// Returns: The converted time.Time value and a possible error.
+func toTimeInWithErr(a any, loc *time.Location) (time.Time, error) {
+	switch a.(type) {
+	case time.Time, *time.Time:
+	default:
+		if text, ok := toText(a); ok {
+			return toTimeInWithErr(text, loc)
+		}
+	}
 	reflectValue := reflect.ValueOf(a)
 	switch reflectValue.Kind() {
 	case reflect.String:
 		layouts := []string{time.Layout, time.ANSIC, time.UnixDate, time.RubyDate, time.RFC822, time.RFC822Z,
 			time.RFC850, time.RFC1123, time.RFC1123Z, time.RFC3339, time.RFC3339Nano, time.Kitchen, time.Stamp,
 			time.DateTime, time.DateOnly, time.TimeOnly}
+		parse := time.Parse
+		if loc != nil {
+			parse = func(layout, value string) (time.Time, error) { return time.ParseInLocation(layout, value, loc) }
+		}
 		for _, layout := range layouts {
-			if t, err := time.Parse(layout, reflectValue.String()); err == nil {
+			if t, err := parse(layout, reflectValue.String()); err == nil {
 				return t, nil
 			}
 		}
@@ -187,11 +285,20 @@ func toTimeWithErr(a any) (time.Time, error) {
 		return time.UnixMilli(int64(reflectValue.Uint())), nil
 	case reflect.Float32, reflect.Float64:
 		return time.UnixMilli(int64(reflectValue.Float())), nil
+	case reflect.Interface, reflect.Pointer:
+		if reflectValue.IsNil() {
+			return time.Time{}, fmt.Errorf("cannot convert to time.Time: %w", ErrNilValue)
+		}
+		return toTimeInWithErr(reflectValue.Elem().Interface(), loc)
+	case reflect.Invalid:
+		return time.Time{}, fmt.Errorf("cannot convert to time.Time: %w", ErrNilValue)
 	default:
 		if reflectValue.Type() == reflect.TypeOf(time.Time{}) {
 			return reflectValue.Interface().(time.Time), nil
+		} else if value, ok := valuerValue(reflectValue.Interface()); ok {
+			return toTimeInWithErr(value, loc)
 		}
-		return time.Time{}, fmt.Errorf("cannot convert to time.Time from type: %s", reflectValue.Kind().String())
+		return time.Time{}, fmt.Errorf("cannot convert to time.Time: %w", ErrUnsupportedType{Kind: reflectValue.Kind()})
 	}
 }
 
@@ -207,6 +314,21 @@ func toTime(a any) time.Time {
 	return t
 }
 
+// toTimeIn converts a value of any type to a time.Time value in the location loc. Strings without time zone
+// information, such as "2024-01-01", are interpreted in loc, and the other values are converted to it.
+//
+// Returns: The converted time.Time value.
+func toTimeIn(a any, loc *time.Location) time.Time {
+	if loc == nil {
+		panic("Invalid location: nil")
+	}
+	t, err := toTimeInWithErr(a, loc)
+	if err != nil {
+		panic(err)
+	}
+	return t.In(loc)
+}
+
 // toDate converts a value of any type to a time.Time value by calling toTime and adjusting it to midnight.
 //
 // Returns: The converted time.Time value.
@@ -215,21 +337,56 @@ func toDate(a any) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
 }
 
-// timeNow returns the current local time as a time.Time value.
+// toDuration converts a value of any type to a time.Duration value. Strings are parsed with time.ParseDuration,
+// such as "15m", and integers are taken as nanoseconds, as time.Duration itself is.
+//
+// Returns: The converted time.Duration value.
+func toDuration(a any) time.Duration {
+	reflectValue := reflect.ValueOf(a)
+	for (reflectValue.Kind() == reflect.Ptr || reflectValue.Kind() == reflect.Interface) && !reflectValue.IsNil() {
+		reflectValue = reflectValue.Elem()
+	}
+
+	switch reflectValue.Kind() {
+	case reflect.String:
+		d, err := time.ParseDuration(reflectValue.String())
+		if err != nil {
+			panic(fmt.Errorf("error getting duration: %w", err))
+		}
+		return d
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return time.Duration(reflectValue.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return time.Duration(reflectValue.Uint())
+	default:
+		panic(fmt.Errorf("cannot convert to time.Duration: %w", ErrUnsupportedType{Kind: reflectValue.Kind()}))
+	}
+}
+
+// timeNow returns the current local time as a time.Time value, read from the clock set by WithFrozenTime or
+// from the system clock.
 func timeNow() time.Time {
-	return time.Now()
+	return loadClock().Now()
 }
 
-// dateNow returns the current date as a time.Time value with the time components set to 0.
-// The function uses the time.Now() function to get the current time and then constructs
-// a new time.Time value with the same year, month, and day as the current time but with
-// the time components (hour, minute, second, nanosecond) set to 0. The location of the
-// new time value is set to the same location as the current time.
+// dateNow returns the current date, read through timeNow, as calendarDate returns it for the current time: the
+// local calendar date at midnight UTC.
 //
 // Returns: The current date as a time.Time value with the time components set to 0.
 func dateNow() time.Time {
-	now := time.Now()
-	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return calendarDate(timeNow())
+}
+
+// dateNowIn returns the current date in the location loc, read through timeNow, as calendarDate returns it.
+func dateNowIn(loc *time.Location) time.Time {
+	return calendarDate(timeNow().In(loc))
+}
+
+// calendarDate returns the calendar date of t, in the location of t, as midnight UTC, so that dates of times in
+// different locations can be compared by day instead of by instant: 2024-01-01 in UTC and 2024-01-01 in
+// São Paulo are the same date, although their midnights are 3 hours apart.
+func calendarDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
 }
 
 // removeNonDigits removes all non-digit characters from the given string.
@@ -294,3 +451,46 @@ func calculateVerifierDigits(document string, weights1, weights2 []int) (int, in
 
 	return firstVerifier, secondVerifier
 }
+
+// isLuhnValid checks if the given digit string passes the Luhn (mod 10) checksum used by card numbers.
+// Starting from the rightmost digit, every second digit is doubled, subtracting 9 when the result exceeds 9,
+// and the sum of all digits must be a multiple of 10.
+// Returns: Boolean value indicating if the digits pass the Luhn checksum.
+func isLuhnValid(digits string) bool {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		digit := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+// modulo11Digit calculates a modulo 11 verifier digit of the given digits using the weights from left to right.
+// The digit is 11 minus the remainder of the weighted sum, a result of 11 becomes "0" and a result of 10 becomes
+// the given substitute.
+func modulo11Digit(digits string, weights []int, substitute string) string {
+	sum := 0
+	for i, weight := range weights {
+		sum += int(digits[i]-'0') * weight
+	}
+
+	switch digit := 11 - sum%11; digit {
+	case 11:
+		return "0"
+	case 10:
+		return substitute
+	default:
+		return strconv.Itoa(digit)
+	}
+}
+
+// leftPadZeros pads the given digits with zeros on the left up to the given length.
+func leftPadZeros(digits string, length int) string {
+	return strings.Repeat("0", length-len(digits)) + digits
+}